@@ -1,17 +1,23 @@
 package main
 
 import (
-	"encoding/binary"
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/meshplus/bitxhub-core/agency"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Rican7/retry"
 	"github.com/Rican7/retry/strategy"
+	"github.com/fsnotify/fsnotify"
 	"github.com/golang/protobuf/proto"
 	"github.com/hashicorp/go-hclog"
 	"github.com/hyperledger/fabric-chaincode-go/shim"
@@ -21,15 +27,30 @@ import (
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/ledger"
 	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
 	"github.com/hyperledger/fabric/common/util"
+	"github.com/hyperledger/fabric/protoutil"
 	"github.com/meshplus/bitxhub-model/pb"
+	"github.com/meshplus/pier-client-fabric/codec"
+	"github.com/meshplus/pier-client-fabric/proof"
+	"github.com/meshplus/pier-client-fabric/verifier"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
+	// logger logs until Initialize creates the logger configured in fabric.toml
 	logger = hclog.New(&hclog.LoggerOptions{
 		Name:   "client",
 		Output: os.Stderr,
 		Level:  hclog.Trace,
 	})
+
+	// ErrInvalidTransaction is returned when a proof is requested for a
+	// transaction that fabric committed with a non-VALID validation code
+	ErrInvalidTransaction = errors.New("invalid transaction")
+
+	// ErrNotSupported is returned by the agency.Client methods the broker has
+	// no counterpart for
+	ErrNotSupported = errors.New("not supported by the fabric plugin")
 )
 
 var _ agency.Client = (*Client)(nil)
@@ -43,6 +64,8 @@ const (
 	GetChainId                           = "getChainId"
 	GetInMessageMethod                   = "getInMessage"
 	GetOutMessageMethod                  = "getOutMessage"
+	GetInMessagesMethod                  = "getInMessages"  // get a range of receipts with the txs storing them
+	GetOutMessagesMethod                 = "getOutMessages" // get a range of crosschain events with the txs storing them
 	PollingEventMethod                   = "pollingEvent"
 	InvokeInterchainMethod               = "invokeInterchain"
 	InvokeInterchainsMethod              = "invokeInterchains"
@@ -51,6 +74,18 @@ const (
 	InvokeGetDirectTransactionMetaMethod = "getDirectTransactionMeta"
 	InvokerGetAppchainInfoMethod         = "getAppchainInfo"
 	FabricType                           = "fabric"
+
+	// chaincodeErrorStatus is in the errors of requests the chaincode
+	// answered with an error
+	chaincodeErrorStatus = "Chaincode status Code: (500)"
+
+	// defaultStopTimeout bounds how long Stop waits for polling and event
+	// handling to exit
+	defaultStopTimeout = 10 * time.Second
+
+	// maxMessageRange is the most messages the broker answers a range query
+	// with
+	maxMessageRange = 1000
 )
 
 type ContractMeta struct {
@@ -67,26 +102,35 @@ type DirectTransactionMeta struct {
 }
 
 type Client struct {
-	meta          *ContractMeta
-	consumer      *Consumer
-	eventC        chan *pb.IBTP
-	appchainID    string
-	bitxhubID     string
-	name          string
-	serviceMeta   map[string]*pb.Interchain
-	ticker        *time.Ticker
-	done          chan bool
-	timeoutHeight int64
-	config        *Config
-}
-
-type Validator struct {
-	Cid      string   `json:"cid"`
-	ChainId  string   `json:"chain_id"`
-	Policy   string   `json:"policy"`
-	ConfByte []string `json:"conf_byte"`
+	meta        *ContractMeta
+	consumer    *Consumer
+	eventC      chan *pb.IBTP
+	chainIDMu   sync.Mutex
+	appchainID  string
+	bitxhubID   string
+	name        string
+	serviceMeta map[string]*pb.Interchain
+	ticker      *time.Ticker
+	configPath  string
+	configMu    sync.RWMutex
+	config      *Config
+	cryptor     *Cryptor
+	deadLetters *DeadLetterStore
+	metrics     *Metrics
+	health      *Health
+	tracing     *Tracing
+	servers     servers
+	logger      hclog.Logger
+
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	stopOnce    sync.Once
+	stopTimeout time.Duration
 }
 
+type Validator = verifier.Validator
+
 type CallFunc struct {
 	Func string   `json:"func"`
 	Args [][]byte `json:"args"`
@@ -101,18 +145,44 @@ type Appchain struct {
 	Exist     bool   `json:"exist"`
 }
 
+// formats of Receipt.Result.Payload as stored by the broker
+const (
+	ReceiptResultRaw   = "raw"
+	ReceiptResultMulti = "multi"
+)
+
 type Receipt struct {
-	Encrypt bool          `json:"encrypt"`
-	Typ     uint64        `json:"typ"`
-	Result  peer.Response `json:"result"`
+	Encrypt   bool          `json:"encrypt"`
+	Typ       uint64        `json:"typ"`
+	Result    peer.Response `json:"result"`
+	Format    string        `json:"format,omitempty"`
+	Index     uint64        `json:"index,omitempty"`
+	Timestamp int64         `json:"timestamp,omitempty"`
+	TxID      string        `json:"tx_id,omitempty"`
+	// TimeoutHeight and Group are those of the interchain, stored by brokers
+	// invoked with them
+	TimeoutHeight int64             `json:"timeout_height,omitempty"`
+	Group         map[string]uint64 `json:"group,omitempty"`
 }
 
 func (c *Client) Initialize(configPath string, extra []byte, mode string) error {
+	return c.initialize(configPath, func(ctx context.Context, meta *ContractMeta, msgH MessageHandler) (*Consumer, error) {
+		return NewConsumer(ctx, configPath, meta, msgH)
+	})
+}
+
+// initialize sets the client up from the config in configPath, talking to
+// fabric through the consumer newConsumer creates.
+func (c *Client) initialize(configPath string, newConsumer ConsumerFactory) error {
 	eventC := make(chan *pb.IBTP)
 	config, err := UnmarshalConfig(configPath)
 	if err != nil {
 		return fmt.Errorf("unmarshal config for plugin :%w", err)
 	}
+	log, err := NewLogger(config.Log, os.Stderr)
+	if err != nil {
+		return fmt.Errorf("create logger for plugin :%w", err)
+	}
 	fabricConfig := config.Fabric
 	contractmeta := &ContractMeta{
 		Username:  fabricConfig.Username,
@@ -129,170 +199,424 @@ func (c *Client) Initialize(configPath string, extra []byte, mode string) error
 	// 	m = make(map[string]*pb.Interchain)
 	// }
 
-	mgh, err := newFabricHandler(contractmeta.EventFilter, eventC)
+	cryptor, err := NewCryptor(configPath, config.Encryption)
+	if err != nil {
+		return fmt.Errorf("load encryption keys for plugin :%w", err)
+	}
+
+	deadLetters, err := NewDeadLetterStore(filepath.Join(configPath, DeadLetterDir))
+	if err != nil {
+		return err
+	}
+
+	tracing, err := NewTracing(config.Tracing)
+	if err != nil {
+		return fmt.Errorf("set tracing up for plugin :%w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	mgh, err := newFabricHandler(ctx, log.Named("handler"), contractmeta.EventFilter, eventC, deadLetters)
 	if err != nil {
+		cancel()
 		return err
 	}
+	mgh.tracing = tracing
 
-	done := make(chan bool)
-	csm, err := NewConsumer(configPath, contractmeta, mgh, done)
+	csm, err := newConsumer(ctx, contractmeta, mgh)
 	if err != nil {
+		cancel()
 		return err
 	}
+	metrics := NewMetrics()
+	csm.metrics = metrics
+	health := NewHealth(time.Duration(config.Health.StalePeriod) * time.Second)
+	csm.health = health
+	csm.logger = log.Named("consumer")
 
 	c.consumer = csm
 	c.eventC = eventC
 	c.meta = contractmeta
 	c.name = fabricConfig.Name
 	c.serviceMeta = m
-	c.ticker = time.NewTicker(2 * time.Second)
-	c.done = done
-	c.timeoutHeight = fabricConfig.TimeoutHeight
+	c.ticker = time.NewTicker(time.Duration(config.Polling.Interval) * time.Second)
+	c.ctx = ctx
+	c.cancel = cancel
+	c.configPath = configPath
 	c.config = config
+	c.cryptor = cryptor
+	c.deadLetters = deadLetters
+	c.metrics = metrics
+	c.health = health
+	c.tracing = tracing
+	c.logger = log
+	c.servers.logger = log
+	c.stopTimeout = defaultStopTimeout
 	c.appchainID = ""
 	c.bitxhubID = ""
 	return nil
 }
 
 func (c *Client) Start() error {
-	logger.Info("Fabric consumer started")
-	go c.polling()
+	config := c.currentConfig()
+	c.servers.handle(config.Metrics.Port, MetricsPath, c.metrics.Handler())
+	c.servers.handle(config.Health.Port, HealthzPath, c.health.LivenessHandler())
+	c.servers.handle(config.Health.Port, ReadyzPath, c.health.ReadinessHandler())
+	c.servers.handle(config.Admin.Port, AdminConfigPath, c.configHandler())
+
+	if err := c.servers.serve(); err != nil {
+		_ = c.servers.shutdown(context.Background())
+		return err
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err == nil {
+		if err = watcher.Add(c.configPath); err != nil {
+			watcher.Close()
+		}
+	}
+	if err != nil {
+		c.logger.Warn("Can't watch config, it won't be reloaded", "error", err.Error())
+	} else {
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			c.watchConfig(c.ctx, watcher)
+		}()
+	}
+
+	c.logger.Info("Fabric consumer started")
+	c.health.setPolling(true)
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.health.setPolling(false)
+		c.polling(c.ctx)
+	}()
+
+	if period := config.Health.SummaryPeriod; period != 0 {
+		c.wg.Add(1)
+		go func() {
+			defer c.wg.Done()
+			c.logHealth(c.ctx, time.Duration(period)*time.Second)
+		}()
+	}
 	return nil
 }
 
+// logHealth logs the health summary every period until ctx is cancelled.
+func (c *Client) logHealth(ctx context.Context, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.health.logSummary(c.logger)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // polling event from broker
-func (c *Client) polling() {
+func (c *Client) polling(ctx context.Context) {
 	for {
 		select {
 		case <-c.ticker.C:
+			if !c.redriveEvents(ctx) {
+				return
+			}
 			outMeta, err := c.GetOutMeta()
 			if err != nil {
+				c.health.queried(err)
 				continue
 			}
 			inMeta, err := c.GetInMeta()
+			c.health.queried(err)
 			if err != nil {
 				continue
 			}
-			for servicePair, index := range outMeta {
-				srcChainServiceID, dstChainServiceID, err := parseServicePair(servicePair)
-				if err != nil {
-					logger.Error("Polling out invalid service pair",
-						"servicePair", servicePair,
-						"index", index,
-						"error", err.Error())
-					continue
-				}
-				meta, ok := c.serviceMeta[srcChainServiceID]
-				if !ok {
-					meta = &pb.Interchain{
-						ID:                      srcChainServiceID,
-						InterchainCounter:       make(map[string]uint64),
-						ReceiptCounter:          make(map[string]uint64),
-						SourceInterchainCounter: make(map[string]uint64),
-						SourceReceiptCounter:    make(map[string]uint64),
-					}
-					c.serviceMeta[srcChainServiceID] = meta
-					// if index == 1, need throw event
-					// even if there is likely repeat throwing
-					if index == 1 {
-						ibtp, err := c.GetOutMessage(servicePair, index)
-						if err != nil {
-							logger.Error("Polling out message",
-								"servicePair", servicePair,
-								"index", index,
-								"error", err.Error())
-							continue
-						}
-
-						c.eventC <- ibtp
-					}
-					meta.InterchainCounter[dstChainServiceID] = index
-					continue
-				}
+			if !c.pollPairs(ctx, DeadLetterInterchain, outMeta, c.getOutMessages) {
+				return
+			}
+			if !c.pollPairs(ctx, DeadLetterReceipt, inMeta, c.getReceiptMessages) {
+				return
+			}
+		case <-ctx.Done():
+			c.logger.Info("Stop long polling")
+			return
+		}
+	}
+}
 
-				for i := meta.InterchainCounter[dstChainServiceID] + 1; i <= index; i++ {
-					ibtp, err := c.GetOutMessage(servicePair, i)
-					if err != nil {
-						logger.Error("Polling out message",
-							"servicePair", servicePair,
-							"index", i,
-							"error", err.Error())
-						continue
-					}
-
-					c.eventC <- ibtp
-					meta.InterchainCounter[dstChainServiceID]++
-				}
+// pollPairs hands the messages of kind the broker holds beyond the ones
+// already handed over to pier, meta being the latest index of each service
+// pair, getting them in batches with getRange. It returns false if ctx is
+// cancelled.
+func (c *Client) pollPairs(ctx context.Context, kind string, meta map[string]uint64, getRange rangeGetter) bool {
+	config := c.currentConfig().Polling
+	servicePairs := make([]string, 0, len(meta))
+	for servicePair := range meta {
+		servicePairs = append(servicePairs, servicePair)
+	}
+	sort.Strings(servicePairs)
+
+	for _, servicePair := range servicePairs {
+		index := meta[servicePair]
+		srcChainServiceID, dstChainServiceID, err := parseServicePair(servicePair)
+		if err != nil {
+			c.logger.Error("Polling invalid service pair",
+				"service_pair", servicePair,
+				"index", index,
+				"error", err.Error())
+			continue
+		}
+		interchain, ok := c.serviceMeta[srcChainServiceID]
+		if !ok {
+			interchain = &pb.Interchain{
+				ID:                      srcChainServiceID,
+				InterchainCounter:       make(map[string]uint64),
+				ReceiptCounter:          make(map[string]uint64),
+				SourceInterchainCounter: make(map[string]uint64),
+				SourceReceiptCounter:    make(map[string]uint64),
 			}
-			for servicePair, index := range inMeta {
-				srcChainServiceID, dstChainServiceID, err := parseServicePair(servicePair)
-				if err != nil {
-					logger.Error("Polling out invalid service pair",
-						"servicePair", servicePair,
-						"index", index,
-						"error", err.Error())
-					continue
-				}
-				meta, ok := c.serviceMeta[srcChainServiceID]
-				if !ok {
-					meta = &pb.Interchain{
-						ID:                      srcChainServiceID,
-						InterchainCounter:       make(map[string]uint64),
-						ReceiptCounter:          make(map[string]uint64),
-						SourceInterchainCounter: make(map[string]uint64),
-						SourceReceiptCounter:    make(map[string]uint64),
-					}
-					c.serviceMeta[srcChainServiceID] = meta
-					if index == 1 {
-						ibtp, err := c.GetReceiptMessage(servicePair, index)
-						if err != nil {
-							logger.Error("Polling out message",
-								"servicePair", servicePair,
-								"index", index,
-								"error", err.Error())
-							continue
-						}
-
-						c.eventC <- ibtp
-					}
-					meta.ReceiptCounter[dstChainServiceID] = index
-					continue
-				}
+			c.serviceMeta[srcChainServiceID] = interchain
+		}
+		counter := interchain.InterchainCounter
+		if kind == DeadLetterReceipt {
+			counter = interchain.ReceiptCounter
+		}
+		// messages sent before the plugin first saw the service pair are
+		// skipped, except the very first one even if it may be sent twice
+		if !ok && index != 1 {
+			counter[dstChainServiceID] = index
+			continue
+		}
 
-				for i := meta.ReceiptCounter[dstChainServiceID] + 1; i <= index; i++ {
-					ibtp, err := c.GetReceiptMessage(servicePair, i)
-					if err != nil {
-						logger.Error("Polling out message",
-							"servicePair", servicePair,
-							"index", i,
-							"error", err.Error())
-						continue
-					}
-
-					c.eventC <- ibtp
-					meta.ReceiptCounter[dstChainServiceID]++
-				}
+		from := counter[dstChainServiceID] + 1
+		to := index
+		if to >= from && to-from >= config.BatchSize {
+			to = from + config.BatchSize - 1
+		}
+		polled, alive := c.pollRange(ctx, kind, servicePair, from, to, config.Workers, getRange)
+		counter[dstChainServiceID] += polled
+		if !alive {
+			return false
+		}
+		c.metrics.setPollingLag(kind, servicePair, index, counter[dstChainServiceID])
+	}
+
+	return true
+}
+
+type polledMessage struct {
+	ibtp *pb.IBTP
+	// span is the trace context the IBTP was polled in
+	span trace.SpanContext
+	err  error
+}
+
+// pollRange gets messages from through to of servicePair in one call with
+// getRange, fetches their proofs up to workers at the same time and hands
+// them to pier in index order. It stops at the first message that can't be
+// fetched, to query the broker for it again on the next tick. It returns how
+// many messages were handed over and false if ctx is cancelled.
+func (c *Client) pollRange(ctx context.Context, kind, servicePair string, from, to uint64, workers int, getRange rangeGetter) (uint64, bool) {
+	if from > to {
+		return 0, true
+	}
+	get := getRange(ctx, servicePair, from, to)
+
+	fetchCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	results := make([]chan polledMessage, to-from+1)
+	for i := range results {
+		results[i] = make(chan polledMessage, 1)
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		slots := make(chan struct{}, workers)
+		for i := range results {
+			select {
+			case slots <- struct{}{}:
+			case <-fetchCtx.Done():
+				return
 			}
-		case <-c.done:
-			logger.Info("Stop long polling")
-			return
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				defer func() { <-slots }()
+				results[i] <- c.pollMessage(fetchCtx, kind, servicePair, from+uint64(i), get)
+			}(i)
+		}
+	}()
+
+	for i := range results {
+		var polled polledMessage
+		select {
+		case polled = <-results[i]:
+		case <-ctx.Done():
+			return uint64(i), false
+		}
+		if polled.err != nil {
+			if !errors.Is(polled.err, ErrDeadLetter) {
+				ibtpLogger(c.logger, servicePair, from+uint64(i), kindType(kind)).Error("Polling message", "error", polled.err.Error())
+			}
+			return uint64(i), true
+		}
+		if !c.sendIBTP(ctx, polled.ibtp, polled.span) {
+			return uint64(i), false
 		}
 	}
+
+	return uint64(len(results)), true
+}
+
+// pollMessage fetches message idx of servicePair in a span of its own, the
+// trace of which the receipt of the IBTP carries on.
+func (c *Client) pollMessage(ctx context.Context, kind, servicePair string, idx uint64, get messageGetter) polledMessage {
+	ctx, span := c.tracing.start(ctx, "PollMessage", ibtpAttributes(servicePair, idx, kindType(kind))...)
+	ibtp, err := c.fetchMessage(ctx, kind, servicePair, idx, get)
+	endSpan(span, err)
+
+	return polledMessage{ibtp: ibtp, span: span.SpanContext(), err: err}
+}
+
+// messageGetter gets message idx of servicePair from the broker.
+type messageGetter func(ctx context.Context, servicePair string, idx uint64) (*pb.IBTP, error)
+
+// fetchMessage gets message idx of servicePair with get. Malformed messages
+// are parked as dead letters and not fetched again until marked for redrive.
+func (c *Client) fetchMessage(ctx context.Context, kind, servicePair string, idx uint64, get messageGetter) (*pb.IBTP, error) {
+	id := deadLetterID(kind, servicePair, idx)
+	log := ibtpLogger(c.logger, servicePair, idx, kindType(kind)).With("id", id)
+	letter, err := c.deadLetters.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if letter != nil && !letter.Redrive {
+		return nil, ErrDeadLetter
+	}
+
+	ibtp, err := get(ctx, servicePair, idx)
+	var merr *MalformedEventError
+	if errors.As(err, &merr) {
+		letter = &DeadLetter{
+			ID:          id,
+			Kind:        kind,
+			ServicePair: servicePair,
+			Index:       idx,
+			Payload:     merr.Payload,
+			Error:       merr.Err.Error(),
+			Timestamp:   time.Now().UnixNano(),
+		}
+		if err := c.deadLetters.Put(letter); err != nil {
+			log.Error("Store dead letter", "error", err.Error())
+		}
+		log.Error("Parked malformed message, its service pair waits until it is redriven", "error", merr.Err.Error())
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if letter != nil {
+		if err := c.deadLetters.Remove(id); err != nil {
+			log.Error("Remove dead letter", "error", err.Error())
+		}
+		log.Info("Redrove dead letter")
+	}
+
+	return ibtp, nil
+}
+
+// redriveEvents hands chaincode events marked for redrive to pier again, and
+// reports the dead letters parked. It returns false if ctx is cancelled.
+func (c *Client) redriveEvents(ctx context.Context) bool {
+	letters, err := c.deadLetters.List()
+	if err != nil {
+		c.logger.Error("List dead letters", "error", err.Error())
+		return true
+	}
+	c.metrics.setDeadLetters(letters)
+	c.health.setDeadLetters(len(letters))
+
+	for _, letter := range letters {
+		if letter.Kind != DeadLetterEvent || !letter.Redrive {
+			continue
+		}
+
+		ibtp := &pb.IBTP{}
+		if err := ibtp.Unmarshal(letter.Payload); err != nil {
+			letter.Error = err.Error()
+			letter.Redrive = false
+			if err := c.deadLetters.Put(letter); err != nil {
+				c.logger.Error("Store dead letter", "id", letter.ID, "error", err.Error())
+			}
+			continue
+		}
+		ibtp.Proof = letter.Proof
+
+		if !c.sendIBTP(ctx, ibtp, trace.SpanContext{}) {
+			return false
+		}
+		if err := c.deadLetters.Remove(letter.ID); err != nil {
+			c.logger.Error("Remove dead letter", "id", letter.ID, "error", err.Error())
+		}
+		c.logger.Info("Redrove dead letter", "id", letter.ID)
+	}
+
+	return true
+}
+
+// sendIBTP hands ibtp, traced in span, to pier, giving up if ctx is cancelled
+// first.
+func (c *Client) sendIBTP(ctx context.Context, ibtp *pb.IBTP, span trace.SpanContext) bool {
+	select {
+	case c.eventC <- ibtp:
+		c.metrics.ibtpEmitted(ibtp)
+		c.tracing.emit(span, ibtp)
+		ibtpLogger(c.logger, genServicePair(ibtp.From, ibtp.To), ibtp.Index, ibtp.Type.String()).Debug("Handed ibtp to pier")
+		return true
+	case <-ctx.Done():
+		return false
+	}
 }
 
-func (c *Client) getProof(response channel.Response) ([]byte, error) {
-	var proof []byte
-	var handle = func(response channel.Response) ([]byte, error) {
+// getProof gets the proof of transaction txID, logging to log.
+func (c *Client) getProof(ctx context.Context, log hclog.Logger, txID fab.TransactionID) (ret []byte, err error) {
+	defer c.metrics.proofFetched(time.Now())
+	ctx, span := c.tracing.start(ctx, "GetProof", attribute.String("fabric.tx_id", string(txID)))
+	defer func() { endSpan(span, err) }()
+	log = log.With("tx_id", string(txID))
+
+	var handle = func(txID fab.TransactionID) ([]byte, error) {
 		// query proof from fabric
-		l, err := ledger.New(c.consumer.channelProvider)
+		t, err := c.consumer.Ledger.QueryTransaction(txID, ledger.WithParentContext(ctx))
 		if err != nil {
 			return nil, err
 		}
-
-		t, err := l.QueryTransaction(response.TransactionID)
-		if err != nil {
+		if err := checkValidationCode(txID, t); err != nil {
 			return nil, err
 		}
+
+		if c.currentConfig().Fabric.ProofFormat == ProofFormatEnvelope {
+			block, err := c.consumer.Ledger.QueryBlockByTxID(txID, ledger.WithParentContext(ctx))
+			if err != nil {
+				return nil, err
+			}
+			span.SetAttributes(attribute.Int64("fabric.block_number", int64(block.Header.Number)))
+			p, err := proof.New(string(txID), t, block)
+			if err != nil {
+				return nil, err
+			}
+			return p.Marshal()
+		}
+
 		pd := &common.Payload{}
 		if err := proto.Unmarshal(t.TransactionEnvelope.Payload, pd); err != nil {
 			return nil, err
@@ -303,30 +627,117 @@ func (c *Client) getProof(response channel.Response) ([]byte, error) {
 			return nil, err
 		}
 
+		if len(pt.Actions) == 0 || len(pt.Actions[0].Payload) == 0 {
+			return nil, fmt.Errorf("%w: %s carries no chaincode action", ErrInvalidTransaction, txID)
+		}
+
 		return pt.Actions[0].Payload, nil
 	}
 
+	var invalidErr error
 	if err := retry.Retry(func(attempt uint) error {
+		c.metrics.attempted(OpGetProof, attempt)
 		var err error
-		proof, err = handle(response)
+		ret, err = handle(txID)
 		if err != nil {
-			logger.Error("Can't get proof", "error", err.Error())
+			log.Error("Can't get proof", "error", err.Error())
+			// querying an invalid transaction again won't make it valid
+			if errors.Is(err, ErrInvalidTransaction) {
+				invalidErr = err
+				return nil
+			}
 			return err
 		}
 		return nil
-	}, strategy.Wait(2*time.Second)); err != nil {
-		logger.Error("Can't get proof", "error", err.Error())
+	}, waitWithContext(ctx, c.retryInterval())); err != nil {
+		log.Error("Can't get proof", "error", err.Error())
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("get proof of %s: %w", txID, err)
+	}
+	if invalidErr != nil {
+		return nil, invalidErr
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("get proof of %s: empty proof", txID)
 	}
 
-	return proof, nil
+	return ret, nil
 }
 
-func (c *Client) Stop() error {
-	c.ticker.Stop()
-	c.done <- true
+// checkValidationCode rejects transactions fabric did not commit as VALID,
+// e.g. because of an MVCC read conflict.
+func checkValidationCode(txID fab.TransactionID, t *peer.ProcessedTransaction) error {
+	if t.ValidationCode != int32(peer.TxValidationCode_VALID) {
+		return fmt.Errorf("%w: %s has validation code %s", ErrInvalidTransaction, txID, peer.TxValidationCode(t.ValidationCode))
+	}
+
 	return nil
 }
 
+// Stop cancels polling and in-flight fabric requests, shuts the consumer and
+// sdk down and closes the IBTP channel. It is safe to call more than once and
+// before Start, and returns within the stop timeout, leaving the IBTP channel
+// open if polling didn't exit by then.
+func (c *Client) Stop() error {
+	var err error
+	c.stopOnce.Do(func() {
+		if c.cancel != nil {
+			c.cancel()
+		}
+		if c.ticker != nil {
+			c.ticker.Stop()
+		}
+
+		timeout := c.stopTimeout
+		if timeout == 0 {
+			timeout = defaultStopTimeout
+		}
+		stopCtx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		pollingDone := make(chan struct{})
+		go func() {
+			c.wg.Wait()
+			close(pollingDone)
+		}()
+
+		stopped := true
+		select {
+		case <-pollingDone:
+		case <-stopCtx.Done():
+			stopped = false
+			err = fmt.Errorf("wait for polling to stop: %w", stopCtx.Err())
+			c.logger.Warn("Timeout waiting for polling to stop")
+		}
+
+		if c.consumer != nil {
+			if cerr := c.consumer.Shutdown(stopCtx); cerr != nil {
+				stopped = false
+				if err == nil {
+					err = cerr
+				}
+			}
+		}
+		if serr := c.servers.shutdown(stopCtx); serr != nil {
+			c.logger.Warn("Shutdown http servers", "error", serr.Error())
+		}
+		if c.tracing != nil {
+			if terr := c.tracing.Shutdown(stopCtx); terr != nil {
+				c.logger.Warn("Export remaining spans", "error", terr.Error())
+			}
+		}
+
+		// closing eventC while a sender is still alive would panic,
+		// so only close it once every producer is known to have exited
+		if stopped && c.eventC != nil {
+			close(c.eventC)
+		}
+	})
+	return err
+}
+
 func (c *Client) Name() string {
 	return c.name
 }
@@ -339,11 +750,47 @@ func (c *Client) GetIBTPCh() chan *pb.IBTP {
 	return c.eventC
 }
 
+// SubmitReceiptBatch submits the receipts one by one as the broker has no
+// batch counterpart of invokeReceipt, stopping at the first one that fails.
 func (c *Client) SubmitReceiptBatch(to []string, index []uint64, serviceID []string, ibtpType []pb.IBTP_Type, result []*pb.Result, proof []*pb.BxhProof) (*pb.SubmitIBTPResponse, error) {
-	panic("implement me")
+	n := len(to)
+	if len(index) != n || len(serviceID) != n || len(ibtpType) != n || len(result) != n || len(proof) != n {
+		return &pb.SubmitIBTPResponse{Status: false, Message: "receipt batch fields differ in length"}, nil
+	}
+
+	ret := &pb.SubmitIBTPResponse{Status: true}
+	for i := range to {
+		var err error
+		ret, err = c.SubmitReceipt(to[i], index[i], serviceID[i], ibtpType[i], result[i], proof[i])
+		if err != nil {
+			return ret, fmt.Errorf("submit receipt %s#%d: %w", to[i], index[i], err)
+		}
+		if !ret.Status {
+			ret.Message = fmt.Sprintf("submit receipt %s#%d: %s", to[i], index[i], ret.Message)
+			return ret, nil
+		}
+	}
+
+	return ret, nil
 }
 
 func (c *Client) SubmitIBTPBatch(from []string, index []uint64, serviceID []string, ibtpType []pb.IBTP_Type, content []*pb.Content, proof []*pb.BxhProof, isEncrypted []bool) (*pb.SubmitIBTPResponse, error) {
+	return c.submitIBTPBatchWithMeta(nil, from, index, serviceID, ibtpType, content, proof, isEncrypted)
+}
+
+// submitIBTPBatchWithMeta is SubmitIBTPBatch knowing the interchain meta of
+// every IBTP, or of none if meta is nil.
+func (c *Client) submitIBTPBatchWithMeta(meta []interchainMeta, from []string, index []uint64, serviceID []string, ibtpType []pb.IBTP_Type, content []*pb.Content, proof []*pb.BxhProof, isEncrypted []bool) (*pb.SubmitIBTPResponse, error) {
+	start := time.Now()
+	ctx, span := c.tracing.start(c.ctx, "SubmitIBTPBatch", attribute.Int("ibtp.batch_size", len(index)))
+	ret, err := c.submitIBTPBatch(ctx, meta, from, index, serviceID, ibtpType, content, proof, isEncrypted)
+	c.metrics.submitted(OpSubmitIBTPBatch, start, ret, err)
+	endSpan(span, responseError(ret, err))
+
+	return ret, err
+}
+
+func (c *Client) submitIBTPBatch(ctx context.Context, meta []interchainMeta, from []string, index []uint64, serviceID []string, ibtpType []pb.IBTP_Type, content []*pb.Content, proof []*pb.BxhProof, isEncrypted []bool) (*pb.SubmitIBTPResponse, error) {
 	ret := &pb.SubmitIBTPResponse{Status: true}
 	var (
 		callFunc []string
@@ -352,15 +799,40 @@ func (c *Client) SubmitIBTPBatch(from []string, index []uint64, serviceID []stri
 		txStatus []uint64
 		sign     [][][]byte
 	)
+	if len(isEncrypted) != len(content) {
+		ret.Status = false
+		ret.Message = fmt.Sprintf("got %d encryption flags for %d contents", len(isEncrypted), len(content))
+		return ret, nil
+	}
+	if meta != nil && len(meta) != len(content) {
+		ret.Status = false
+		ret.Message = fmt.Sprintf("got %d interchain meta for %d contents", len(meta), len(content))
+		return ret, nil
+	}
 	for idx, ct := range content {
+		ct, err := c.openContent(from[idx], ct, isEncrypted[idx])
+		if err != nil {
+			ret.Status = false
+			ret.Message = err.Error()
+			return ret, nil
+		}
+		env, err := codec.Decode(ct.Args)
+		if err != nil {
+			ret.Status = false
+			ret.Message = fmt.Sprintf("invalid args of ibtp %s#%d: %s", from[idx], index[idx], err)
+			return ret, nil
+		}
+		if env.Type == uint64(pb.IBTP_Multi) {
+			return ret, fmt.Errorf("multi IBTP is not supported yet")
+		}
 		callFunc = append(callFunc, ct.Func)
-		args = append(args, ct.Args[1:])
+		args = append(args, env.Values())
 		typ = append(typ, uint64(ibtpType[idx]))
 		txStatus = append(txStatus, uint64(proof[idx].TxStatus))
 		sign = append(sign, proof[idx].MultiSign)
 	}
 
-	_, resp, err := c.InvokeInterchains(from, index, serviceID, typ, callFunc, args, txStatus, sign, isEncrypted)
+	_, resp, err := c.invokeInterchains(ctx, meta, from, index, serviceID, typ, callFunc, args, txStatus, sign, isEncrypted)
 	if err != nil {
 		ret.Status = false
 		ret.Message = fmt.Sprintf("invoke interchains failed: %s", err.Error())
@@ -372,15 +844,61 @@ func (c *Client) SubmitIBTPBatch(from []string, index []uint64, serviceID []stri
 	return ret, nil
 }
 
+// SubmitIBTP executes the interchain on the broker, whose receipt carries no
+// timeout height or group as pier does not pass them here, see
+// submitIBTPWithMeta.
 func (c *Client) SubmitIBTP(from string, index uint64, serviceID string, ibtpType pb.IBTP_Type, content *pb.Content, proof *pb.BxhProof, isEncrypted bool) (*pb.SubmitIBTPResponse, error) {
+	return c.submitIBTPWithMeta(interchainMeta{}, from, index, serviceID, ibtpType, content, proof, isEncrypted)
+}
+
+// interchainMeta is what the broker keeps of an interchain beyond the
+// arguments of SubmitIBTP, so that its receipt carries them back: the timeout
+// height and group of the IBTP, zero if unknown.
+type interchainMeta struct {
+	TimeoutHeight int64
+	Group         map[string]uint64
+}
+
+// submitIBTPWithMeta is SubmitIBTP knowing the interchain meta of the IBTP.
+func (c *Client) submitIBTPWithMeta(meta interchainMeta, from string, index uint64, serviceID string, ibtpType pb.IBTP_Type, content *pb.Content, proof *pb.BxhProof, isEncrypted bool) (*pb.SubmitIBTPResponse, error) {
+	start := time.Now()
+	ctx, span := c.tracing.start(c.ctx, "SubmitIBTP", ibtpAttributes(genServicePair(from, c.logFullID(serviceID)), index, ibtpType.String())...)
+	ret, err := c.submitIBTP(ctx, meta, from, index, serviceID, ibtpType, content, proof, isEncrypted)
+	c.metrics.submitted(OpSubmitIBTP, start, ret, err)
+	endSpan(span, responseError(ret, err))
+
+	return ret, err
+}
+
+func (c *Client) submitIBTP(ctx context.Context, meta interchainMeta, from string, index uint64, serviceID string, ibtpType pb.IBTP_Type, content *pb.Content, proof *pb.BxhProof, isEncrypted bool) (*pb.SubmitIBTPResponse, error) {
 	ret := &pb.SubmitIBTPResponse{Status: true}
 
-	typ := int64(binary.BigEndian.Uint64(content.Args[0]))
-	if typ == int64(pb.IBTP_Multi) {
+	content, err := c.openContent(from, content, isEncrypted)
+	if err != nil {
+		ret.Status = false
+		ret.Message = err.Error()
+		return ret, nil
+	}
+
+	env, err := codec.Decode(content.Args)
+	if err != nil {
+		ret.Status = false
+		ret.Message = fmt.Sprintf("invalid args of ibtp %s#%d: %s", from, index, err)
+		return ret, nil
+	}
+	if env.Type == uint64(pb.IBTP_Multi) {
 		return ret, fmt.Errorf("multi IBTP is not supported yet")
 	}
 
-	_, resp, err := c.InvokeInterchain(from, index, serviceID, uint64(ibtpType), content.Func, content.Args[1:], uint64(proof.TxStatus), proof.MultiSign, isEncrypted)
+	destFullID, err := c.fullServiceID(serviceID)
+	if err != nil {
+		ret.Status = false
+		ret.Message = err.Error()
+		return ret, nil
+	}
+	log := ibtpLogger(c.logger, genServicePair(from, destFullID), index, ibtpType.String())
+
+	res, resp, err := c.invokeInterchain(ctx, meta, from, index, serviceID, uint64(ibtpType), content.Func, env.Values(), uint64(proof.TxStatus), proof.MultiSign, isEncrypted)
 	if err != nil {
 		ret.Status = false
 		ret.Message = fmt.Sprintf("invoke interchain foribtp to call %s: %s", content.Func, err)
@@ -389,25 +907,67 @@ func (c *Client) SubmitIBTP(from string, index uint64, serviceID string, ibtpTyp
 	ret.Status = resp.OK
 	ret.Message = resp.Message
 
-	if c.bitxhubID == "" || c.appchainID == "" {
-		c.bitxhubID, c.appchainID, err = c.GetChainID()
-		if err != nil {
-			ret.Status = false
-			ret.Message = fmt.Sprintf("get id err: %s", err)
-			return ret, nil
-		}
+	// the broker answers with the receipt it stored, older brokers only with
+	// the chaincode payload, in which case the receipt is looked up
+	receipt := &Receipt{}
+	if err := json.Unmarshal(resp.Data, receipt); err != nil || receipt.Format == "" {
+		ret.Result = c.pollMessage(ctx, DeadLetterReceipt, from+"-"+destFullID, index, c.getReceiptMessage).ibtp
+		return ret, nil
 	}
-	destFullID := c.bitxhubID + ":" + c.appchainID + ":" + serviceID
-	servicePair := from + "-" + destFullID
-	ibtp, err := c.GetReceiptMessage(servicePair, index)
-	ret.Result = ibtp
+
+	ret.Result, _ = c.buildReceipt(ctx, log, from, destFullID, index, receipt, *res)
 
 	return ret, nil
 }
 
+// buildReceipt builds the receipt IBTP of the interchain executed by res,
+// carrying on the trace of ctx.
+func (c *Client) buildReceipt(ctx context.Context, log hclog.Logger, from, to string, index uint64, receipt *Receipt, res channel.Response) (ibtp *pb.IBTP, err error) {
+	ctx, span := c.tracing.start(ctx, "BuildReceipt", ibtpAttributes(genServicePair(from, to), index, logTypeReceipt)...)
+	defer func() { endSpan(span, err) }()
+
+	proof, err := c.getProof(ctx, log, res.TransactionID)
+	if err != nil {
+		log.Error("Get receipt proof", "error", err.Error())
+		return nil, err
+	}
+	ibtp, err = c.receiptIBTP(from, to, index, receipt, proof)
+	if err != nil {
+		log.Error("Build receipt", "tx_id", string(res.TransactionID), "error", err.Error())
+		return nil, err
+	}
+
+	return ibtp, nil
+}
+
 func (c *Client) SubmitReceipt(to string, index uint64, serviceID string, ibtpType pb.IBTP_Type, result *pb.Result, proof *pb.BxhProof) (*pb.SubmitIBTPResponse, error) {
+	start := time.Now()
+	// the receipt continues the trace of the interchain it answers
+	fromFullID := c.logFullID(serviceID)
+	ctx := c.tracing.receiptContext(c.ctx, (&pb.IBTP{From: fromFullID, To: to, Index: index}).ID())
+	ctx, span := c.tracing.start(ctx, "SubmitReceipt", ibtpAttributes(genServicePair(fromFullID, to), index, ibtpType.String())...)
+	ret, err := c.submitReceipt(ctx, to, index, serviceID, ibtpType, result, proof)
+	c.metrics.submitted(OpSubmitReceipt, start, ret, err)
+	endSpan(span, responseError(ret, err))
+
+	return ret, err
+}
+
+func (c *Client) submitReceipt(ctx context.Context, to string, index uint64, serviceID string, ibtpType pb.IBTP_Type, result *pb.Result, proof *pb.BxhProof) (*pb.SubmitIBTPResponse, error) {
 	ret := &pb.SubmitIBTPResponse{Status: true}
 
+	if isEncryptedResult(result) {
+		dstAppchainID, err := parseAppchainID(to)
+		if err == nil {
+			result, err = c.cryptor.DecryptResult(result, dstAppchainID)
+		}
+		if err != nil {
+			ret.Status = false
+			ret.Message = fmt.Sprintf("decrypt result of receipt: %s", err)
+			return ret, nil
+		}
+	}
+
 	var results [][][]byte
 	for _, s := range result.Data {
 		results = append(results, s.Data)
@@ -420,9 +980,9 @@ func (c *Client) SubmitReceipt(to string, index uint64, serviceID string, ibtpTy
 	var err error
 	var resp *Response
 	if len(results) != 0 {
-		_, resp, err = c.InvokeReceipt(serviceID, to, index, uint64(ibtpType), results[0], uint64(proof.TxStatus), proof.MultiSign)
+		_, resp, err = c.invokeReceipt(ctx, serviceID, to, index, uint64(ibtpType), results[0], uint64(proof.TxStatus), proof.MultiSign)
 	} else {
-		_, resp, err = c.InvokeReceipt(serviceID, to, index, uint64(ibtpType), make([][]byte, 0), uint64(proof.TxStatus), proof.MultiSign)
+		_, resp, err = c.invokeReceipt(ctx, serviceID, to, index, uint64(ibtpType), make([][]byte, 0), uint64(proof.TxStatus), proof.MultiSign)
 	}
 	if err != nil {
 		ret.Status = false
@@ -435,6 +995,29 @@ func (c *Client) SubmitReceipt(to string, index uint64, serviceID string, ibtpTy
 	return ret, nil
 }
 
+// openContent returns the plain content of an ibtp from from, decrypting it
+// if pier marked the ibtp encrypted. Contents sealed by EncryptContent but not
+// marked encrypted are rejected rather than handed to the chaincode.
+func (c *Client) openContent(from string, content *pb.Content, isEncrypted bool) (*pb.Content, error) {
+	if !isEncrypted {
+		if isEncryptedContent(content) {
+			return nil, fmt.Errorf("content of ibtp from %s is encrypted but the ibtp is not", from)
+		}
+		return content, nil
+	}
+
+	srcAppchainID, err := parseAppchainID(from)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt content of ibtp from %s: %w", from, err)
+	}
+	plain, err := c.cryptor.DecryptContent(content, srcAppchainID)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt content of ibtp from %s: %w", from, err)
+	}
+
+	return plain, nil
+}
+
 func (c *Client) GetDirectTransactionMeta(IBTPid string) (uint64, uint64, uint64, error) {
 
 	args := util.ToChaincodeArgs(IBTPid)
@@ -444,7 +1027,7 @@ func (c *Client) GetDirectTransactionMeta(IBTPid string) (uint64, uint64, uint64
 		Args:        args,
 	}
 	var response channel.Response
-	response, err := c.consumer.ChannelClient.Execute(request)
+	response, err := c.consumer.Querier.Query(request, channel.WithParentContext(c.ctx))
 	if err != nil {
 		return 0, 0, 0, err
 	}
@@ -453,11 +1036,17 @@ func (c *Client) GetDirectTransactionMeta(IBTPid string) (uint64, uint64, uint64
 		return 0, 0, 0, err
 	}
 
-	return uint64(ret.StartTimestamp), c.config.Fabric.TimeoutPeriod, ret.TransactionStatus, nil
+	return uint64(ret.StartTimestamp), c.currentConfig().Fabric.TimeoutPeriod, ret.TransactionStatus, nil
 
 }
 
 func (c *Client) InvokeInterchains(srcFullID []string, index []uint64, destAddr []string, reqType []uint64, callFunc []string, callArgs [][][]byte, txStatus []uint64, multiSign [][][]byte, encrypt []bool) (*channel.Response, *Response, error) {
+	return c.invokeInterchains(c.ctx, nil, srcFullID, index, destAddr, reqType, callFunc, callArgs, txStatus, multiSign, encrypt)
+}
+
+// invokeInterchains executes a batch of interchains, handing the broker the
+// interchain meta of each of them unless meta is nil.
+func (c *Client) invokeInterchains(ctx context.Context, meta []interchainMeta, srcFullID []string, index []uint64, destAddr []string, reqType []uint64, callFunc []string, callArgs [][][]byte, txStatus []uint64, multiSign [][][]byte, encrypt []bool) (*channel.Response, *Response, error) {
 	srcFullIDBytes, err := json.Marshal(srcFullID)
 	if err != nil {
 		return nil, nil, err
@@ -495,8 +1084,25 @@ func (c *Client) InvokeInterchains(srcFullID []string, index []uint64, destAddr
 		return nil, nil, err
 	}
 
-	args := util.ToChaincodeArgs(string(srcFullIDBytes), string(indexBytes), string(destAddrBytes), string(reqTypeBytes), string(callFuncBytes),
+	args := util.ToChaincodeArgs(string(srcFullIDBytes), string(destAddrBytes), string(indexBytes), string(reqTypeBytes), string(callFuncBytes),
 		string(callArgsBytes), string(txStatusBytes), string(multiSignBytes), string(encryptBytes))
+	if meta != nil {
+		timeoutHeights := make([]int64, 0, len(meta))
+		groups := make([]map[string]uint64, 0, len(meta))
+		for _, m := range meta {
+			timeoutHeights = append(timeoutHeights, m.TimeoutHeight)
+			groups = append(groups, m.Group)
+		}
+		timeoutHeightsBytes, err := json.Marshal(timeoutHeights)
+		if err != nil {
+			return nil, nil, err
+		}
+		groupsBytes, err := json.Marshal(groups)
+		if err != nil {
+			return nil, nil, err
+		}
+		args = append(args, timeoutHeightsBytes, groupsBytes)
+	}
 
 	request := channel.Request{
 		ChaincodeID: c.meta.CCID,
@@ -504,38 +1110,17 @@ func (c *Client) InvokeInterchains(srcFullID []string, index []uint64, destAddr
 		Args:        args,
 	}
 
-	// retry executing
-	var res channel.Response
-	if err := retry.Retry(func(attempt uint) error {
-		res, err = c.consumer.ChannelClient.Execute(request)
-		if err != nil {
-			if strings.Contains(err.Error(), "Chaincode status Code: (500)") {
-				res.ChaincodeStatus = shim.ERROR
-				logger.Error("execute request failed", "err", err.Error())
-				return nil
-			}
-			return fmt.Errorf("execute request: %w", err)
-		}
-
-		return nil
-	}, strategy.Wait(2*time.Second)); err != nil {
-		logger.Error("Can't send rollback ibtp back to bitxhub", "error", err.Error())
-	}
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	logger.Info("response", "cc status", strconv.Itoa(int(res.ChaincodeStatus)), "payload", string(res.Payload))
-	response := &Response{}
-	if err := json.Unmarshal(res.Payload, response); err != nil {
-		return nil, nil, err
-	}
-
-	return &res, response, nil
+	log := c.logger.With("batch_size", len(index))
+	return c.execute(ctx, log, OpInvokeInterchains, request)
 }
 
 func (c *Client) InvokeInterchain(srcFullID string, index uint64, destAddr string, reqType uint64, callFunc string, callArgs [][]byte, txStatus uint64, multiSign [][]byte, encrypt bool) (*channel.Response, *Response, error) {
+	return c.invokeInterchain(c.ctx, interchainMeta{}, srcFullID, index, destAddr, reqType, callFunc, callArgs, txStatus, multiSign, encrypt)
+}
+
+// invokeInterchain executes an interchain, handing the broker its interchain
+// meta if known.
+func (c *Client) invokeInterchain(ctx context.Context, meta interchainMeta, srcFullID string, index uint64, destAddr string, reqType uint64, callFunc string, callArgs [][]byte, txStatus uint64, multiSign [][]byte, encrypt bool) (*channel.Response, *Response, error) {
 	callArgsBytes, err := json.Marshal(callArgs)
 	if err != nil {
 		return nil, nil, err
@@ -547,6 +1132,13 @@ func (c *Client) InvokeInterchain(srcFullID string, index uint64, destAddr strin
 
 	args := util.ToChaincodeArgs(srcFullID, destAddr, strconv.FormatUint(index, 10), strconv.FormatUint(reqType, 10), callFunc,
 		string(callArgsBytes), strconv.FormatUint(txStatus, 10), string(multiSignBytes), strconv.FormatBool(encrypt))
+	if meta.TimeoutHeight != 0 || meta.Group != nil {
+		groupBytes, err := json.Marshal(meta.Group)
+		if err != nil {
+			return nil, nil, err
+		}
+		args = append(args, []byte(strconv.FormatInt(meta.TimeoutHeight, 10)), groupBytes)
+	}
 
 	request := channel.Request{
 		ChaincodeID: c.meta.CCID,
@@ -554,38 +1146,15 @@ func (c *Client) InvokeInterchain(srcFullID string, index uint64, destAddr strin
 		Args:        args,
 	}
 
-	// retry executing
-	var res channel.Response
-	if err := retry.Retry(func(attempt uint) error {
-		res, err = c.consumer.ChannelClient.Execute(request)
-		if err != nil {
-			if strings.Contains(err.Error(), "Chaincode status Code: (500)") {
-				res.ChaincodeStatus = shim.ERROR
-				logger.Error("execute request failed", "err", err.Error())
-				return nil
-			}
-			return fmt.Errorf("execute request: %w", err)
-		}
-
-		return nil
-	}, strategy.Wait(2*time.Second)); err != nil {
-		logger.Error("Can't send rollback ibtp back to bitxhub", "error", err.Error())
-	}
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	logger.Info("response", "cc status", strconv.Itoa(int(res.ChaincodeStatus)), "payload", string(res.Payload))
-	response := &Response{}
-	if err := json.Unmarshal(res.Payload, response); err != nil {
-		return nil, nil, err
-	}
-
-	return &res, response, nil
+	log := ibtpLogger(c.logger, genServicePair(srcFullID, c.logFullID(destAddr)), index, pb.IBTP_Type(reqType).String())
+	return c.execute(ctx, log, OpInvokeInterchain, request)
 }
 
 func (c *Client) InvokeReceipt(srcAddr string, dstFullID string, index uint64, reqType uint64, result [][]byte, txStatus uint64, multiSign [][]byte) (*channel.Response, *Response, error) {
+	return c.invokeReceipt(c.ctx, srcAddr, dstFullID, index, reqType, result, txStatus, multiSign)
+}
+
+func (c *Client) invokeReceipt(ctx context.Context, srcAddr string, dstFullID string, index uint64, reqType uint64, result [][]byte, txStatus uint64, multiSign [][]byte) (*channel.Response, *Response, error) {
 	resultBytes, err := json.Marshal(result)
 	if err != nil {
 		return nil, nil, err
@@ -603,29 +1172,42 @@ func (c *Client) InvokeReceipt(srcAddr string, dstFullID string, index uint64, r
 		Args:        args,
 	}
 
-	// retry executing
+	log := ibtpLogger(c.logger, genServicePair(c.logFullID(srcAddr), dstFullID), index, pb.IBTP_Type(reqType).String())
+	return c.execute(ctx, log, OpInvokeReceipt, request)
+}
+
+// execute executes request on the broker, retrying until the peers endorse it
+// or ctx is cancelled, and unpacks the broker response.
+func (c *Client) execute(ctx context.Context, log hclog.Logger, op string, request channel.Request) (_ *channel.Response, _ *Response, err error) {
+	ctx, span := c.tracing.start(ctx, "Execute", attribute.String("fabric.fcn", request.Fcn))
+	defer func() { endSpan(span, err) }()
+
 	var res channel.Response
-	if err := retry.Retry(func(attempt uint) error {
-		res, err = c.consumer.ChannelClient.Execute(request)
+	if rerr := retry.Retry(func(attempt uint) error {
+		c.metrics.attempted(op, attempt)
+		res, err = c.consumer.Executor.Execute(request, channel.WithParentContext(ctx))
+		c.health.endorsed(err)
 		if err != nil {
-			if strings.Contains(err.Error(), "Chaincode status Code: (500)") {
+			if strings.Contains(err.Error(), chaincodeErrorStatus) {
 				res.ChaincodeStatus = shim.ERROR
-				logger.Error("execute request failed", "err", err.Error())
+				log.Error("Execute request failed", "fcn", request.Fcn, "error", err.Error())
 				return nil
 			}
 			return fmt.Errorf("execute request: %w", err)
 		}
 
 		return nil
-	}, strategy.Wait(2*time.Second)); err != nil {
-		logger.Error("Can't send rollback ibtp back to bitxhub", "error", err.Error())
+	}, waitWithContext(ctx, c.retryInterval())); rerr != nil {
+		log.Error("Give up executing request", "fcn", request.Fcn, "error", rerr.Error())
 	}
 
 	if err != nil {
 		return nil, nil, err
 	}
 
-	logger.Info("response", "cc status", strconv.Itoa(int(res.ChaincodeStatus)), "payload", string(res.Payload))
+	span.SetAttributes(attribute.String("fabric.tx_id", string(res.TransactionID)))
+	log.Info("Executed request", "fcn", request.Fcn, "tx_id", string(res.TransactionID),
+		"cc_status", res.ChaincodeStatus, "payload", string(res.Payload))
 	response := &Response{}
 	if err := json.Unmarshal(res.Payload, response); err != nil {
 		return nil, nil, err
@@ -634,57 +1216,211 @@ func (c *Client) InvokeReceipt(srcAddr string, dstFullID string, index uint64, r
 	return &res, response, nil
 }
 
-func (c *Client) GetOutMessage(servicePair string, idx uint64) (*pb.IBTP, error) {
-	args := util.ToChaincodeArgs(servicePair, strconv.FormatUint(idx, 10))
-	request := channel.Request{
-		ChaincodeID: c.meta.CCID,
-		Fcn:         GetOutMessageMethod,
-		Args:        args,
+// fullServiceID is the full id of the local service serviceID, the chain id
+// being looked up from the broker on first use.
+func (c *Client) fullServiceID(serviceID string) (string, error) {
+	c.chainIDMu.Lock()
+	defer c.chainIDMu.Unlock()
+	if c.bitxhubID == "" || c.appchainID == "" {
+		var err error
+		c.bitxhubID, c.appchainID, err = c.GetChainID()
+		if err != nil {
+			return "", fmt.Errorf("get chain id: %w", err)
+		}
 	}
 
-	var response channel.Response
-	response, err := c.consumer.ChannelClient.Execute(request)
+	return c.bitxhubID + ":" + c.appchainID + ":" + serviceID, nil
+}
+
+// logFullID is the full id of the local service serviceID to log, the bare
+// service id if the chain id can't be looked up.
+func (c *Client) logFullID(serviceID string) string {
+	id, err := c.fullServiceID(serviceID)
 	if err != nil {
-		return nil, err
+		return serviceID
 	}
 
-	proof, err := c.getProof(response)
+	return id
+}
+
+func (c *Client) GetOutMessage(servicePair string, idx uint64) (*pb.IBTP, error) {
+	return c.getOutMessage(c.ctx, servicePair, idx)
+}
+
+func (c *Client) getOutMessage(ctx context.Context, servicePair string, idx uint64) (*pb.IBTP, error) {
+	payload, proof, err := c.lookupMessage(ctx, GetOutMessageMethod, servicePair, idx)
 	if err != nil {
 		return nil, err
 	}
-	return c.unpackIBTP(&response, pb.IBTP_INTERCHAIN, proof)
+
+	return c.unpackIBTP(&channel.Response{Payload: payload}, pb.IBTP_INTERCHAIN, proof)
 }
 
-func (c *Client) GetInMessage(servicePair string, index uint64) ([][]byte, []byte, bool, uint64, error) {
+// lookupMessage queries the broker with fcn for message idx of servicePair,
+// returning it along with the proof of the transaction that stored it.
+func (c *Client) lookupMessage(ctx context.Context, fcn, servicePair string, idx uint64) ([]byte, []byte, error) {
 	request := channel.Request{
 		ChaincodeID: c.meta.CCID,
-		Fcn:         GetInMessageMethod,
-		Args:        util.ToChaincodeArgs(servicePair, strconv.FormatUint(index, 10)),
+		Fcn:         fcn,
+		Args:        util.ToChaincodeArgs(servicePair, strconv.FormatUint(idx, 10)),
 	}
 
-	var response channel.Response
-	response, err := c.consumer.ChannelClient.Execute(request)
+	response, err := c.consumer.Querier.Query(request, channel.WithParentContext(ctx))
 	if err != nil {
-		return nil, nil, false, 0, fmt.Errorf("execute req: %w", err)
+		return nil, nil, fmt.Errorf("query %s: %w", fcn, err)
+	}
+	log := c.logger.With("service_pair", servicePair, "index", idx, "fcn", fcn)
+	proof, err := c.messageProof(ctx, log, response)
+	if err != nil {
+		return nil, nil, fmt.Errorf("proof of %s: %w", fcn, err)
 	}
 
-	resp := &Receipt{}
-	if err := json.Unmarshal(response.Payload, resp); err != nil {
-		return nil, nil, false, 0, err
+	return response.Payload, proof, nil
+}
+
+// messageProof gets the proof, in the configured format, of the transaction
+// that stored the message the broker answered a query with. Out messages are
+// stored by the business chaincode calling the broker, the write of the
+// broker proving them. Messages of older brokers name no transaction and are
+// proven by the endorsements of the query, which are in no block.
+func (c *Client) messageProof(ctx context.Context, log hclog.Logger, response channel.Response) ([]byte, error) {
+	origin := struct {
+		TxID string `json:"tx_id"`
+	}{}
+	if err := json.Unmarshal(response.Payload, &origin); err != nil {
+		return nil, &MalformedEventError{Payload: response.Payload, Err: fmt.Errorf("unmarshal message: %w", err)}
+	}
+	if origin.TxID != "" {
+		return c.getProof(ctx, log, fab.TransactionID(origin.TxID))
+	}
+	if c.currentConfig().Fabric.ProofFormat == ProofFormatEnvelope {
+		return nil, fmt.Errorf("message names no transaction to prove in %s format", ProofFormatEnvelope)
+	}
+
+	return endorsedProof(response)
+}
+
+// endorsedProof lays the endorsements of a query out as the chaincode action
+// payload of a transaction, the proof validating.wasm checks: the broker
+// response it carries must match the IBTP and its endorsements must satisfy
+// the endorsement policy. The query is not committed.
+func endorsedProof(response channel.Response) ([]byte, error) {
+	if response.Proposal == nil || response.Proposal.Proposal == nil || len(response.Responses) == 0 {
+		return nil, fmt.Errorf("query of %s carries no endorsement", response.TransactionID)
 	}
 
-	results := []string{"true"}
-	if resp.Result.Status == shim.ERROR {
-		results = []string{"false"}
+	cpp, err := protoutil.UnmarshalChaincodeProposalPayload(response.Proposal.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal proposal payload: %w", err)
+	}
+	cppBytes, err := protoutil.GetBytesProposalPayloadForTx(cpp)
+	if err != nil {
+		return nil, fmt.Errorf("marshal proposal payload: %w", err)
+	}
+
+	action := &peer.ChaincodeEndorsedAction{ProposalResponsePayload: response.Responses[0].Payload}
+	for _, r := range response.Responses {
+		if r.ProposalResponse == nil || r.Endorsement == nil {
+			return nil, fmt.Errorf("response of %s is not endorsed", r.Endorser)
+		}
+		if !bytes.Equal(r.Payload, action.ProposalResponsePayload) {
+			return nil, fmt.Errorf("endorsers answered %s differently", response.TransactionID)
+		}
+		action.Endorsements = append(action.Endorsements, r.Endorsement)
 	}
-	results = append(results, strings.Split(string(resp.Result.Payload), ",")...)
 
-	proof, err := c.getProof(response)
+	return proto.Marshal(&peer.ChaincodeActionPayload{
+		ChaincodeProposalPayload: cppBytes,
+		Action:                   action,
+	})
+}
+
+func (c *Client) GetInMessage(servicePair string, index uint64) ([][]byte, []byte, bool, uint64, error) {
+	receipt, payload, proof, err := c.getReceipt(c.ctx, servicePair, index)
 	if err != nil {
 		return nil, nil, false, 0, err
 	}
 
-	return util.ToChaincodeArgs(results...), proof, resp.Encrypt, resp.Typ, nil
+	status := []byte("true")
+	if receipt.Result.Status == shim.ERROR {
+		status = []byte("false")
+	}
+	results, err := c.receiptResults(receipt)
+	if err != nil {
+		return nil, nil, false, 0, &MalformedEventError{Payload: payload, Err: err}
+	}
+
+	return append([][]byte{status}, results...), proof, receipt.Encrypt, receipt.Typ, nil
+}
+
+// getReceipt gets the receipt of interchain index of servicePair, as stored
+// in payload, along with its proof.
+func (c *Client) getReceipt(ctx context.Context, servicePair string, index uint64) (*Receipt, []byte, []byte, error) {
+	payload, proof, err := c.lookupMessage(ctx, GetInMessageMethod, servicePair, index)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	receipt := &Receipt{}
+	if err := json.Unmarshal(payload, receipt); err != nil {
+		return nil, nil, nil, &MalformedEventError{Payload: payload, Err: fmt.Errorf("unmarshal receipt: %w", err)}
+	}
+
+	return receipt, payload, proof, nil
+}
+
+// receiptIBTP builds the receipt IBTP answering interchain index from from to
+// to out of the receipt the broker stored when executing it.
+func (c *Client) receiptIBTP(from, to string, index uint64, receipt *Receipt, proof []byte) (*pb.IBTP, error) {
+	results, err := c.receiptResults(receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	if receipt.Index != 0 && receipt.Index != index {
+		return nil, fmt.Errorf("receipt of interchain %d stored for %d", receipt.Index, index)
+	}
+	ibtp, err := c.generateReceipt(from, to, index, results, proof, receipt.Result.Status != shim.ERROR, receipt.Encrypt, receipt.Typ)
+	if err != nil {
+		return nil, err
+	}
+	ibtp.TimeoutHeight = receipt.TimeoutHeight
+	if len(receipt.Group) != 0 {
+		ibtp.Group = &pb.StringUint64Map{}
+		for key := range receipt.Group {
+			ibtp.Group.Keys = append(ibtp.Group.Keys, key)
+		}
+		sort.Strings(ibtp.Group.Keys)
+		for _, key := range ibtp.Group.Keys {
+			ibtp.Group.Vals = append(ibtp.Group.Vals, receipt.Group[key])
+		}
+	}
+	ibtp.Timestamp = receipt.Timestamp
+
+	return ibtp, nil
+}
+
+// receiptResults returns the values the destination chaincode responded with.
+// Receipts stored by older brokers, or all receipts in legacy mode, are split
+// on commas.
+func (c *Client) receiptResults(receipt *Receipt) ([][]byte, error) {
+	payload := receipt.Result.Payload
+	if c.currentConfig().Fabric.ReceiptFormat == ReceiptFormatLegacy || receipt.Format == "" {
+		return util.ToChaincodeArgs(strings.Split(string(payload), ",")...), nil
+	}
+
+	switch receipt.Format {
+	case ReceiptResultRaw:
+		return [][]byte{payload}, nil
+	case ReceiptResultMulti:
+		var results [][]byte
+		if err := json.Unmarshal(payload, &results); err != nil {
+			return nil, fmt.Errorf("unmarshal receipt results: %w", err)
+		}
+		return results, nil
+	default:
+		return nil, fmt.Errorf("unknown receipt format %s", receipt.Format)
+	}
 }
 
 func (c *Client) GetInMeta() (map[string]uint64, error) {
@@ -694,7 +1430,7 @@ func (c *Client) GetInMeta() (map[string]uint64, error) {
 	}
 
 	var response channel.Response
-	response, err := c.consumer.ChannelClient.Query(request)
+	response, err := c.consumer.Querier.Query(request, channel.WithParentContext(c.ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -709,7 +1445,7 @@ func (c *Client) GetOutMeta() (map[string]uint64, error) {
 	}
 
 	var response channel.Response
-	response, err := c.consumer.ChannelClient.Query(request)
+	response, err := c.consumer.Querier.Query(request, channel.WithParentContext(c.ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -717,14 +1453,14 @@ func (c *Client) GetOutMeta() (map[string]uint64, error) {
 	return c.unpackMap(response)
 }
 
-func (c Client) GetCallbackMeta() (map[string]uint64, error) {
+func (c *Client) GetCallbackMeta() (map[string]uint64, error) {
 	request := channel.Request{
 		ChaincodeID: c.meta.CCID,
 		Fcn:         GetCallbackMetaMethod,
 	}
 
 	var response channel.Response
-	response, err := c.consumer.ChannelClient.Query(request)
+	response, err := c.consumer.Querier.Query(request, channel.WithParentContext(c.ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -737,23 +1473,129 @@ func (c *Client) CommitCallback(ibtp *pb.IBTP) error {
 }
 
 func (c *Client) GetReceiptMessage(servicePair string, idx uint64) (*pb.IBTP, error) {
-	var encrypt bool
+	return c.getReceiptMessage(c.ctx, servicePair, idx)
+}
 
-	result, proof, encrypt, typ, err := c.GetInMessage(servicePair, idx)
+func (c *Client) getReceiptMessage(ctx context.Context, servicePair string, idx uint64) (*pb.IBTP, error) {
+	receipt, payload, proof, err := c.getReceipt(ctx, servicePair, idx)
 	if err != nil {
 		return nil, err
 	}
 
-	status, err := strconv.ParseBool(string(result[0]))
-	if err != nil {
-		return nil, err
-	}
+	return c.receiptMessage(servicePair, idx, receipt, payload, proof)
+}
 
+// receiptMessage builds the receipt IBTP out of receipt, stored as payload.
+func (c *Client) receiptMessage(servicePair string, idx uint64, receipt *Receipt, payload, proof []byte) (*pb.IBTP, error) {
 	srcServiceID, dstServiceID, err := pb.ParseServicePair(servicePair)
 	if err != nil {
 		return nil, err
 	}
-	return c.generateReceipt(srcServiceID, dstServiceID, idx, result[1:], proof, status, encrypt, typ)
+	ibtp, err := c.receiptIBTP(srcServiceID, dstServiceID, idx, receipt, proof)
+	if err != nil {
+		return nil, &MalformedEventError{Payload: payload, Err: err}
+	}
+
+	return ibtp, nil
+}
+
+// storedMessage is the part of the events and receipts stored by the broker
+// telling which message they are and which transaction stored them.
+type storedMessage struct {
+	Index uint64 `json:"index"`
+	TxID  string `json:"tx_id"`
+}
+
+type rangedMessage struct {
+	txID    fab.TransactionID
+	payload []byte
+}
+
+// queryMessages queries the broker with fcn for the messages from through to
+// of servicePair, keyed by index, in calls of at most maxMessageRange
+// messages. Messages not naming the transaction that stored them, which
+// can't prove them, are left out, and so are the messages of the calls the
+// broker can't answer, e.g. if it predates range queries.
+func (c *Client) queryMessages(ctx context.Context, fcn, servicePair string, from, to uint64) map[uint64]rangedMessage {
+	log := c.logger.With("service_pair", servicePair, "from", from, "to", to)
+	messages := make(map[uint64]rangedMessage, to-from+1)
+	for start := from; start <= to; start += maxMessageRange {
+		end := to
+		if end-start >= maxMessageRange {
+			end = start + maxMessageRange - 1
+		}
+		request := channel.Request{
+			ChaincodeID: c.meta.CCID,
+			Fcn:         fcn,
+			Args:        util.ToChaincodeArgs(servicePair, strconv.FormatUint(start, 10), strconv.FormatUint(end, 10)),
+		}
+
+		response, err := c.consumer.Querier.Query(request, channel.WithParentContext(ctx))
+		if err != nil {
+			log.Debug("Query messages, fetching them one by one", "fcn", fcn, "error", err.Error())
+			return messages
+		}
+		var payloads []json.RawMessage
+		if err := json.Unmarshal(response.Payload, &payloads); err != nil {
+			log.Warn("Unmarshal messages, fetching them one by one", "fcn", fcn, "error", err.Error())
+			return messages
+		}
+		for _, payload := range payloads {
+			stored := storedMessage{}
+			if err := json.Unmarshal(payload, &stored); err != nil || stored.TxID == "" || stored.Index < start || stored.Index > end {
+				continue
+			}
+			messages[stored.Index] = rangedMessage{txID: fab.TransactionID(stored.TxID), payload: payload}
+		}
+	}
+
+	return messages
+}
+
+// rangeGetter gets the messages from through to of servicePair from the
+// broker in one call, returning the getter handing them out.
+type rangeGetter func(ctx context.Context, servicePair string, from, to uint64) messageGetter
+
+// getOutMessages gets the out messages from through to of servicePair in one
+// call, each proven with the transaction that stored it. Messages missing
+// from the range are got one by one.
+func (c *Client) getOutMessages(ctx context.Context, servicePair string, from, to uint64) messageGetter {
+	messages := c.queryMessages(ctx, GetOutMessagesMethod, servicePair, from, to)
+
+	return func(ctx context.Context, servicePair string, idx uint64) (*pb.IBTP, error) {
+		message, ok := messages[idx]
+		if !ok {
+			return c.getOutMessage(ctx, servicePair, idx)
+		}
+		proof, err := c.getProof(ctx, ibtpLogger(c.logger, servicePair, idx, pb.IBTP_INTERCHAIN.String()), message.txID)
+		if err != nil {
+			return nil, err
+		}
+		return c.unpackIBTP(&channel.Response{Payload: message.payload}, pb.IBTP_INTERCHAIN, proof)
+	}
+}
+
+// getReceiptMessages gets the receipts from through to of servicePair in one
+// call, each proven with the transaction that stored it. Receipts missing
+// from the range are got one by one.
+func (c *Client) getReceiptMessages(ctx context.Context, servicePair string, from, to uint64) messageGetter {
+	messages := c.queryMessages(ctx, GetInMessagesMethod, servicePair, from, to)
+
+	return func(ctx context.Context, servicePair string, idx uint64) (*pb.IBTP, error) {
+		message, ok := messages[idx]
+		if !ok {
+			return c.getReceiptMessage(ctx, servicePair, idx)
+		}
+		receipt := &Receipt{}
+		if err := json.Unmarshal(message.payload, receipt); err != nil {
+			return nil, &MalformedEventError{Payload: message.payload, Err: fmt.Errorf("unmarshal receipt: %w", err)}
+		}
+		proof, err := c.getProof(ctx, ibtpLogger(c.logger, servicePair, idx, logTypeReceipt), message.txID)
+		if err != nil {
+			return nil, err
+		}
+		return c.receiptMessage(servicePair, idx, receipt, message.payload, proof)
+	}
 }
 
 func (c *Client) InvokeIndexUpdate(from string, index uint64, serviceId string, category pb.IBTP_Category) (*channel.Response, *Response, error) {
@@ -765,7 +1607,7 @@ func (c *Client) InvokeIndexUpdate(from string, index uint64, serviceId string,
 		Args:        args,
 	}
 
-	res, err := c.consumer.ChannelClient.Execute(request)
+	res, err := c.consumer.Executor.Execute(request, channel.WithParentContext(c.ctx))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -779,7 +1621,7 @@ func (c *Client) InvokeIndexUpdate(from string, index uint64, serviceId string,
 }
 
 func (c *Client) GetSrcRollbackMeta() (map[string]uint64, error) {
-	panic("implement me")
+	return nil, fmt.Errorf("get src rollback meta: %w", ErrNotSupported)
 }
 
 func (c *Client) GetDstRollbackMeta() (map[string]uint64, error) {
@@ -789,7 +1631,7 @@ func (c *Client) GetDstRollbackMeta() (map[string]uint64, error) {
 	}
 
 	var response channel.Response
-	response, err := c.consumer.ChannelClient.Query(request)
+	response, err := c.consumer.Querier.Query(request, channel.WithParentContext(c.ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -804,7 +1646,7 @@ func (c *Client) GetServices() ([]string, error) {
 	}
 
 	var response channel.Response
-	response, err := c.consumer.ChannelClient.Query(request)
+	response, err := c.consumer.Querier.Query(request, channel.WithParentContext(c.ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -827,7 +1669,7 @@ func (c *Client) GetChainID() (string, string, error) {
 		Fcn:         GetChainId,
 	}
 
-	response, err := c.consumer.ChannelClient.Query(request)
+	response, err := c.consumer.Querier.Query(request, channel.WithParentContext(c.ctx))
 	if err != nil || response.Payload == nil {
 		return "", "", err
 	}
@@ -841,9 +1683,13 @@ func (c *Client) GetChainID() (string, string, error) {
 func (c *Client) unpackIBTP(response *channel.Response, ibtpType pb.IBTP_Type, proof []byte) (*pb.IBTP, error) {
 	ret := &Event{}
 	if err := json.Unmarshal(response.Payload, ret); err != nil {
-		return nil, err
+		return nil, &MalformedEventError{Payload: response.Payload, Err: fmt.Errorf("unmarshal event: %w", err)}
+	}
+	config := c.currentConfig()
+	ibtp, err := ret.Convert2IBTP(config.Fabric.TimeoutHeight, ibtpType, c.cryptor, config.Fabric.payloadVersion())
+	if err != nil {
+		return nil, &MalformedEventError{Payload: response.Payload, Err: err}
 	}
-	ibtp := ret.Convert2IBTP(c.timeoutHeight, ibtpType)
 	ibtp.Proof = proof
 	return ibtp, nil
 }
@@ -874,7 +1720,7 @@ func (c *Client) GetAppchainInfo(chainID string) (string, []byte, string, error)
 		Args:        args,
 	}
 	var response channel.Response
-	response, err := c.consumer.ChannelClient.Execute(request)
+	response, err := c.consumer.Querier.Query(request, channel.WithParentContext(c.ctx))
 	if err != nil {
 		return "", nil, "", err
 	}
@@ -886,15 +1732,22 @@ func (c *Client) GetAppchainInfo(chainID string) (string, []byte, string, error)
 }
 
 type handler struct {
+	ctx         context.Context
+	logger      hclog.Logger
+	tracing     *Tracing
 	eventFilter string
 	eventC      chan *pb.IBTP
+	deadLetters *DeadLetterStore
 	ID          string
 }
 
-func newFabricHandler(eventFilter string, eventC chan *pb.IBTP) (*handler, error) {
+func newFabricHandler(ctx context.Context, logger hclog.Logger, eventFilter string, eventC chan *pb.IBTP, deadLetters *DeadLetterStore) (*handler, error) {
 	return &handler{
+		ctx:         ctx,
+		logger:      logger,
 		eventC:      eventC,
 		eventFilter: eventFilter,
+		deadLetters: deadLetters,
 	}, nil
 }
 
@@ -902,11 +1755,55 @@ func (h *handler) HandleMessage(deliveries *fab.CCEvent, payload []byte) {
 	if deliveries.EventName == h.eventFilter {
 		e := &pb.IBTP{}
 		if err := e.Unmarshal(deliveries.Payload); err != nil {
+			h.logger.Error("Malformed chaincode event", "tx_id", deliveries.TxID, "error", err.Error())
+			letter := &DeadLetter{
+				ID:        deadLetterID(DeadLetterEvent, deliveries.TxID, 0),
+				Kind:      DeadLetterEvent,
+				TxID:      deliveries.TxID,
+				Payload:   deliveries.Payload,
+				Proof:     payload,
+				Error:     err.Error(),
+				Timestamp: time.Now().UnixNano(),
+			}
+			if err := h.deadLetters.Put(letter); err != nil {
+				h.logger.Error("Store dead letter", "id", letter.ID, "error", err.Error())
+			}
 			return
 		}
 		e.Proof = payload
+		ibtpLogger(h.logger, genServicePair(e.From, e.To), e.Index, e.Type.String()).Debug("Received chaincode event", "tx_id", deliveries.TxID)
+		_, span := h.tracing.start(h.ctx, "ReceiveEvent", append(ibtpAttributes(genServicePair(e.From, e.To), e.Index, e.Type.String()),
+			attribute.String("fabric.tx_id", deliveries.TxID))...)
+		span.End()
 
-		h.eventC <- e
+		select {
+		case h.eventC <- e:
+			h.tracing.emit(span.SpanContext(), e)
+		case <-h.ctx.Done():
+		}
+	}
+}
+
+// retryInterval is how long to wait before retrying a failed fabric request.
+func (c *Client) retryInterval() time.Duration {
+	return time.Duration(c.currentConfig().Retry.Interval) * time.Second
+}
+
+// waitWithContext retries every interval until ctx is cancelled.
+func waitWithContext(ctx context.Context, interval time.Duration) strategy.Strategy {
+	return func(attempt uint) bool {
+		if attempt == 0 {
+			return ctx.Err() == nil
+		}
+
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return true
+		case <-ctx.Done():
+			return false
+		}
 	}
 }
 
@@ -933,16 +1830,15 @@ func genServicePair(from, to string) string {
 }
 
 func (c *Client) GetOffChainData(request *pb.GetDataRequest) (*pb.OffChainDataInfo, error) {
-	//TODO implement me
-	panic("implement me")
+	return nil, fmt.Errorf("get off-chain data: %w", ErrNotSupported)
 }
 
+// GetOffChainDataReq returns a nil channel, the plugin never requests
+// off-chain data.
 func (c *Client) GetOffChainDataReq() chan *pb.GetDataRequest {
-	//TODO implement me
-	panic("implement me")
+	return nil
 }
 
 func (c *Client) SubmitOffChainData(response *pb.GetDataResponse) error {
-	//TODO implement me
-	panic("implement me")
+	return fmt.Errorf("submit off-chain data: %w", ErrNotSupported)
 }
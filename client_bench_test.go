@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	txnmocks "github.com/hyperledger/fabric-sdk-go/pkg/client/common/mocks"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/ledger"
+	sdkcontext "github.com/hyperledger/fabric-sdk-go/pkg/common/providers/context"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	contextImpl "github.com/hyperledger/fabric-sdk-go/pkg/context"
+	fcmocks "github.com/hyperledger/fabric-sdk-go/pkg/fab/mocks"
+	mspmocks "github.com/hyperledger/fabric-sdk-go/pkg/msp/test/mockmsp"
+	"github.com/meshplus/bitxhub-model/pb"
+)
+
+// benchBacklog is how many out-messages the polling benchmarks drain.
+const benchBacklog = 2000
+
+// perCallClients are the simulator clients behind a channel or ledger
+// client created for every request, the way the plugin did before sharing
+// them.
+type perCallClients struct {
+	Clients
+	channelProvider sdkcontext.ChannelProvider
+}
+
+func newPerCallClients(b *testing.B, clients Clients) *perCallClients {
+	ctx := fcmocks.NewMockContext(mspmocks.NewMockSigningIdentity("bench", fakeUserMSP))
+	chProvider, err := fcmocks.NewMockChannelProvider(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	chService, err := chProvider.ChannelService(ctx, fakeChannel)
+	if err != nil {
+		b.Fatal(err)
+	}
+	chService.(*fcmocks.MockChannelService).SetTransactor(&txnmocks.MockTransactor{Ctx: ctx, ChannelID: fakeChannel})
+	chService.(*fcmocks.MockChannelService).SetDiscovery(txnmocks.NewMockDiscoveryService(nil))
+	ctx.MockProviderContext.ChannelProvider().(*fcmocks.MockChannelProvider).SetCustomChannelService(chService)
+
+	return &perCallClients{
+		Clients: clients,
+		channelProvider: func() (sdkcontext.Channel, error) {
+			return contextImpl.NewChannel(func() (sdkcontext.Client, error) { return ctx, nil }, fakeChannel)
+		},
+	}
+}
+
+func (c *perCallClients) clients() Clients {
+	return Clients{Executor: c, Querier: c, Ledger: c, Events: c.Events}
+}
+
+func (c *perCallClients) Execute(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	if _, err := channel.New(c.channelProvider); err != nil {
+		return channel.Response{}, err
+	}
+	return c.Executor.Execute(request, options...)
+}
+
+func (c *perCallClients) Query(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	if _, err := channel.New(c.channelProvider); err != nil {
+		return channel.Response{}, err
+	}
+	return c.Querier.Query(request, options...)
+}
+
+func (c *perCallClients) QueryTransaction(txID fab.TransactionID, options ...ledger.RequestOption) (*peer.ProcessedTransaction, error) {
+	if _, err := ledger.New(c.channelProvider); err != nil {
+		return nil, err
+	}
+	return c.Ledger.QueryTransaction(txID, options...)
+}
+
+func (c *perCallClients) QueryBlockByTxID(txID fab.TransactionID, options ...ledger.RequestOption) (*common.Block, error) {
+	if _, err := ledger.New(c.channelProvider); err != nil {
+		return nil, err
+	}
+	return c.Ledger.QueryBlockByTxID(txID, options...)
+}
+
+func (c *perCallClients) QueryConfig(options ...ledger.RequestOption) (fab.ChannelCfg, error) {
+	if _, err := ledger.New(c.channelProvider); err != nil {
+		return nil, err
+	}
+	return c.Ledger.QueryConfig(options...)
+}
+
+// BenchmarkPollPairs drains a backlog of out-messages of the simulator, the
+// way the plugin catches up after being down, with fabric clients shared or
+// created for every request.
+//
+//	go test -run xxx -bench PollPairs -benchtime 5x
+func BenchmarkPollPairs(b *testing.B) {
+	f := newFakeFabric(b)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", strconv.Itoa(benchBacklog))
+	for i := 0; i < benchBacklog; i++ {
+		f.mustInvoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "1")
+	}
+
+	for _, mode := range []string{"shared", "per-call"} {
+		for _, workers := range []int{1, 8} {
+			b.Run(fmt.Sprintf("%s/workers=%d", mode, workers), func(b *testing.B) {
+				clients := f.Clients()
+				if mode == "per-call" {
+					clients = newPerCallClients(b, clients).clients()
+				}
+				benchmarkPollPairs(b, newTestClientWith(b, clients), workers)
+			})
+		}
+	}
+}
+
+func benchmarkPollPairs(b *testing.B, c *Client, workers int) {
+	c.config.Polling.BatchSize = benchBacklog
+	c.config.Polling.Workers = workers
+	meta := map[string]uint64{genServicePair(testLocal, testRemote): benchBacklog}
+
+	// the drain goroutine exits once ctx is cancelled or the ibtp channel
+	// is closed, which the cleanup waits for even if the benchmark fails
+	ctx, cancel := context.WithCancel(context.Background())
+	drained := make(chan uint64)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		var n uint64
+		for ibtp := range c.GetIBTPCh() {
+			if n++; ibtp.Index != benchBacklog {
+				continue
+			}
+			select {
+			case drained <- n:
+			case <-ctx.Done():
+				return
+			}
+			n = 0
+		}
+	}()
+	b.Cleanup(func() {
+		cancel()
+		_ = c.Stop()
+		wg.Wait()
+	})
+
+	goroutines := runtime.NumGoroutine()
+	start := time.Now()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// poll the backlog again from the first message
+		c.serviceMeta[testLocal] = &pb.Interchain{
+			ID:                testLocal,
+			InterchainCounter: map[string]uint64{testRemote: 0},
+			ReceiptCounter:    make(map[string]uint64),
+		}
+		if !c.pollPairs(ctx, DeadLetterInterchain, meta, c.getOutMessages) {
+			b.Fatal("polling cancelled")
+		}
+		if n := <-drained; n != benchBacklog {
+			b.Fatalf("got %d ibtps, want %d", n, benchBacklog)
+		}
+	}
+	b.StopTimer()
+	b.ReportMetric(float64(b.N*benchBacklog)/time.Since(start).Seconds(), "ibtps/s")
+	b.ReportMetric(float64(runtime.NumGoroutine()-goroutines), "goroutines")
+}
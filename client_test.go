@@ -0,0 +1,939 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/ledger"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/meshplus/bitxhub-core/validator/validatorlib"
+	"github.com/meshplus/bitxhub-model/pb"
+	"github.com/meshplus/pier-client-fabric/codec"
+	"github.com/meshplus/pier-client-fabric/payload"
+	"github.com/meshplus/pier-client-fabric/proof"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/urfave/cli"
+)
+
+const (
+	testTransferCID = fakeChannel + "&transfer"
+	testLocal       = "1356:appchain1:" + testTransferCID
+	testRemote      = "1356:appchain2:" + testTransferCID
+)
+
+// writeTestConfig writes a plugin config talking to the broker chaincode
+// and returns its dir.
+func writeTestConfig(t testing.TB) string {
+	dir := t.TempDir()
+	config := "[fabric]\nname = \"fabric\"\nccid = \"broker\"\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, ConfigName), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return dir
+}
+
+func newTestClient(t testing.TB, f *fakeFabric) *Client {
+	return newTestClientWith(t, f.Clients())
+}
+
+// newTestClientWith is newTestClient talking to fabric through clients.
+func newTestClientWith(t testing.TB, clients Clients) *Client {
+	c := &Client{}
+	err := c.initialize(writeTestConfig(t), func(ctx context.Context, meta *ContractMeta, msgH MessageHandler) (*Consumer, error) {
+		return newConsumer(ctx, meta, msgH, clients), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = c.Stop()
+	})
+
+	return c
+}
+
+func transferContent(sender, receiver string, amount uint64) *pb.Content {
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, amount)
+	return &pb.Content{
+		Func: "interchainCharge",
+		Args: codec.Encode(0, codec.String(sender), codec.String(receiver), codec.Bytes(v)),
+	}
+}
+
+func ibtpContent(t *testing.T, ibtp *pb.IBTP) *pb.Content {
+	t.Helper()
+	pd := &pb.Payload{}
+	if err := pd.Unmarshal(ibtp.Payload); err != nil {
+		t.Fatal(err)
+	}
+	content := &pb.Content{}
+	if err := content.Unmarshal(pd.Content); err != nil {
+		t.Fatal(err)
+	}
+
+	return content
+}
+
+func TestPollingOutMessage(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+	c.ticker = time.NewTicker(10 * time.Millisecond)
+
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", "100")
+	f.mustInvoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "10")
+
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	var ibtp *pb.IBTP
+	select {
+	case ibtp = <-c.GetIBTPCh():
+	case <-time.After(5 * time.Second):
+		t.Fatal("no ibtp polled")
+	}
+
+	if ibtp.From != testLocal || ibtp.To != testRemote || ibtp.Index != 1 || ibtp.Type != pb.IBTP_INTERCHAIN {
+		t.Fatalf("unexpected ibtp %s", ibtp.ID())
+	}
+	if content := ibtpContent(t, ibtp); content.Func != "interchainCharge" {
+		t.Fatalf("unexpected content func %s", content.Func)
+	}
+	if err := proto.Unmarshal(ibtp.Proof, &peer.ChaincodeActionPayload{}); err != nil || len(ibtp.Proof) == 0 {
+		t.Fatalf("proof is not a chaincode action payload: %v", err)
+	}
+}
+
+// slowLedger answers the first proof queries the slowest, keeping track of
+// how many run at the same time.
+type slowLedger struct {
+	Ledger
+	queries     int32
+	inFlight    int32
+	maxInFlight int32
+}
+
+func (l *slowLedger) QueryTransaction(txID fab.TransactionID, options ...ledger.RequestOption) (*peer.ProcessedTransaction, error) {
+	n := atomic.AddInt32(&l.inFlight, 1)
+	defer atomic.AddInt32(&l.inFlight, -1)
+	for max := atomic.LoadInt32(&l.maxInFlight); n > max && !atomic.CompareAndSwapInt32(&l.maxInFlight, max, n); {
+		max = atomic.LoadInt32(&l.maxInFlight)
+	}
+	q := atomic.AddInt32(&l.queries, 1)
+	time.Sleep(time.Duration(10-q%10) * 10 * time.Millisecond)
+
+	return l.Ledger.QueryTransaction(txID, options...)
+}
+
+// blockCountingLedger counts the blocks queried.
+type blockCountingLedger struct {
+	Ledger
+	queries int32
+}
+
+func (l *blockCountingLedger) QueryBlockByTxID(txID fab.TransactionID, options ...ledger.RequestOption) (*common.Block, error) {
+	atomic.AddInt32(&l.queries, 1)
+	return l.Ledger.QueryBlockByTxID(txID, options...)
+}
+
+// countingClients counts the broker functions executed and queried.
+type countingClients struct {
+	Executor
+	Querier
+	calls sync.Map
+}
+
+func (c *countingClients) count(fcn string) int32 {
+	n, _ := c.calls.LoadOrStore(fcn, new(int32))
+	return atomic.LoadInt32(n.(*int32))
+}
+
+func (c *countingClients) called(fcn string) {
+	n, _ := c.calls.LoadOrStore(fcn, new(int32))
+	atomic.AddInt32(n.(*int32), 1)
+}
+
+func (c *countingClients) Execute(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	c.called(request.Fcn)
+	return c.Executor.Execute(request, options...)
+}
+
+func (c *countingClients) Query(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	c.called(request.Fcn)
+	return c.Querier.Query(request, options...)
+}
+
+// oldBroker answers no range queries, as brokers predating them.
+type oldBroker struct {
+	Querier
+}
+
+func (b oldBroker) Query(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	if request.Fcn == GetOutMessagesMethod || request.Fcn == GetInMessagesMethod {
+		return channel.Response{}, errors.New(chaincodeErrorStatus + " invalid function: " + request.Fcn)
+	}
+
+	return b.Querier.Query(request, options...)
+}
+
+// unprovenMessage drops the transaction of message index from the range
+// answers of the broker, as brokers predating it stored the messages.
+type unprovenMessage struct {
+	Querier
+	index uint64
+}
+
+func (q unprovenMessage) Query(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	res, err := q.Querier.Query(request, options...)
+	if err != nil || request.Fcn != GetOutMessagesMethod {
+		return res, err
+	}
+
+	var messages []map[string]interface{}
+	if err := json.Unmarshal(res.Payload, &messages); err != nil {
+		return res, err
+	}
+	for _, m := range messages {
+		if m["index"] == float64(q.index) {
+			delete(m, "tx_id")
+		}
+	}
+	res.Payload, err = json.Marshal(messages)
+	return res, err
+}
+
+// pollTransfers sends count transfers once c polls the broker, and checks c
+// hands them to pier in order with proofs bitxhub accepts.
+func pollTransfers(t *testing.T, f *fakeFabric, c *Client, count uint64) {
+	t.Helper()
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", "100")
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	// the plugin polls the messages sent after it saw the service pair
+	for i := uint64(1); i <= count; i++ {
+		f.mustInvoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "1")
+		if i != 1 {
+			continue
+		}
+		select {
+		case ibtp := <-c.GetIBTPCh():
+			if err := f.verify(ibtp); err != nil {
+				t.Fatalf("ibtp 1: %v", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("ibtp 1 not polled")
+		}
+	}
+
+	for i := uint64(2); i <= count; i++ {
+		select {
+		case ibtp := <-c.GetIBTPCh():
+			if ibtp.Index != i {
+				t.Fatalf("got ibtp %d, want %d", ibtp.Index, i)
+			}
+			if err := f.verify(ibtp); err != nil {
+				t.Fatalf("ibtp %d: %v", i, err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("ibtp %d not polled", i)
+		}
+	}
+}
+
+func TestPollingConcurrentInOrder(t *testing.T) {
+	f := newFakeFabric(t)
+	clients := f.Clients()
+	counting := &countingClients{Executor: clients.Executor, Querier: clients.Querier}
+	slow := &slowLedger{Ledger: clients.Ledger}
+	clients.Executor, clients.Querier, clients.Ledger = counting, counting, slow
+	c := newTestClientWith(t, clients)
+	c.config.Polling.BatchSize = 3
+	c.config.Polling.Workers = 3
+	c.ticker = time.NewTicker(10 * time.Millisecond)
+
+	pollTransfers(t, f, c, 6)
+	if max := atomic.LoadInt32(&slow.maxInFlight); max < 2 || max > 3 {
+		t.Fatalf("got %d proof queries at the same time, want 2 to 3", max)
+	}
+	if n := counting.count(GetOutMessageMethod); n != 0 {
+		t.Fatalf("got %d single message lookups, want messages got by range", n)
+	}
+	if counting.count(GetOutMessagesMethod) == 0 {
+		t.Fatal("no range query")
+	}
+}
+
+func TestPollingOldBroker(t *testing.T) {
+	f := newFakeFabric(t)
+	clients := f.Clients()
+	counting := &countingClients{Executor: clients.Executor, Querier: oldBroker{clients.Querier}}
+	clients.Executor, clients.Querier = counting, counting
+	c := newTestClientWith(t, clients)
+	c.ticker = time.NewTicker(10 * time.Millisecond)
+
+	pollTransfers(t, f, c, 3)
+	if n := counting.count(GetOutMessageMethod); n < 3 {
+		t.Fatalf("got %d single message lookups, want one per message", n)
+	}
+}
+
+func TestSubmitIBTP(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "bob", "0")
+
+	// the interchain times out at another height than the one configured
+	meta := interchainMeta{TimeoutHeight: c.config.Fabric.TimeoutHeight + 47, Group: map[string]uint64{"b": 2, "a": 1}}
+	ret, err := c.submitIBTPWithMeta(meta, testRemote, 1, testTransferCID, pb.IBTP_INTERCHAIN,
+		transferContent("carol", "bob", 10), &pb.BxhProof{TxStatus: pb.TransactionStatus_BEGIN}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ret.Status {
+		t.Fatalf("submit ibtp: %s", ret.Message)
+	}
+	if got := f.state("transfer", "bob"); got != "10" {
+		t.Fatalf("balance of bob: got %s, want 10", got)
+	}
+
+	receipt := ret.Result
+	if receipt == nil || receipt.From != testRemote || receipt.To != testLocal || receipt.Index != 1 ||
+		receipt.Type != pb.IBTP_RECEIPT_SUCCESS || len(receipt.Proof) == 0 || receipt.TimeoutHeight != meta.TimeoutHeight {
+		t.Fatalf("unexpected receipt %+v", receipt)
+	}
+	if g := receipt.Group; g == nil || strings.Join(g.Keys, ",") != "a,b" || len(g.Vals) != 2 || g.Vals[0] != 1 || g.Vals[1] != 2 {
+		t.Fatalf("receipt carries group %+v, want %v", g, meta.Group)
+	}
+
+	// the timeout of the interchain is kept with the receipt, not re-read
+	// from the config
+	c.config.Fabric.TimeoutHeight++
+	polled, err := c.GetReceiptMessage(genServicePair(testRemote, testLocal), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if polled.ID() != receipt.ID() || polled.Type != receipt.Type || string(polled.Payload) != string(receipt.Payload) ||
+		polled.TimeoutHeight != receipt.TimeoutHeight || polled.Timestamp != receipt.Timestamp || polled.Group.String() != receipt.Group.String() {
+		t.Fatalf("polled receipt %+v differs from %+v", polled, receipt)
+	}
+	// both are proven by the broker transaction executing the interchain,
+	// which their hashed extra does not name
+	if !bytes.Equal(polled.Proof, receipt.Proof) {
+		t.Fatal("polled receipt proven by another transaction")
+	}
+	cap := &peer.ChaincodeActionPayload{}
+	if err := proto.Unmarshal(polled.Proof, cap); err != nil || cap.Action == nil {
+		t.Fatalf("proof is not a chaincode action payload: %v", err)
+	}
+	if err := validatorlib.ValidateChainCodeID(cap.Action.ProposalResponsePayload, "broker"); err != nil {
+		t.Fatal(err)
+	}
+	if len(polled.Extra) != 0 || len(receipt.Extra) != 0 {
+		t.Fatalf("receipts carry extra %q and %q", polled.Extra, receipt.Extra)
+	}
+}
+
+func TestGetOutMessageVerifies(t *testing.T) {
+	f := newFakeFabric(t)
+	clients := f.Clients()
+	blocks := &blockCountingLedger{Ledger: clients.Ledger}
+	clients.Ledger = blocks
+	c := newTestClientWith(t, clients)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", "100")
+	f.mustInvoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "10")
+
+	height := f.Height()
+	ibtp, err := c.GetOutMessage(genServicePair(testLocal, testRemote), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Height() != height {
+		t.Fatal("looking up the out message committed a transaction")
+	}
+	if ibtp.Timestamp == 0 {
+		t.Fatal("out message carries no timestamp")
+	}
+	// the origin is in the proof, not in the hashed extra, and takes no
+	// block lookup in the payload format
+	if len(ibtp.Extra) != 0 {
+		t.Fatalf("out message carries extra %q", ibtp.Extra)
+	}
+	if n := atomic.LoadInt32(&blocks.queries); n != 0 {
+		t.Fatalf("got %d block queries, want none", n)
+	}
+	if err := f.verify(ibtp); err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := *ibtp
+	tampered.Index = 2
+	if err := f.verify(&tampered); err == nil {
+		t.Fatal("ibtp with another index verified")
+	}
+	tampered = *ibtp
+	pd := &pb.Payload{}
+	if err := pd.Unmarshal(ibtp.Payload); err != nil {
+		t.Fatal(err)
+	}
+	pd.Content, _ = transferContent("alice", "mallory", 10).Marshal()
+	tampered.Payload, _ = pd.Marshal()
+	if err := f.verify(&tampered); err == nil {
+		t.Fatal("ibtp with other args verified")
+	}
+}
+
+// A polled out message is proven, in either format, by the transaction of the
+// business chaincode that had the broker store it, which proves no other
+// message.
+func TestGetOutMessageOriginProof(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", "100")
+	var txIDs []fab.TransactionID
+	for i := 0; i < 2; i++ {
+		txID, res := f.Invoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "10")
+		if res.Status != shim.OK {
+			t.Fatal(res.Message)
+		}
+		txIDs = append(txIDs, txID)
+	}
+
+	for _, format := range []string{ProofFormatPayload, ProofFormatEnvelope} {
+		t.Run(format, func(t *testing.T) {
+			c.config.Fabric.ProofFormat = format
+			var ibtps []*pb.IBTP
+			for i, txID := range txIDs {
+				ibtp, err := c.GetOutMessage(genServicePair(testLocal, testRemote), uint64(i+1))
+				if err != nil {
+					t.Fatal(err)
+				}
+				want, err := c.getProof(context.Background(), c.logger, txID)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(ibtp.Proof, want) {
+					t.Fatalf("ibtp %d is not proven by transaction %s", ibtp.Index, txID)
+				}
+				if proof.IsEnvelopeProof(ibtp.Proof) != (format == ProofFormatEnvelope) {
+					t.Fatalf("ibtp %d proof is not in %s format", ibtp.Index, format)
+				}
+				if err := f.verify(ibtp); err != nil {
+					t.Fatalf("ibtp %d: %v", ibtp.Index, err)
+				}
+				ibtps = append(ibtps, ibtp)
+			}
+
+			swapped := *ibtps[0]
+			swapped.Proof = ibtps[1].Proof
+			if err := f.verify(&swapped); err == nil {
+				t.Fatal("ibtp verified with the proof of another message")
+			}
+		})
+	}
+}
+
+// Polling gets a batch of out messages with one range query, proving each by
+// the transaction that stored it, and looks up one by one only the messages
+// the range answer can't prove.
+func TestPollRangeQuery(t *testing.T) {
+	f := newFakeFabric(t)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", "100")
+	for i := 0; i < 5; i++ {
+		f.mustInvoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "1")
+	}
+	clients := f.Clients()
+	q := &countingClients{Executor: clients.Executor, Querier: unprovenMessage{Querier: clients.Querier, index: 3}}
+	clients.Querier = q
+	c := newTestClientWith(t, clients)
+	c.config.Polling.BatchSize = 5
+	c.serviceMeta[testLocal] = &pb.Interchain{
+		ID:                testLocal,
+		InterchainCounter: map[string]uint64{testRemote: 0},
+		ReceiptCounter:    make(map[string]uint64),
+	}
+
+	alive := make(chan bool, 1)
+	go func() {
+		alive <- c.pollPairs(context.Background(), DeadLetterInterchain, map[string]uint64{genServicePair(testLocal, testRemote): 5}, c.getOutMessages)
+	}()
+	for i := uint64(1); i <= 5; i++ {
+		select {
+		case ibtp := <-c.GetIBTPCh():
+			if ibtp.Index != i {
+				t.Fatalf("got ibtp %d, want %d", ibtp.Index, i)
+			}
+			if err := f.verify(ibtp); err != nil {
+				t.Fatalf("ibtp %d: %v", i, err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("ibtp %d not polled", i)
+		}
+	}
+	if !<-alive {
+		t.Fatal("polling cancelled")
+	}
+	if n := q.count(GetOutMessagesMethod); n != 1 {
+		t.Fatalf("queried %d ranges, want 1", n)
+	}
+	if n := q.count(GetOutMessageMethod); n != 1 {
+		t.Fatalf("looked up %d messages one by one, want the unproven one", n)
+	}
+}
+
+// The payload hash of a polled message is taken over the call the broker
+// recorded, packed as the configured version says.
+func TestGetOutMessagePayloadHash(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", "100")
+	f.mustInvoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "10")
+
+	for _, tt := range []struct {
+		format string
+		pack   func(fields [][]byte) []byte
+	}{
+		{PayloadHashV1, func(fields [][]byte) []byte { return payload.Encode(payload.KindInterchain, fields) }},
+		{PayloadHashLegacy, func(fields [][]byte) []byte { return bytes.Join(fields, nil) }},
+	} {
+		c.config.Fabric.PayloadHash = tt.format
+		ibtp, err := c.GetOutMessage(genServicePair(testLocal, testRemote), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pd := &pb.Payload{}
+		if err := pd.Unmarshal(ibtp.Payload); err != nil {
+			t.Fatal(err)
+		}
+		content := ibtpContent(t, ibtp)
+		want := crypto.Keccak256(tt.pack(append([][]byte{[]byte(content.Func)}, content.Args...)))
+		if !bytes.Equal(pd.Hash, want) {
+			t.Errorf("%s: got hash %x, want %x", tt.format, pd.Hash, want)
+		}
+	}
+}
+
+func TestSubmitIBTPDuplicateIndex(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "bob", "0")
+
+	for i, want := range []bool{true, false} {
+		ret, err := c.SubmitIBTP(testRemote, 1, testTransferCID, pb.IBTP_INTERCHAIN,
+			transferContent("carol", "bob", 10), &pb.BxhProof{TxStatus: pb.TransactionStatus_BEGIN}, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ret.Status != want {
+			t.Fatalf("submission %d: got status %v, want %v: %s", i, ret.Status, want, ret.Message)
+		}
+	}
+	if got := f.state("transfer", "bob"); got != "10" {
+		t.Fatalf("balance of bob: got %s, want 10", got)
+	}
+}
+
+func TestSubmitIBTPInvalidArgs(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+
+	content := &pb.Content{Func: "interchainCharge", Args: [][]byte{[]byte("bad header")}}
+	ret, err := c.SubmitIBTP(testRemote, 1, testTransferCID, pb.IBTP_INTERCHAIN,
+		content, &pb.BxhProof{TxStatus: pb.TransactionStatus_BEGIN}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.Status || !strings.Contains(ret.Message, "invalid args") {
+		t.Fatalf("unexpected response %+v", ret)
+	}
+}
+
+func TestSubmitReceiptRollback(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", "100")
+	f.mustInvoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "10")
+
+	ret, err := c.SubmitReceipt(testRemote, 1, testTransferCID, pb.IBTP_RECEIPT_FAILURE,
+		&pb.Result{MultiStatus: []bool{false}}, &pb.BxhProof{TxStatus: pb.TransactionStatus_FAILURE})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ret.Status {
+		t.Fatalf("submit receipt: %s", ret.Message)
+	}
+	if got := f.state("transfer", "alice"); got != "100" {
+		t.Fatalf("balance of alice: got %s, want 100", got)
+	}
+
+	callbackMeta, err := c.GetCallbackMeta()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := callbackMeta[genServicePair(testLocal, testRemote)]; got != 1 {
+		t.Fatalf("callback meta: got %d, want 1", got)
+	}
+}
+
+func TestUnpackIBTPMalformed(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+
+	_, err := c.unpackIBTP(&channel.Response{Payload: []byte("{")}, pb.IBTP_INTERCHAIN, nil)
+	var merr *MalformedEventError
+	if !errors.As(err, &merr) {
+		t.Fatalf("got %v, want a malformed event error", err)
+	}
+
+	get := func(context.Context, string, uint64) (*pb.IBTP, error) {
+		return nil, err
+	}
+	if _, err := c.fetchMessage(c.ctx, DeadLetterInterchain, genServicePair(testLocal, testRemote), 1, get); !errors.As(err, &merr) {
+		t.Fatalf("got %v, want a malformed event error", err)
+	}
+	if _, err := c.fetchMessage(c.ctx, DeadLetterInterchain, genServicePair(testLocal, testRemote), 1, get); !errors.Is(err, ErrDeadLetter) {
+		t.Fatalf("got %v, want %v", err, ErrDeadLetter)
+	}
+}
+
+// waitFor fails the test if cond does not hold within 5 seconds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// corruptingQuerier garbles the out messages the broker answers with while
+// broken is set.
+type corruptingQuerier struct {
+	Querier
+	broken int32
+}
+
+func (q *corruptingQuerier) Query(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	res, err := q.Querier.Query(request, options...)
+	if err == nil && (request.Fcn == GetOutMessageMethod || request.Fcn == GetOutMessagesMethod) && atomic.LoadInt32(&q.broken) == 1 {
+		res.Payload = []byte("{")
+	}
+	return res, err
+}
+
+// A malformed out message is parked, reported, and delivered once the
+// dead-letter command marks it for redrive.
+func TestDeadLetterRedrive(t *testing.T) {
+	f := newFakeFabric(t)
+	clients := f.Clients()
+	querier := &corruptingQuerier{Querier: clients.Querier, broken: 1}
+	clients.Querier = querier
+	c := newTestClientWith(t, clients)
+	c.ticker = time.NewTicker(10 * time.Millisecond)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", "100")
+	f.mustInvoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "10")
+
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	id := deadLetterID(DeadLetterInterchain, genServicePair(testLocal, testRemote), 1)
+	waitFor(t, "parked dead letter reported", func() bool {
+		return c.health.Report().DeadLetters == 1 &&
+			testutil.ToFloat64(c.metrics.deadLetters.WithLabelValues(DeadLetterInterchain)) == 1
+	})
+	if r := c.health.Report(); r.Ready || !strings.Contains(strings.Join(r.Problems, ";"), "1 dead letters parked") {
+		t.Fatalf("parked dead letter not reported as a problem: %+v", r)
+	}
+	select {
+	case ibtp := <-c.GetIBTPCh():
+		t.Fatalf("ibtp %s polled past a parked message", ibtp.ID())
+	default:
+	}
+
+	// the broker is fixed and the operator redrives the message
+	atomic.StoreInt32(&querier.broken, 0)
+	app := cli.NewApp()
+	app.Commands = []cli.Command{deadLetterCMD}
+	if err := app.Run([]string{"fabric-plugin", "dead-letter", "redrive", "--config", c.configPath, "--id", id}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case ibtp := <-c.GetIBTPCh():
+		if ibtp.Index != 1 {
+			t.Fatalf("got ibtp %s, want the redriven one", ibtp.ID())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("redriven message not polled")
+	}
+	waitFor(t, "dead letter cleared", func() bool {
+		return c.health.Report().DeadLetters == 0 &&
+			testutil.ToFloat64(c.metrics.deadLetters.WithLabelValues(DeadLetterInterchain)) == 0
+	})
+	if letter, err := c.deadLetters.Get(id); err != nil || letter != nil {
+		t.Fatalf("dead letter kept after redrive: %+v, %v", letter, err)
+	}
+}
+
+// actionlessLedger answers with transactions stripped of their actions.
+type actionlessLedger struct {
+	Ledger
+}
+
+func (l actionlessLedger) QueryTransaction(txID fab.TransactionID, options ...ledger.RequestOption) (*peer.ProcessedTransaction, error) {
+	t, err := l.Ledger.QueryTransaction(txID, options...)
+	if err != nil {
+		return nil, err
+	}
+	payload := &common.Payload{}
+	if err := proto.Unmarshal(t.TransactionEnvelope.Payload, payload); err != nil {
+		return nil, err
+	}
+	payload.Data = protoutil.MarshalOrPanic(&peer.Transaction{})
+	t.TransactionEnvelope.Payload = protoutil.MarshalOrPanic(payload)
+
+	return t, nil
+}
+
+func TestGetProofWithoutAction(t *testing.T) {
+	f := newFakeFabric(t)
+	clients := f.Clients()
+	clients.Ledger = actionlessLedger{clients.Ledger}
+	c := newTestClientWith(t, clients)
+
+	res, err := f.Execute(channel.Request{ChaincodeID: "broker", Fcn: GetChainId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ret, err := c.getProof(c.ctx, c.logger, res.TransactionID)
+	if !errors.Is(err, ErrInvalidTransaction) || ret != nil {
+		t.Fatalf("got proof %x and %v, want %v", ret, err, ErrInvalidTransaction)
+	}
+}
+
+func TestGetProofInvalidTransaction(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+
+	res, err := f.Execute(channel.Request{ChaincodeID: "broker", Fcn: GetChainId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.invalidate(res.TransactionID)
+
+	if _, err := c.getProof(c.ctx, c.logger, res.TransactionID); !errors.Is(err, ErrInvalidTransaction) {
+		t.Fatalf("got %v, want %v", err, ErrInvalidTransaction)
+	}
+}
+
+func TestConsumerHandleWithoutAction(t *testing.T) {
+	f := newFakeFabric(t)
+	clients := f.Clients()
+	clients.Ledger = actionlessLedger{clients.Ledger}
+	c := newTestClientWith(t, clients)
+
+	res, err := f.Execute(channel.Request{ChaincodeID: "broker", Fcn: GetChainId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.consumer.handle(&fab.CCEvent{TxID: string(res.TransactionID)}); err == nil {
+		t.Fatal("handled a transaction without actions")
+	}
+}
+
+func TestStopTwice(t *testing.T) {
+	c := newTestClient(t, newFakeFabric(t))
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := c.Stop(); err != nil {
+			t.Fatalf("stop %d: %v", i, err)
+		}
+	}
+	if _, ok := <-c.GetIBTPCh(); ok {
+		t.Fatal("ibtp channel is still open")
+	}
+}
+
+// blockingQuerier holds message lookups until done is closed.
+type blockingQuerier struct {
+	Querier
+	started chan struct{}
+	once    sync.Once
+	done    <-chan struct{}
+}
+
+func (q *blockingQuerier) Query(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	if request.Fcn != GetOutMessageMethod && request.Fcn != GetOutMessagesMethod {
+		return q.Querier.Query(request, options...)
+	}
+	q.once.Do(func() { close(q.started) })
+	<-q.done
+	return channel.Response{}, context.Canceled
+}
+
+func TestStopWhilePolling(t *testing.T) {
+	f := newFakeFabric(t)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", "10")
+	f.mustInvoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "1")
+	clients := f.Clients()
+	blocking := &blockingQuerier{Querier: clients.Querier, started: make(chan struct{})}
+	clients.Querier = blocking
+	c := newTestClientWith(t, clients)
+	blocking.done = c.ctx.Done()
+	c.ticker = time.NewTicker(10 * time.Millisecond)
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-blocking.started:
+	case <-time.After(5 * time.Second):
+		t.Fatal("polling didn't look the message up")
+	}
+	if err := c.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	for range c.GetIBTPCh() {
+	}
+}
+
+func TestStopTimeout(t *testing.T) {
+	c := newTestClient(t, newFakeFabric(t))
+	c.stopTimeout = 50 * time.Millisecond
+
+	// a producer that ignores cancellation
+	release := make(chan struct{})
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		<-release
+	}()
+	defer close(release)
+
+	start := time.Now()
+	if err := c.Stop(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want %v", err, context.DeadlineExceeded)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Fatalf("stop took %s", d)
+	}
+	select {
+	case _, ok := <-c.GetIBTPCh():
+		t.Fatalf("ibtp channel got an ibtp or was closed (%t) with a producer alive", ok)
+	default:
+	}
+}
+
+func TestFullServiceIDConcurrent(t *testing.T) {
+	f := newFakeFabric(t)
+	counting := &countingQuerier{Querier: f.Clients().Querier}
+	clients := f.Clients()
+	clients.Querier = counting
+	c := newTestClientWith(t, clients)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if id, err := c.fullServiceID(testTransferCID); err != nil || id != testLocal {
+				t.Errorf("got %s and %v, want %s", id, err, testLocal)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := atomic.LoadInt32(&counting.chainIDs); n != 1 {
+		t.Fatalf("looked the chain id up %d times, want once", n)
+	}
+}
+
+// countingQuerier counts the chain id lookups.
+type countingQuerier struct {
+	Querier
+	chainIDs int32
+}
+
+func (q *countingQuerier) Query(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	if request.Fcn == GetChainId {
+		atomic.AddInt32(&q.chainIDs, 1)
+	}
+	return q.Querier.Query(request, options...)
+}
+
+func TestReceiptResults(t *testing.T) {
+	multi, err := json.Marshal([][]byte{[]byte("a,b"), nil, []byte("c")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		config  string
+		format  string
+		payload string
+		want    [][]byte
+		wantErr bool
+	}{
+		{"raw", ReceiptFormatStructured, ReceiptResultRaw, "a,b", [][]byte{[]byte("a,b")}, false},
+		{"raw empty", ReceiptFormatStructured, ReceiptResultRaw, "", [][]byte{{}}, false},
+		{"multi", ReceiptFormatStructured, ReceiptResultMulti, string(multi), [][]byte{[]byte("a,b"), nil, []byte("c")}, false},
+		{"multi empty", ReceiptFormatStructured, ReceiptResultMulti, "[]", [][]byte{}, false},
+		{"multi malformed", ReceiptFormatStructured, ReceiptResultMulti, "a,b", nil, true},
+		{"multi not base64", ReceiptFormatStructured, ReceiptResultMulti, `["a,b"]`, nil, true},
+		{"multi not a list", ReceiptFormatStructured, ReceiptResultMulti, `{"a":"b"}`, nil, true},
+		{"unknown format", ReceiptFormatStructured, "csv", "a,b", nil, true},
+		{"legacy broker", ReceiptFormatStructured, "", "a,b", [][]byte{[]byte("a"), []byte("b")}, false},
+		{"legacy config", ReceiptFormatLegacy, ReceiptResultMulti, "a,b", [][]byte{[]byte("a"), []byte("b")}, false},
+		{"legacy config unknown format", ReceiptFormatLegacy, "csv", "a", [][]byte{[]byte("a")}, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newTestClient(t, newFakeFabric(t))
+			c.config.Fabric.ReceiptFormat = tc.config
+
+			got, err := c.receiptResults(&Receipt{Format: tc.format, Result: peer.Response{Payload: []byte(tc.payload)}})
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("got %q, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %q, want %q", got, tc.want)
+			}
+			for i := range got {
+				if !bytes.Equal(got[i], tc.want[i]) {
+					t.Fatalf("got %q, want %q", got, tc.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Package codec encodes the arguments of interchain calls. It only depends on
+// the standard library so that chaincodes can import it as well as the plugin.
+//
+// The first argument of an interchain call is a header carrying the call
+// type, the remaining ones are the call arguments:
+//
+//	legacy: Args[0] = uint64 type (8 bytes), Args[1:] = raw values
+//	v1:     Args[0] = Version | uint64 type (9 bytes), Args[1:] = Kind | value
+//
+// Integers are big endian. Chaincodes receive the raw values of both
+// layouts, the plugin strips the kinds after validating them.
+package codec
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"unicode/utf8"
+)
+
+// Version of the typed argument encoding
+const Version byte = 1
+
+type Kind byte
+
+const (
+	KindString Kind = 's'
+	KindInt    Kind = 'i'
+	KindUint   Kind = 'u'
+	KindBytes  Kind = 'b'
+	KindJSON   Kind = 'j'
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindString:
+		return "string"
+	case KindInt:
+		return "int"
+	case KindUint:
+		return "uint"
+	case KindBytes:
+		return "bytes"
+	case KindJSON:
+		return "json"
+	default:
+		return fmt.Sprintf("kind(%d)", byte(k))
+	}
+}
+
+// Arg is a typed interchain argument.
+type Arg struct {
+	Kind  Kind
+	Value []byte
+}
+
+func String(s string) Arg {
+	return Arg{Kind: KindString, Value: []byte(s)}
+}
+
+func Int(i int64) Arg {
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, uint64(i))
+	return Arg{Kind: KindInt, Value: v}
+}
+
+func Uint(u uint64) Arg {
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, u)
+	return Arg{Kind: KindUint, Value: v}
+}
+
+func Bytes(b []byte) Arg {
+	return Arg{Kind: KindBytes, Value: b}
+}
+
+func JSON(v interface{}) (Arg, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return Arg{}, fmt.Errorf("marshal json arg: %w", err)
+	}
+	return Arg{Kind: KindJSON, Value: data}, nil
+}
+
+func (a Arg) AsString() (string, error) {
+	if a.Kind != KindString {
+		return "", fmt.Errorf("arg is %s, not string", a.Kind)
+	}
+	return string(a.Value), nil
+}
+
+func (a Arg) AsInt() (int64, error) {
+	if a.Kind != KindInt {
+		return 0, fmt.Errorf("arg is %s, not int", a.Kind)
+	}
+	return int64(binary.BigEndian.Uint64(a.Value)), nil
+}
+
+func (a Arg) AsUint() (uint64, error) {
+	if a.Kind != KindUint {
+		return 0, fmt.Errorf("arg is %s, not uint", a.Kind)
+	}
+	return binary.BigEndian.Uint64(a.Value), nil
+}
+
+func (a Arg) AsJSON(v interface{}) error {
+	if a.Kind != KindJSON {
+		return fmt.Errorf("arg is %s, not json", a.Kind)
+	}
+	return json.Unmarshal(a.Value, v)
+}
+
+// Validate checks that the value is well formed for its kind.
+func (a Arg) Validate() error {
+	switch a.Kind {
+	case KindString:
+		if !utf8.Valid(a.Value) {
+			return fmt.Errorf("string arg is not valid utf8")
+		}
+	case KindInt, KindUint:
+		if len(a.Value) != 8 {
+			return fmt.Errorf("%s arg has %d bytes, expect 8", a.Kind, len(a.Value))
+		}
+	case KindBytes:
+	case KindJSON:
+		if !json.Valid(a.Value) {
+			return fmt.Errorf("json arg is not valid json")
+		}
+	default:
+		return fmt.Errorf("unknown arg %s", a.Kind)
+	}
+
+	return nil
+}
+
+// Envelope is a decoded interchain call. Version is 0 for the legacy layout,
+// whose arguments are all of KindBytes.
+type Envelope struct {
+	Version byte
+	Type    uint64
+	Args    []Arg
+}
+
+// Encode lays out a v1 interchain call of type typ with args.
+func Encode(typ uint64, args ...Arg) [][]byte {
+	header := make([]byte, 9)
+	header[0] = Version
+	binary.BigEndian.PutUint64(header[1:], typ)
+
+	ret := make([][]byte, 0, len(args)+1)
+	ret = append(ret, header)
+	for _, a := range args {
+		ret = append(ret, append([]byte{byte(a.Kind)}, a.Value...))
+	}
+
+	return ret
+}
+
+// Decode parses and validates the arguments of an interchain call in either
+// layout.
+func Decode(raw [][]byte) (*Envelope, error) {
+	if len(raw) == 0 {
+		return nil, fmt.Errorf("missing interchain header")
+	}
+
+	header := raw[0]
+	switch len(header) {
+	case 8:
+		env := &Envelope{Type: binary.BigEndian.Uint64(header)}
+		for _, v := range raw[1:] {
+			env.Args = append(env.Args, Bytes(v))
+		}
+		return env, nil
+	case 9:
+		if header[0] != Version {
+			return nil, fmt.Errorf("unsupported interchain args version %d", header[0])
+		}
+	default:
+		return nil, fmt.Errorf("interchain header has %d bytes, expect 8 or 9", len(header))
+	}
+
+	env := &Envelope{
+		Version: header[0],
+		Type:    binary.BigEndian.Uint64(header[1:]),
+	}
+	for i, v := range raw[1:] {
+		if len(v) == 0 {
+			return nil, fmt.Errorf("arg %d has no kind", i)
+		}
+		a := Arg{Kind: Kind(v[0]), Value: v[1:]}
+		if err := a.Validate(); err != nil {
+			return nil, fmt.Errorf("arg %d: %w", i, err)
+		}
+		env.Args = append(env.Args, a)
+	}
+
+	return env, nil
+}
+
+// Values returns the raw argument values as handed to chaincodes.
+func (e *Envelope) Values() [][]byte {
+	ret := make([][]byte, 0, len(e.Args))
+	for _, a := range e.Args {
+		ret = append(ret, a.Value)
+	}
+
+	return ret
+}
@@ -0,0 +1,97 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestRoundTrip(t *testing.T) {
+	obj, err := JSON(map[string]int{"n": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := []Arg{String("alice"), Int(-7), Uint(10), Bytes([]byte{0, 1}), obj}
+
+	env, err := Decode(Encode(3, args...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.Version != Version || env.Type != 3 || len(env.Args) != len(args) {
+		t.Fatalf("unexpected envelope %+v", env)
+	}
+	for i, a := range args {
+		if env.Args[i].Kind != a.Kind || !bytes.Equal(env.Args[i].Value, a.Value) {
+			t.Errorf("arg %d: got %+v, want %+v", i, env.Args[i], a)
+		}
+	}
+
+	if s, err := env.Args[0].AsString(); err != nil || s != "alice" {
+		t.Errorf("AsString: got %q, %v", s, err)
+	}
+	if i, err := env.Args[1].AsInt(); err != nil || i != -7 {
+		t.Errorf("AsInt: got %d, %v", i, err)
+	}
+	if u, err := env.Args[2].AsUint(); err != nil || u != 10 {
+		t.Errorf("AsUint: got %d, %v", u, err)
+	}
+	var m map[string]int
+	if err := env.Args[4].AsJSON(&m); err != nil || m["n"] != 1 {
+		t.Errorf("AsJSON: got %v, %v", m, err)
+	}
+	if _, err := env.Args[0].AsUint(); err == nil {
+		t.Error("string arg read as uint")
+	}
+
+	values := env.Values()
+	if len(values) != len(args) || string(values[0]) != "alice" || !bytes.Equal(values[3], []byte{0, 1}) {
+		t.Fatalf("unexpected values %q", values)
+	}
+}
+
+func TestDecodeLegacy(t *testing.T) {
+	header := make([]byte, 8)
+	binary.BigEndian.PutUint64(header, 2)
+
+	env, err := Decode([][]byte{header, []byte("alice"), {}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if env.Version != 0 || env.Type != 2 || len(env.Args) != 2 {
+		t.Fatalf("unexpected envelope %+v", env)
+	}
+	for _, a := range env.Args {
+		if a.Kind != KindBytes {
+			t.Fatalf("legacy arg of kind %s", a.Kind)
+		}
+	}
+}
+
+func TestDecodeMalformed(t *testing.T) {
+	header := Encode(0)[0]
+	unversioned := append([]byte{Version + 1}, header[1:]...)
+
+	tests := []struct {
+		name string
+		raw  [][]byte
+		err  string
+	}{
+		{"no header", nil, "missing interchain header"},
+		{"short header", [][]byte{{1, 2, 3}}, "expect 8 or 9"},
+		{"long header", [][]byte{make([]byte, 10)}, "expect 8 or 9"},
+		{"unknown version", [][]byte{unversioned}, "unsupported interchain args version"},
+		{"no kind", [][]byte{header, {}}, "arg 0 has no kind"},
+		{"unknown kind", [][]byte{header, []byte("xvalue")}, "unknown arg"},
+		{"invalid utf8", [][]byte{header, {byte(KindString), 0xff}}, "not valid utf8"},
+		{"short int", [][]byte{header, {byte(KindInt), 1, 2}}, "expect 8"},
+		{"long uint", [][]byte{header, append([]byte{byte(KindUint)}, make([]byte, 9)...)}, "expect 8"},
+		{"invalid json", [][]byte{header, []byte("j{")}, "not valid json"},
+	}
+
+	for _, tt := range tests {
+		if _, err := Decode(tt.raw); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: got %v, want %q", tt.name, err, tt.err)
+		}
+	}
+}
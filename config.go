@@ -1,19 +1,52 @@
 package main
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/hyperledger/fabric-sdk-go/pkg/util/pathvar"
+	"github.com/meshplus/pier-client-fabric/payload"
 	"github.com/spf13/viper"
 )
 
 const (
 	ConfigName = "fabric.toml"
+	// SDKConfigName is the fabric sdk config next to ConfigName
+	SDKConfigName = "config.yaml"
+
+	// ProofFormatPayload is the chaincode action payload checked by validating.wasm
+	ProofFormatPayload = "payload"
+	// ProofFormatEnvelope is the full transaction envelope with its endorsements
+	ProofFormatEnvelope = "envelope"
+
+	// ReceiptFormatStructured passes receipt results through as the broker
+	// stored them
+	ReceiptFormatStructured = "structured"
+	// ReceiptFormatLegacy splits receipt results on commas as older plugins did
+	ReceiptFormatLegacy = "legacy"
+
+	// PayloadHashV1 hashes IBTP payloads over the versioned encoding of
+	// payload.Encode
+	PayloadHashV1 = "v1"
+	// PayloadHashLegacy hashes IBTP payloads over the concatenation of their
+	// fields, as bitxhub and the broker do
+	PayloadHashLegacy = "legacy"
 )
 
 type Config struct {
-	Fabric   Fabric    `toml:"fabric" json:"fabric"`
-	Services []Service `mapstructure:"services" json:"services"`
+	Fabric     Fabric        `toml:"fabric" json:"fabric"`
+	Services   []Service     `mapstructure:"services" json:"services"`
+	Encryption Encryption    `mapstructure:"encryption" json:"encryption"`
+	Metrics    MetricsConfig `mapstructure:"metrics" json:"metrics"`
+	Health     HealthConfig  `mapstructure:"health" json:"health"`
+	Log        LogConfig     `mapstructure:"log" json:"log"`
+	Tracing    TracingConfig `mapstructure:"tracing" json:"tracing"`
+	Retry      RetryConfig   `mapstructure:"retry" json:"retry"`
+	Admin      AdminConfig   `mapstructure:"admin" json:"admin"`
+	Polling    PollingConfig `mapstructure:"polling" json:"polling"`
 }
 type Fabric struct {
 	Name          string `toml:"name" json:"name"`
@@ -24,6 +57,18 @@ type Fabric struct {
 	ServerPort    string `toml:"server_port" json:"server_port"`
 	TimeoutHeight int64  `mapstructure:"timeout_height" json:"timeout_height"`
 	TimeoutPeriod uint64 `mapstructure:"timeout_period" json:"timeout_period"`
+	ProofFormat   string `mapstructure:"proof_format" json:"proof_format"`
+	ReceiptFormat string `mapstructure:"receipt_format" json:"receipt_format"`
+	PayloadHash   string `mapstructure:"payload_hash" json:"payload_hash"`
+}
+
+// payloadVersion is the payload.Version IBTP payloads are hashed with.
+func (f Fabric) payloadVersion() byte {
+	if f.PayloadHash == PayloadHashLegacy {
+		return payload.Legacy
+	}
+
+	return payload.Version
 }
 
 type Service struct {
@@ -32,6 +77,72 @@ type Service struct {
 	Type string `toml:"type" json:"type"`
 }
 
+// Encryption holds the keys used to encrypt IBTPs marked Encrypt. Keys are
+// hex encoded secp256k1 keys, the private key file being relative to the
+// config directory.
+type Encryption struct {
+	PrivateKey string           `mapstructure:"private_key" json:"private_key"`
+	Peers      []EncryptionPeer `mapstructure:"peers" json:"peers"`
+}
+
+type EncryptionPeer struct {
+	AppchainID string `mapstructure:"appchain_id" json:"appchain_id"`
+	PublicKey  string `mapstructure:"public_key" json:"public_key"`
+}
+
+// MetricsConfig is where the prometheus metrics are served, a zero port
+// disabling the metrics server.
+type MetricsConfig struct {
+	Port int `mapstructure:"port" json:"port"`
+}
+
+// HealthConfig is where /healthz and /readyz are served, a zero port disabling
+// them. The plugin is not ready once its last successful broker query is
+// older than StalePeriod seconds and logs its health every SummaryPeriod
+// seconds.
+type HealthConfig struct {
+	Port          int    `mapstructure:"port" json:"port"`
+	StalePeriod   uint64 `mapstructure:"stale_period" json:"stale_period"`
+	SummaryPeriod uint64 `mapstructure:"summary_period" json:"summary_period"`
+}
+
+// LogConfig is the level (trace, debug, info, warn or error) and the format
+// (text or json) of the plugin logs.
+type LogConfig struct {
+	Level  string `mapstructure:"level" json:"level"`
+	Format string `mapstructure:"format" json:"format"`
+}
+
+// TracingConfig is the OTLP/HTTP collector spans are exported to, e.g.
+// http://localhost:4318, an empty endpoint disabling tracing. SampleRatio of
+// the traces started by the plugin are sampled.
+type TracingConfig struct {
+	Endpoint    string  `mapstructure:"endpoint" json:"endpoint"`
+	ServiceName string  `mapstructure:"service_name" json:"service_name"`
+	SampleRatio float64 `mapstructure:"sample_ratio" json:"sample_ratio"`
+}
+
+// RetryConfig is how long to wait in seconds before retrying a failed fabric
+// request.
+type RetryConfig struct {
+	Interval uint64 `mapstructure:"interval" json:"interval"`
+}
+
+// AdminConfig is where the active config is served, a zero port disabling
+// it.
+type AdminConfig struct {
+	Port int `mapstructure:"port" json:"port"`
+}
+
+// PollingConfig is how often in seconds the broker is polled for new
+// messages, how many messages of a service pair are fetched per poll at most
+// and how many of them are fetched at the same time.
+type PollingConfig struct {
+	Interval  uint64 `mapstructure:"interval" json:"interval"`
+	BatchSize uint64 `mapstructure:"batch_size" json:"batch_size"`
+	Workers   int    `mapstructure:"workers" json:"workers"`
+}
+
 func DefaultConfig() *Config {
 	return &Config{
 		Fabric: Fabric{
@@ -42,8 +153,31 @@ func DefaultConfig() *Config {
 			Org:           "org2",
 			TimeoutHeight: 30,
 			TimeoutPeriod: 60,
+			ProofFormat:   ProofFormatPayload,
+			ReceiptFormat: ReceiptFormatStructured,
+			PayloadHash:   PayloadHashLegacy,
 		},
 		Services: nil,
+		Health: HealthConfig{
+			StalePeriod:   30,
+			SummaryPeriod: 60,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: LogFormatText,
+		},
+		Tracing: TracingConfig{
+			ServiceName: "pier-client-fabric",
+			SampleRatio: 1,
+		},
+		Retry: RetryConfig{
+			Interval: 2,
+		},
+		Polling: PollingConfig{
+			Interval:  2,
+			BatchSize: 100,
+			Workers:   4,
+		},
 	}
 }
 
@@ -63,6 +197,189 @@ func UnmarshalConfig(configPath string) (*Config, error) {
 	if err := viper.Unmarshal(config); err != nil {
 		return nil, err
 	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 
 	return config, nil
 }
+
+// ConfigError lists the problems found in a config.
+type ConfigError struct {
+	File     string
+	Problems []string
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("invalid %s: %s", e.File, strings.Join(e.Problems, "; "))
+}
+
+func (e *ConfigError) add(format string, args ...interface{}) {
+	e.Problems = append(e.Problems, fmt.Sprintf(format, args...))
+}
+
+func (e *ConfigError) orNil() error {
+	if len(e.Problems) == 0 {
+		return nil
+	}
+
+	return e
+}
+
+// Validate checks every field of config, returning a ConfigError naming all
+// the invalid ones.
+func (c *Config) Validate() error {
+	cerr := &ConfigError{File: ConfigName}
+	for _, field := range []struct{ key, value string }{
+		{"fabric.name", c.Fabric.Name},
+		{"fabric.username", c.Fabric.Username},
+		{"fabric.ccid", c.Fabric.CCID},
+		{"fabric.channel_id", c.Fabric.ChannelId},
+		{"fabric.org", c.Fabric.Org},
+	} {
+		if strings.TrimSpace(field.value) == "" {
+			cerr.add("%s must not be empty", field.key)
+		}
+	}
+	if c.Fabric.TimeoutHeight <= 0 {
+		cerr.add("fabric.timeout_height must be positive, got %d", c.Fabric.TimeoutHeight)
+	}
+	if c.Fabric.TimeoutPeriod == 0 {
+		cerr.add("fabric.timeout_period must be positive")
+	}
+	if c.Fabric.ProofFormat != ProofFormatPayload && c.Fabric.ProofFormat != ProofFormatEnvelope {
+		cerr.add("fabric.proof_format must be %q or %q, got %q", ProofFormatPayload, ProofFormatEnvelope, c.Fabric.ProofFormat)
+	}
+	if c.Fabric.ReceiptFormat != ReceiptFormatStructured && c.Fabric.ReceiptFormat != ReceiptFormatLegacy {
+		cerr.add("fabric.receipt_format must be %q or %q, got %q", ReceiptFormatStructured, ReceiptFormatLegacy, c.Fabric.ReceiptFormat)
+	}
+	if c.Fabric.PayloadHash != PayloadHashV1 && c.Fabric.PayloadHash != PayloadHashLegacy {
+		cerr.add("fabric.payload_hash must be %q or %q, got %q", PayloadHashV1, PayloadHashLegacy, c.Fabric.PayloadHash)
+	}
+
+	ids := make(map[string]bool)
+	for i, service := range c.Services {
+		if _, _, err := parseServiceID(service.ID); err != nil {
+			cerr.add("services[%d].id %s", i, err)
+		}
+		if ids[service.ID] {
+			cerr.add("services[%d].id %q is listed twice", i, service.ID)
+		}
+		ids[service.ID] = true
+	}
+
+	for i, peer := range c.Encryption.Peers {
+		if peer.AppchainID == "" {
+			cerr.add("encryption.peers[%d].appchain_id must not be empty", i)
+		}
+	}
+
+	for _, field := range []struct {
+		key  string
+		port int
+	}{
+		{"metrics.port", c.Metrics.Port},
+		{"health.port", c.Health.Port},
+		{"admin.port", c.Admin.Port},
+	} {
+		if field.port < 0 || field.port > 65535 {
+			cerr.add("%s %d is not a port", field.key, field.port)
+		}
+	}
+	if c.Health.StalePeriod == 0 {
+		cerr.add("health.stale_period must be positive")
+	}
+	if c.Retry.Interval == 0 {
+		cerr.add("retry.interval must be positive")
+	}
+	if c.Polling.Interval == 0 {
+		cerr.add("polling.interval must be positive")
+	}
+	if c.Polling.BatchSize == 0 {
+		cerr.add("polling.batch_size must be positive")
+	}
+	if c.Polling.Workers <= 0 {
+		cerr.add("polling.workers must be positive, got %d", c.Polling.Workers)
+	}
+
+	if _, err := NewLogger(c.Log, ioutil.Discard); err != nil {
+		cerr.add("log: %s", err)
+	}
+
+	if c.Tracing.Endpoint != "" {
+		if _, err := newOTLPExporter(c.Tracing.Endpoint); err != nil {
+			cerr.add("tracing.endpoint: %s", err)
+		}
+		if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+			cerr.add("tracing.sample_ratio %v is not within [0, 1]", c.Tracing.SampleRatio)
+		}
+	}
+
+	return cerr.orNil()
+}
+
+// parseServiceID splits the id of a fabric service into its channel and
+// chaincode.
+func parseServiceID(id string) (string, string, error) {
+	splits := strings.Split(id, "&")
+	if len(splits) != 2 || splits[0] == "" || splits[1] == "" {
+		return "", "", fmt.Errorf("%q is not of the form channel&chaincode", id)
+	}
+
+	return splits[0], splits[1], nil
+}
+
+// CheckSDKConfig checks that the sdk config in configPath defines org, and
+// that the msp of org holds the certificate and key of user, as the sdk would
+// look them up.
+func CheckSDKConfig(configPath, org, user string) error {
+	cerr := &ConfigError{File: SDKConfigName}
+	v := viper.New()
+	v.SetConfigFile(filepath.Join(configPath, SDKConfigName))
+	v.SetConfigType("yaml")
+	if err := v.ReadInConfig(); err != nil {
+		cerr.add("read: %s", err)
+		return cerr
+	}
+
+	key := "organizations." + strings.ToLower(org)
+	if !v.IsSet(key) {
+		cerr.add("organization %q of %s is not defined", org, ConfigName)
+		return cerr
+	}
+	// users may be given inline instead of in an msp
+	if v.IsSet(key + ".users." + strings.ToLower(user)) {
+		return nil
+	}
+	cryptoPath := v.GetString(key + ".cryptoPath")
+	if cryptoPath == "" {
+		cerr.add("organization %q has neither a cryptoPath nor the user %q", org, user)
+		return cerr
+	}
+
+	mspPath := pathvar.Subst(cryptoPath)
+	if !filepath.IsAbs(mspPath) {
+		mspPath = filepath.Join(pathvar.Subst(v.GetString("client.cryptoconfig.path")), mspPath)
+	}
+	mspPath = strings.NewReplacer("{userName}", user, "{username}", user).Replace(mspPath)
+	if _, err := os.Stat(mspPath); err != nil {
+		cerr.add("msp of organization %q: %s", org, err)
+		return cerr
+	}
+
+	// the sdk names certificates after the user and the org domain the msp
+	// lies in, as cryptogen does
+	orgDomain := filepath.Base(filepath.Dir(filepath.Dir(filepath.Dir(mspPath))))
+	cert := filepath.Join(mspPath, "signcerts", fmt.Sprintf("%s@%s-cert.pem", user, orgDomain))
+	if _, err := os.Stat(cert); err != nil {
+		cerr.add("certificate of user %q: %s", user, err)
+	}
+	keys, err := ioutil.ReadDir(filepath.Join(mspPath, "keystore"))
+	if err != nil {
+		cerr.add("keystore of user %q: %s", user, err)
+	} else if len(keys) == 0 {
+		cerr.add("keystore of user %q is empty", user)
+	}
+
+	return cerr.orNil()
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/meshplus/pier-client-fabric/payload"
+)
+
+func TestConfigValidate(t *testing.T) {
+	if err := DefaultConfig().Validate(); err != nil {
+		t.Fatalf("default config is invalid: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		change  func(c *Config)
+		problem string
+	}{
+		{"empty ccid", func(c *Config) { c.Fabric.CCID = "" }, "fabric.ccid must not be empty"},
+		{"zero timeout height", func(c *Config) { c.Fabric.TimeoutHeight = 0 }, "fabric.timeout_height must be positive"},
+		{"unknown proof format", func(c *Config) { c.Fabric.ProofFormat = "block" }, "fabric.proof_format"},
+		{"unknown payload hash", func(c *Config) { c.Fabric.PayloadHash = "v2" }, "fabric.payload_hash"},
+		{"malformed service", func(c *Config) {
+			c.Services = []Service{{ID: "mychannel&transfer"}, {ID: "transfer"}}
+		}, `services[1].id "transfer" is not of the form channel&chaincode`},
+		{"duplicate service", func(c *Config) {
+			c.Services = []Service{{ID: "mychannel&transfer"}, {ID: "mychannel&transfer"}}
+		}, "listed twice"},
+		{"bad port", func(c *Config) { c.Metrics.Port = 70000 }, "metrics.port 70000 is not a port"},
+		{"bad log level", func(c *Config) { c.Log.Level = "loud" }, "log:"},
+		{"bad sample ratio", func(c *Config) {
+			c.Tracing.Endpoint = "http://localhost:4318"
+			c.Tracing.SampleRatio = 2
+		}, "tracing.sample_ratio"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := DefaultConfig()
+			tt.change(c)
+			err := c.Validate()
+			var cerr *ConfigError
+			if !errors.As(err, &cerr) {
+				t.Fatalf("got %v, want a config error", err)
+			}
+			if len(cerr.Problems) != 1 || !strings.Contains(cerr.Problems[0], tt.problem) {
+				t.Fatalf("got problems %q, want one about %q", cerr.Problems, tt.problem)
+			}
+		})
+	}
+}
+
+func TestUnmarshalConfigInvalid(t *testing.T) {
+	dir := t.TempDir()
+	config := "[fabric]\nccid = \"\"\ntimeout_height = 0\n[[services]]\nid = \"transfer\"\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, ConfigName), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := UnmarshalConfig(dir)
+	var cerr *ConfigError
+	if !errors.As(err, &cerr) || len(cerr.Problems) != 3 {
+		t.Fatalf("got %v, want the ccid, timeout height and service reported", err)
+	}
+}
+
+func TestCheckSDKConfig(t *testing.T) {
+	configPath, err := filepath.Abs("config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("CONFIG_PATH", configPath)
+
+	if err := CheckSDKConfig(configPath, "org2", "Admin"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range []struct {
+		configPath, org, user, problem string
+	}{
+		{t.TempDir(), "org2", "Admin", "read"},
+		{configPath, "org5", "Admin", `organization "org5" of fabric.toml is not defined`},
+		{configPath, "org2", "User9", `certificate of user "User9"`},
+	} {
+		err := CheckSDKConfig(tt.configPath, tt.org, tt.user)
+		if err == nil || !strings.Contains(err.Error(), tt.problem) {
+			t.Fatalf("got %v checking %s@%s, want %q", err, tt.user, tt.org, tt.problem)
+		}
+	}
+}
+
+// the shipped config must hash payloads like bitxhub and the broker do
+func TestDefaultPayloadHash(t *testing.T) {
+	config, err := UnmarshalConfig("config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []*Config{DefaultConfig(), config} {
+		if v := c.Fabric.payloadVersion(); v != payload.Legacy {
+			t.Fatalf("payload hash %q has version %d, want the legacy one", c.Fabric.PayloadHash, v)
+		}
+	}
+}
@@ -1,17 +1,21 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/hyperledger/fabric-protos-go/common"
 	"github.com/hyperledger/fabric-protos-go/peer"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/go-hclog"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/event"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/ledger"
-	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/context"
+	fabcontext "github.com/hyperledger/fabric-sdk-go/pkg/common/providers/context"
 	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
 	"github.com/hyperledger/fabric-sdk-go/pkg/core/config"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
@@ -21,17 +25,64 @@ type MessageHandler interface {
 	HandleMessage(deliveries *fab.CCEvent, payload []byte)
 }
 
+// Executor submits chaincode transactions, see channel.Client.
+type Executor interface {
+	Execute(request channel.Request, options ...channel.RequestOption) (channel.Response, error)
+}
+
+// Querier evaluates chaincode functions without submitting a transaction, see
+// channel.Client.
+type Querier interface {
+	Query(request channel.Request, options ...channel.RequestOption) (channel.Response, error)
+}
+
+// Ledger looks up committed transactions and the channel config, see
+// ledger.Client.
+type Ledger interface {
+	QueryTransaction(txID fab.TransactionID, options ...ledger.RequestOption) (*peer.ProcessedTransaction, error)
+	QueryBlockByTxID(txID fab.TransactionID, options ...ledger.RequestOption) (*common.Block, error)
+	QueryConfig(options ...ledger.RequestOption) (fab.ChannelCfg, error)
+}
+
+// EventSource delivers chaincode events, see event.Client.
+type EventSource interface {
+	RegisterChaincodeEvent(ccID, eventFilter string) (fab.Registration, <-chan *fab.CCEvent, error)
+	Unregister(reg fab.Registration)
+}
+
+// Clients are the fabric clients the plugin talks to. NewConsumer takes them
+// from the sdk, tests hand in fakes.
+type Clients struct {
+	Executor Executor
+	Querier  Querier
+	Ledger   Ledger
+	Events   EventSource
+}
+
 type Consumer struct {
-	eventClient     *event.Client
+	Clients
 	meta            *ContractMeta
 	msgH            MessageHandler
-	channelProvider context.ChannelProvider
-	ChannelClient   *channel.Client
+	sdk             *fabsdk.FabricSDK
+	channelProvider fabcontext.ChannelProvider
 	registration    fab.Registration
-	ctx             chan bool
+	registered      bool
+	metrics         *Metrics
+	health          *Health
+	logger          hclog.Logger
+	ctx             context.Context
+	wg              sync.WaitGroup
 }
 
-func NewConsumer(configPath string, meta *ContractMeta, msgH MessageHandler, ctx chan bool) (*Consumer, error) {
+// ConsumerFactory creates the consumer of the plugin, see NewConsumer.
+type ConsumerFactory func(ctx context.Context, meta *ContractMeta, msgH MessageHandler) (*Consumer, error)
+
+// NewConsumer builds the sdk and the channel, ledger and event clients for
+// meta.ChannelID once, so that every request of the plugin shares them.
+func NewConsumer(ctx context.Context, configPath string, meta *ContractMeta, msgH MessageHandler) (*Consumer, error) {
+	if err := CheckSDKConfig(configPath, meta.ORG, meta.Username); err != nil {
+		return nil, err
+	}
 	configProvider := config.FromFile(filepath.Join(configPath, "config.yaml"))
 	sdk, err := fabsdk.New(configProvider)
 	if err != nil {
@@ -42,43 +93,75 @@ func NewConsumer(configPath string, meta *ContractMeta, msgH MessageHandler, ctx
 
 	channelClient, err := channel.New(channelProvider)
 	if err != nil {
+		sdk.Close()
 		return nil, fmt.Errorf("create channel fabcli fail: %s\n", err.Error())
 	}
 
-	c := &Consumer{
-		msgH:            msgH,
-		ChannelClient:   channelClient,
-		channelProvider: channelProvider,
-		meta:            meta,
-		ctx:             ctx,
+	ledgerClient, err := ledger.New(channelProvider)
+	if err != nil {
+		sdk.Close()
+		return nil, fmt.Errorf("create ledger fabcli fail: %s\n", err.Error())
+	}
+
+	eventClient, err := event.New(channelProvider, event.WithBlockEvents())
+	if err != nil {
+		sdk.Close()
+		return nil, fmt.Errorf("create event fabcli fail: %s\n", err.Error())
 	}
 
+	c := newConsumer(ctx, meta, msgH, Clients{
+		Executor: channelClient,
+		Querier:  channelClient,
+		Ledger:   ledgerClient,
+		Events:   eventClient,
+	})
+	c.sdk = sdk
+	c.channelProvider = channelProvider
+
 	return c, nil
 }
 
-func (c *Consumer) Start() error {
-	var err error
-
-	ec, err := event.New(c.channelProvider, event.WithBlockEvents())
-	if err != nil {
-		return fmt.Errorf("failed to create fabcli, error: %v", err)
+func newConsumer(ctx context.Context, meta *ContractMeta, msgH MessageHandler, clients Clients) *Consumer {
+	return &Consumer{
+		Clients: clients,
+		msgH:    msgH,
+		meta:    meta,
+		metrics: NewMetrics(),
+		health:  NewHealth(time.Duration(DefaultConfig().Health.StalePeriod) * time.Second),
+		logger:  logger,
+		ctx:     ctx,
 	}
-	c.eventClient = ec
-	registration, notifier, err := ec.RegisterChaincodeEvent(c.meta.CCID, c.meta.EventFilter)
+}
+
+func (c *Consumer) Start() error {
+	registration, notifier, err := c.Events.RegisterChaincodeEvent(c.meta.CCID, c.meta.EventFilter)
 	if err != nil {
 		return fmt.Errorf("failed to register chaincode event, error: %v", err)
 	}
 	c.registration = registration
+	if c.registered {
+		c.metrics.eventReconnected()
+	}
+	c.registered = true
+	c.health.setEventStream(EventStreamConnected)
 
-	// todo: add context
+	c.wg.Add(1)
 	go func() {
+		defer c.wg.Done()
 		for {
 			select {
-			case ccEvent := <-notifier:
-				if ccEvent != nil {
-					c.handle(ccEvent)
+			case ccEvent, ok := <-notifier:
+				if !ok {
+					c.health.eventStreamClosed()
+					return
 				}
-			case <-c.ctx:
+				if ccEvent == nil {
+					continue
+				}
+				if err := c.handle(ccEvent); err != nil {
+					c.logger.Warn("Drop chaincode event", "event", ccEvent.EventName, "tx_id", ccEvent.TxID, "error", err.Error())
+				}
+			case <-c.ctx.Done():
 				return
 			}
 		}
@@ -86,28 +169,57 @@ func (c *Consumer) Start() error {
 	return nil
 }
 
-func (c *Consumer) Shutdown() error {
-	c.eventClient.Unregister(c.registration)
-	return nil
+// Shutdown unregisters the chaincode event, waits for the event loop to exit
+// until ctx expires and closes the sdk.
+func (c *Consumer) Shutdown(ctx context.Context) error {
+	if c.Events != nil && c.registration != nil {
+		c.health.setEventStream(EventStreamStopped)
+		c.Events.Unregister(c.registration)
+		c.registration = nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		c.wg.Wait()
+		close(done)
+	}()
+
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		err = fmt.Errorf("wait for event loop to exit: %w", ctx.Err())
+	}
+
+	if c.sdk != nil {
+		c.sdk.Close()
+		c.sdk = nil
+	}
+	return err
 }
 
-func (c *Consumer) handle(deliveries *fab.CCEvent) {
-	l, err := ledger.New(c.channelProvider)
+// handle passes the action payload of the transaction that emitted
+// deliveries on to the message handler.
+func (c *Consumer) handle(deliveries *fab.CCEvent) error {
+	t, err := c.Ledger.QueryTransaction(fab.TransactionID(deliveries.TxID), ledger.WithParentContext(c.ctx))
 	if err != nil {
-		return
+		return fmt.Errorf("query transaction: %w", err)
 	}
-	t, err := l.QueryTransaction(fab.TransactionID(deliveries.TxID))
-	if err != nil {
-		return
+	if err := checkValidationCode(fab.TransactionID(deliveries.TxID), t); err != nil {
+		return err
 	}
 	pd := &common.Payload{}
 	if err := proto.Unmarshal(t.TransactionEnvelope.Payload, pd); err != nil {
-		return
+		return fmt.Errorf("unmarshal payload: %w", err)
 	}
 	pt := &peer.Transaction{}
 	if err := proto.Unmarshal(pd.Data, pt); err != nil {
-		return
+		return fmt.Errorf("unmarshal transaction: %w", err)
+	}
+	if len(pt.Actions) == 0 {
+		return fmt.Errorf("transaction %s has no actions", deliveries.TxID)
 	}
 
 	c.msgH.HandleMessage(deliveries, pt.Actions[0].Payload)
+	return nil
 }
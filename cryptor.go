@@ -0,0 +1,220 @@
+package main
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/ecies"
+	"github.com/meshplus/bitxhub-model/pb"
+)
+
+// EncryptedMarker tags contents and results whose real value is the
+// ciphertext following it
+const EncryptedMarker = "__encrypted__"
+
+// Cryptor encrypts IBTP contents with AES-GCM under a key agreed by ECDH
+// between this appchain's private key and the peer appchain's public key.
+type Cryptor struct {
+	privKey *ecdsa.PrivateKey
+	pubKeys map[string]*ecdsa.PublicKey
+	aeads   sync.Map
+}
+
+// NewCryptor loads the keys configured in the encryption section of
+// fabric.toml. It returns nil if no private key is configured.
+func NewCryptor(configPath string, config Encryption) (*Cryptor, error) {
+	if config.PrivateKey == "" {
+		return nil, nil
+	}
+
+	keyPath := config.PrivateKey
+	if !filepath.IsAbs(keyPath) {
+		keyPath = filepath.Join(configPath, keyPath)
+	}
+	privKey, err := crypto.LoadECDSA(keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("load encryption private key: %w", err)
+	}
+
+	pubKeys := make(map[string]*ecdsa.PublicKey)
+	for _, peer := range config.Peers {
+		raw, err := hex.DecodeString(strings.TrimPrefix(peer.PublicKey, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("decode public key of %s: %w", peer.AppchainID, err)
+		}
+		var pubKey *ecdsa.PublicKey
+		if len(raw) == 33 {
+			pubKey, err = crypto.DecompressPubkey(raw)
+		} else {
+			pubKey, err = crypto.UnmarshalPubkey(raw)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal public key of %s: %w", peer.AppchainID, err)
+		}
+		pubKeys[peer.AppchainID] = pubKey
+	}
+
+	return &Cryptor{
+		privKey: privKey,
+		pubKeys: pubKeys,
+	}, nil
+}
+
+func (c *Cryptor) aead(appchainID string) (cipher.AEAD, error) {
+	if c == nil {
+		return nil, fmt.Errorf("encryption is not configured")
+	}
+	if v, ok := c.aeads.Load(appchainID); ok {
+		return v.(cipher.AEAD), nil
+	}
+
+	pubKey, ok := c.pubKeys[appchainID]
+	if !ok {
+		return nil, fmt.Errorf("no encryption public key for appchain %s", appchainID)
+	}
+	shared, err := ecies.ImportECDSA(c.privKey).GenerateShared(ecies.ImportECDSAPublic(pubKey), 32, 0)
+	if err != nil {
+		return nil, fmt.Errorf("agree key with appchain %s: %w", appchainID, err)
+	}
+	key := sha256.Sum256(shared)
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	c.aeads.Store(appchainID, gcm)
+	return gcm, nil
+}
+
+// Encrypt seals data for appchainID, prefixing the random nonce.
+func (c *Cryptor) Encrypt(data []byte, appchainID string) ([]byte, error) {
+	gcm, err := c.aead(appchainID)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, data, nil), nil
+}
+
+// Decrypt opens data sealed by appchainID with Encrypt.
+func (c *Cryptor) Decrypt(data []byte, appchainID string) ([]byte, error) {
+	gcm, err := c.aead(appchainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, sealed := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	plain, err := gcm.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt content from appchain %s: %w", appchainID, err)
+	}
+
+	return plain, nil
+}
+
+// EncryptContent wraps the sealed content so that it still unmarshals as a
+// pb.Content on the way through pier.
+func (c *Cryptor) EncryptContent(content *pb.Content, appchainID string) (*pb.Content, error) {
+	data, err := content.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := c.Encrypt(data, appchainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Content{
+		Func: EncryptedMarker,
+		Args: [][]byte{sealed},
+	}, nil
+}
+
+func (c *Cryptor) DecryptContent(content *pb.Content, appchainID string) (*pb.Content, error) {
+	if !isEncryptedContent(content) {
+		return nil, fmt.Errorf("content is not encrypted")
+	}
+	data, err := c.Decrypt(content.Args[0], appchainID)
+	if err != nil {
+		return nil, err
+	}
+
+	plain := &pb.Content{}
+	if err := plain.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("unmarshal decrypted content: %w", err)
+	}
+
+	return plain, nil
+}
+
+// EncryptResult seals the result data and keeps the status readable, since
+// pier and bitxhub route receipts by it.
+func (c *Cryptor) EncryptResult(result *pb.Result, appchainID string) (*pb.Result, error) {
+	data, err := result.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := c.Encrypt(data, appchainID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Result{
+		Data:        []*pb.ResultRes{{Data: [][]byte{[]byte(EncryptedMarker), sealed}}},
+		MultiStatus: result.MultiStatus,
+	}, nil
+}
+
+func (c *Cryptor) DecryptResult(result *pb.Result, appchainID string) (*pb.Result, error) {
+	if !isEncryptedResult(result) {
+		return nil, fmt.Errorf("result is not encrypted")
+	}
+	data, err := c.Decrypt(result.Data[0].Data[1], appchainID)
+	if err != nil {
+		return nil, err
+	}
+
+	plain := &pb.Result{}
+	if err := plain.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("unmarshal decrypted result: %w", err)
+	}
+
+	return plain, nil
+}
+
+func isEncryptedContent(content *pb.Content) bool {
+	return content != nil && content.Func == EncryptedMarker && len(content.Args) == 1
+}
+
+func isEncryptedResult(result *pb.Result) bool {
+	return result != nil && len(result.Data) == 1 && len(result.Data[0].Data) == 2 &&
+		string(result.Data[0].Data[0]) == EncryptedMarker
+}
+
+// parseAppchainID returns the appchain part of a bxhID:appchainID:serviceID id.
+func parseAppchainID(fullID string) (string, error) {
+	_, appchainID, _, err := parseChainServiceID(fullID)
+	return appchainID, err
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/meshplus/bitxhub-model/pb"
+)
+
+// newTestCryptors returns the cryptors of appchain1 and appchain2, each
+// knowing the public key of the other.
+func newTestCryptors(t *testing.T) (*Cryptor, *Cryptor) {
+	t.Helper()
+	key1, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key2, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c1 := &Cryptor{privKey: key1, pubKeys: map[string]*ecdsa.PublicKey{"appchain2": &key2.PublicKey}}
+	c2 := &Cryptor{privKey: key2, pubKeys: map[string]*ecdsa.PublicKey{"appchain1": &key1.PublicKey}}
+
+	return c1, c2
+}
+
+func TestCryptorRoundTrip(t *testing.T) {
+	c1, c2 := newTestCryptors(t)
+
+	content := transferContent("alice", "bob", 10)
+	sealed, err := c1.EncryptContent(content, "appchain2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isEncryptedContent(sealed) || bytes.Contains(sealed.Args[0], []byte("alice")) {
+		t.Fatalf("content not sealed: %+v", sealed)
+	}
+	plain, err := c2.DecryptContent(sealed, "appchain1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plain.Func != content.Func || len(plain.Args) != len(content.Args) || !bytes.Equal(plain.Args[0], content.Args[0]) {
+		t.Fatalf("got content %+v, want %+v", plain, content)
+	}
+
+	result := &pb.Result{
+		Data:        []*pb.ResultRes{{Data: [][]byte{[]byte("ok")}}},
+		MultiStatus: []bool{true},
+	}
+	sealedResult, err := c2.EncryptResult(result, "appchain1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isEncryptedResult(sealedResult) || len(sealedResult.MultiStatus) != 1 || !sealedResult.MultiStatus[0] {
+		t.Fatalf("result not sealed with its status: %+v", sealedResult)
+	}
+	plainResult, err := c1.DecryptResult(sealedResult, "appchain2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(plainResult.Data[0].Data[0]) != "ok" {
+		t.Fatalf("got result %+v, want %+v", plainResult, result)
+	}
+}
+
+func TestCryptorWrongKey(t *testing.T) {
+	c1, c2 := newTestCryptors(t)
+	other, _ := newTestCryptors(t)
+
+	sealed, err := c1.EncryptContent(transferContent("alice", "bob", 10), "appchain2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a third appchain holding another key for appchain1
+	other.pubKeys["appchain1"] = &c1.privKey.PublicKey
+	if _, err := other.DecryptContent(sealed, "appchain1"); err == nil {
+		t.Fatal("content decrypted with another key")
+	}
+	if _, err := c2.DecryptContent(sealed, "appchain3"); err == nil || !strings.Contains(err.Error(), "no encryption public key") {
+		t.Fatalf("got %v, want missing public key", err)
+	}
+}
+
+func TestCryptorTamperedCiphertext(t *testing.T) {
+	c1, c2 := newTestCryptors(t)
+
+	sealed, err := c1.EncryptContent(transferContent("alice", "bob", 10), "appchain2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sealed.Args[0][len(sealed.Args[0])-1] ^= 0xff
+	if _, err := c2.DecryptContent(sealed, "appchain1"); err == nil {
+		t.Fatal("tampered content decrypted")
+	}
+
+	sealed.Args[0] = sealed.Args[0][:4]
+	if _, err := c2.DecryptContent(sealed, "appchain1"); err == nil {
+		t.Fatal("truncated content decrypted")
+	}
+
+	if _, err := c2.DecryptContent(transferContent("alice", "bob", 10), "appchain1"); err == nil {
+		t.Fatal("plain content decrypted")
+	}
+}
+
+func TestSubmitIBTPEncryptionMismatch(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+	other, _ := newTestCryptors(t)
+	c.cryptor = other
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "bob", "0")
+	begin := &pb.BxhProof{TxStatus: pb.TransactionStatus_BEGIN}
+
+	sealed, err := other.EncryptContent(transferContent("carol", "bob", 10), "appchain2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ret, err := c.SubmitIBTP(testRemote, 1, testTransferCID, pb.IBTP_INTERCHAIN, sealed, begin, false)
+	if err != nil || ret.Status {
+		t.Fatalf("encrypted content of a plain ibtp accepted: %+v, %v", ret, err)
+	}
+	ret, err = c.SubmitIBTP(testRemote, 1, testTransferCID, pb.IBTP_INTERCHAIN, transferContent("carol", "bob", 10), begin, true)
+	if err != nil || ret.Status {
+		t.Fatalf("plain content of an encrypted ibtp accepted: %+v, %v", ret, err)
+	}
+	ret, err = c.SubmitIBTPBatch([]string{testRemote}, []uint64{1}, []string{testTransferCID}, []pb.IBTP_Type{pb.IBTP_INTERCHAIN},
+		[]*pb.Content{sealed}, []*pb.BxhProof{begin}, []bool{false})
+	if err != nil || ret.Status {
+		t.Fatalf("encrypted content of a plain batched ibtp accepted: %+v, %v", ret, err)
+	}
+	if got := f.state("transfer", "bob"); got != "0" {
+		t.Fatalf("balance of bob: got %s, want 0", got)
+	}
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// DeadLetterDir is where dead letters are kept, relative to the config dir
+	DeadLetterDir = "dead-letters"
+
+	DeadLetterInterchain = "interchain"
+	DeadLetterReceipt    = "receipt"
+	DeadLetterEvent      = "event"
+)
+
+// ErrDeadLetter is returned for messages parked as dead letters until they
+// are marked for redrive.
+var ErrDeadLetter = errors.New("message parked as dead letter")
+
+// MalformedEventError reports a broker message that can't be turned into an
+// IBTP, carrying the raw bytes read from the broker.
+type MalformedEventError struct {
+	Payload []byte
+	Err     error
+}
+
+func (e *MalformedEventError) Error() string {
+	return fmt.Sprintf("malformed event: %s", e.Err)
+}
+
+func (e *MalformedEventError) Unwrap() error {
+	return e.Err
+}
+
+// DeadLetter is a broker message that failed conversion into an IBTP. Letters
+// marked Redrive are converted again by the plugin on its next polling round.
+type DeadLetter struct {
+	ID          string `json:"id"`
+	Kind        string `json:"kind"`
+	ServicePair string `json:"service_pair"`
+	Index       uint64 `json:"index"`
+	TxID        string `json:"tx_id"`
+	Payload     []byte `json:"payload"`
+	Proof       []byte `json:"proof"`
+	Error       string `json:"error"`
+	Timestamp   int64  `json:"timestamp"`
+	Redrive     bool   `json:"redrive"`
+}
+
+func deadLetterID(kind, key string, index uint64) string {
+	return fmt.Sprintf("%s-%s-%d", kind, key, index)
+}
+
+// DeadLetterStore keeps one json file per dead letter, so the plugin and the
+// dead-letter command can share it without locking.
+type DeadLetterStore struct {
+	dir string
+}
+
+func NewDeadLetterStore(dir string) (*DeadLetterStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create dead letter dir: %w", err)
+	}
+
+	return &DeadLetterStore{dir: dir}, nil
+}
+
+func (s *DeadLetterStore) path(id string) string {
+	return filepath.Join(s.dir, url.PathEscape(id)+".json")
+}
+
+func (s *DeadLetterStore) Put(letter *DeadLetter) error {
+	data, err := json.MarshalIndent(letter, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := s.path(letter.ID) + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("write dead letter %s: %w", letter.ID, err)
+	}
+
+	return os.Rename(tmp, s.path(letter.ID))
+}
+
+// Get returns the dead letter id, or nil if there is none.
+func (s *DeadLetterStore) Get(id string) (*DeadLetter, error) {
+	return s.read(s.path(id))
+}
+
+func (s *DeadLetterStore) read(path string) (*DeadLetter, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read dead letter: %w", err)
+	}
+
+	letter := &DeadLetter{}
+	if err := json.Unmarshal(data, letter); err != nil {
+		return nil, fmt.Errorf("unmarshal dead letter %s: %w", filepath.Base(path), err)
+	}
+
+	return letter, nil
+}
+
+func (s *DeadLetterStore) Remove(id string) error {
+	if err := os.Remove(s.path(id)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("remove dead letter %s: %w", id, err)
+	}
+
+	return nil
+}
+
+// List returns all dead letters, oldest first.
+func (s *DeadLetterStore) List() ([]*DeadLetter, error) {
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("read dead letter dir: %w", err)
+	}
+
+	var letters []*DeadLetter
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".json") {
+			continue
+		}
+		letter, err := s.read(filepath.Join(s.dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if letter != nil {
+			letters = append(letters, letter)
+		}
+	}
+
+	sort.Slice(letters, func(i, j int) bool {
+		return letters[i].Timestamp < letters[j].Timestamp
+	})
+
+	return letters, nil
+}
+
+// MarkRedrive flags the dead letter id for conversion on the next polling round.
+func (s *DeadLetterStore) MarkRedrive(id string) error {
+	letter, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+	if letter == nil {
+		return fmt.Errorf("dead letter %s not found", id)
+	}
+
+	letter.Redrive = true
+	return s.Put(letter)
+}
@@ -1,13 +1,17 @@
 package main
 
 import (
+	"fmt"
 	"strings"
 
-	"github.com/cloudflare/cfssl/log"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/meshplus/bitxhub-model/pb"
+	"github.com/meshplus/pier-client-fabric/payload"
 )
 
+// Event is an out message as the broker stores it. TxID and Timestamp are
+// the transaction that emitted it and the time in nanoseconds its client
+// proposed it at, which becomes the IBTP timestamp: the time the IBTP was
+// generated, in the unit pier uses. Events of older brokers carry neither.
 type Event struct {
 	Index     uint64   `json:"index"`
 	DstFullID string   `json:"dst_full_id"`
@@ -16,12 +20,14 @@ type Event struct {
 	CallFunc  CallFunc `json:"call_func"`
 	CallBack  CallFunc `json:"callback"`
 	RollBack  CallFunc `json:"rollback"`
+	TxID      string   `json:"tx_id,omitempty"`
+	Timestamp int64    `json:"timestamp,omitempty"`
 }
 
-func (ev *Event) Convert2IBTP(timeoutHeight int64, ibtpType pb.IBTP_Type) *pb.IBTP {
-	pd, err := ev.encryptPayload()
+func (ev *Event) Convert2IBTP(timeoutHeight int64, ibtpType pb.IBTP_Type, cryptor *Cryptor, hashVersion byte) (*pb.IBTP, error) {
+	pd, err := ev.encryptPayload(cryptor, hashVersion)
 	if err != nil {
-		log.Fatalf("Get ibtp payload :%s", err)
+		return nil, fmt.Errorf("get ibtp payload: %w", err)
 	}
 
 	return &pb.IBTP{
@@ -30,8 +36,9 @@ func (ev *Event) Convert2IBTP(timeoutHeight int64, ibtpType pb.IBTP_Type) *pb.IB
 		Index:         ev.Index,
 		Type:          ibtpType,
 		TimeoutHeight: timeoutHeight,
+		Timestamp:     ev.Timestamp,
 		Payload:       pd,
-	}
+	}, nil
 }
 
 func handleArgs(args string) [][]byte {
@@ -43,27 +50,31 @@ func handleArgs(args string) [][]byte {
 	return argsBytes
 }
 
-func (ev *Event) encryptPayload() ([]byte, error) {
+func (ev *Event) encryptPayload(cryptor *Cryptor, hashVersion byte) ([]byte, error) {
 	content := &pb.Content{
 		Func: ev.CallFunc.Func,
 		Args: ev.CallFunc.Args,
 	}
+	if ev.Encrypt {
+		dstAppchainID, err := parseAppchainID(ev.DstFullID)
+		if err != nil {
+			return nil, err
+		}
+		content, err = cryptor.EncryptContent(content, dstAppchainID)
+		if err != nil {
+			return nil, err
+		}
+	}
 	data, err := content.Marshal()
 	if err != nil {
 		return nil, err
 	}
 
-	var packed []byte
-	packed = append(packed, []byte(ev.CallFunc.Func)...)
-	for _, arg := range ev.CallFunc.Args {
-		packed = append(packed, arg...)
-	}
-	hash := crypto.Keccak256(packed)
-
+	// hash the plaintext so that bitxhub can still validate it
 	ibtppd := &pb.Payload{
 		Encrypted: ev.Encrypt,
 		Content:   data,
-		Hash:      hash,
+		Hash:      payload.InterchainHash(hashVersion, ev.CallFunc.Func, ev.CallFunc.Args),
 	}
 	return ibtppd.Marshal()
 }
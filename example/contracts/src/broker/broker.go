@@ -7,6 +7,7 @@ import (
 	"github.com/hyperledger/fabric/common/util"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/hyperledger/fabric/core/chaincode/lib/cid"
@@ -42,6 +43,15 @@ const (
 	receiptMessages         = "receipt-messages"
 	channelID               = "mychannel"
 	transactionContractName = "transaction"
+	// maxMessageRange bounds the messages returned by one range query
+	maxMessageRange = 1000
+
+	// formats of Receipt.Result.Payload, see Receipt
+	receiptFormatRaw   = "raw"
+	receiptFormatMulti = "multi"
+	// a destination chaincode returning several values responds with this
+	// message and a JSON encoded [][]byte payload
+	multiResultsMessage = "multi-results"
 )
 
 var admins []string
@@ -56,6 +66,11 @@ type Event struct {
 	CallFunc  CallFunc `json:"call_func"`
 	CallBack  CallFunc `json:"callback"`
 	RollBack  CallFunc `json:"rollback"`
+	// TxID and Timestamp are the transaction that emitted the event and
+	// its proposal time in nanoseconds. The block of the transaction is not
+	// known yet when it runs, relayers look it up by TxID.
+	TxID      string `json:"tx_id,omitempty"`
+	Timestamp int64  `json:"timestamp,omitempty"`
 }
 
 // type VerifyPayload struct {
@@ -94,6 +109,20 @@ type Receipt struct {
 	Encrypt bool        `json:"encrypt"`
 	Typ     uint64      `json:"typ"`
 	Result  pb.Response `json:"result"`
+	// Format is receiptFormatRaw when Result.Payload is the single return
+	// value and receiptFormatMulti when it is a JSON [][]byte. Receipts
+	// stored without it carry comma separated values.
+	Format string `json:"format,omitempty"`
+	// Index, Timestamp and TxID are the index of the interchain, the time
+	// it was executed at and the transaction executing it, so that the
+	// receipt IBTP can be built from the receipt alone
+	Index     uint64 `json:"index,omitempty"`
+	Timestamp int64  `json:"timestamp,omitempty"`
+	TxID      string `json:"tx_id,omitempty"`
+	// TimeoutHeight and Group are those of the interchain, when the relayer
+	// passed them to invokeInterchain
+	TimeoutHeight int64             `json:"timeout_height,omitempty"`
+	Group         map[string]uint64 `json:"group,omitempty"`
 }
 
 type DirectTransactionMeta struct {
@@ -176,6 +205,10 @@ func (broker *Broker) Invoke(stub shim.ChaincodeStubInterface) pb.Response {
 		return broker.getInMessage(stub, args)
 	case "getOutMessage":
 		return broker.getOutMessage(stub, args)
+	case "getInMessages":
+		return broker.getInMessageRange(stub, args)
+	case "getOutMessages":
+		return broker.getOutMessageRange(stub, args)
 	case "getList":
 		return broker.getList(stub)
 	case "pollingEvent":
@@ -424,14 +457,18 @@ func (broker *Broker) EmitInterchainEvent(stub shim.ChaincodeStubInterface, args
 		CallFunc:  callFunc,
 		CallBack:  callBack,
 		RollBack:  rollBack,
+		TxID:      stub.GetTxID(),
+	}
+	if ts, err := stub.GetTxTimestamp(); err == nil {
+		tx.Timestamp = ts.Seconds*int64(time.Second) + int64(ts.Nanos)
 	}
 
 	outMeta[outServicePair]++
 
-	// txValue, err := json.Marshal(tx)
-	// if err != nil {
-	// 	return shim.Error(fmt.Sprintf("marshal tx value: %s", err.Error()))
-	// }
+	txValue, err := json.Marshal(tx)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("marshal tx value: %s", err.Error()))
+	}
 
 	messages, err := broker.getOutMessages(stub)
 	if err != nil {
@@ -446,11 +483,12 @@ func (broker *Broker) EmitInterchainEvent(stub shim.ChaincodeStubInterface, args
 		return shim.Error(fmt.Sprintf("set out messages: %s", err.Error()))
 	}
 
-	// persist out message
-	// key := broker.outMsgKey(outServicePair, strconv.FormatUint(tx.Index, 10))
-	// if err := stub.PutState(key, txValue); err != nil {
-	// 	return shim.Error(fmt.Sprintf("outMsgKey: %s", err.Error()))
-	// }
+	// persist out message under a key of its own, the write of which in the
+	// transaction of the business chaincode proves the message
+	key := broker.outMsgKey(outServicePair, strconv.FormatUint(tx.Index, 10))
+	if err := stub.PutState(key, txValue); err != nil {
+		return shim.Error(fmt.Sprintf("outMsgKey: %s", err.Error()))
+	}
 
 	// if err := stub.SetEvent(interchainEventName, txValue); err != nil {
 	// 	return shim.Error(fmt.Sprintf("set event: %s", err.Error()))
@@ -578,6 +616,20 @@ func (broker *Broker) audit(stub shim.ChaincodeStubInterface, args []string) pb.
 		if err = broker.putServiceOrderedList(stub, serviceOrdered); err != nil {
 			return shim.Error(err.Error())
 		}
+
+		// in direct mode the transaction chaincode lets the service start the
+		// transactions of the interchains it emits
+		threshold, err := broker.getValThreshold(stub)
+		if err != nil {
+			return shim.Error(err.Error())
+		}
+		if threshold == 0 {
+			b := util.ToChaincodeArgs("registerLocalService", getKey(channel, chaincodeName))
+			response := stub.InvokeChaincode(transactionContractName, b, channelID)
+			if response.Status != shim.OK {
+				return shim.Error(fmt.Errorf("invoke transaction chaincode: %d - %s", response.Status, response.Message).Error())
+			}
+		}
 	}
 
 	return shim.Success([]byte(fmt.Sprintf("set status of chaincode %s to %s", getKey(channel, chaincodeName), status)))
@@ -749,9 +801,11 @@ func genServicePair(from, to string) string {
 	return fmt.Sprintf("%s-%s", from, to)
 }
 
+// invokeInterchains executes a batch of interchains, the optional 10th and
+// 11th arguments being the timeout height and group of each of them.
 func (broker *Broker) invokeInterchains(stub shim.ChaincodeStubInterface, args []string) pb.Response {
-	if len(args) != 9 {
-		return errorResponse("incorrect number of arguments, expecting 9")
+	if len(args) != 9 && len(args) != 11 {
+		return errorResponse("incorrect number of arguments, expecting 9 or 11")
 	}
 
 	var (
@@ -794,7 +848,29 @@ func (broker *Broker) invokeInterchains(stub shim.ChaincodeStubInterface, args [
 		return errorResponse(fmt.Sprintf("unmarshal args failed for %s", args[8]))
 	}
 
-	for idx := 0; idx < 9; idx++ {
+	n := len(srcFullID)
+	if len(targetCID) != n || len(index) != n || len(typ) != n || len(callFunc) != n || len(callArgs) != n ||
+		len(txStatus) != n || len(signature) != n || len(isEncrypted) != n {
+		return errorResponse("interchain batch fields differ in length")
+	}
+
+	var (
+		timeoutHeight []int64
+		group         []map[string]uint64
+	)
+	if len(args) == 11 {
+		if err := json.Unmarshal([]byte(args[9]), &timeoutHeight); err != nil {
+			return errorResponse(fmt.Sprintf("unmarshal args failed for %s", args[9]))
+		}
+		if err := json.Unmarshal([]byte(args[10]), &group); err != nil {
+			return errorResponse(fmt.Sprintf("unmarshal args failed for %s", args[10]))
+		}
+		if len(timeoutHeight) != n || len(group) != n {
+			return errorResponse("interchain batch fields differ in length")
+		}
+	}
+
+	for idx := 0; idx < n; idx++ {
 		serviceOrdered, err := broker.getServiceOrderedList(stub)
 		if err != nil {
 			return errorResponse(fmt.Sprintf("get service orered list failed: %s", err.Error()))
@@ -826,6 +902,13 @@ func (broker *Broker) invokeInterchains(stub shim.ChaincodeStubInterface, args [
 		invokeArgs = append(invokeArgs, strconv.FormatUint(txStatus[idx], 10))
 		invokeArgs = append(invokeArgs, string(signatureBytes))
 		invokeArgs = append(invokeArgs, strconv.FormatBool(isEncrypted[idx]))
+		if len(args) == 11 {
+			groupBytes, err := json.Marshal(group[idx])
+			if err != nil {
+				return errorResponse(err.Error())
+			}
+			invokeArgs = append(invokeArgs, strconv.FormatInt(timeoutHeight[idx], 10), string(groupBytes))
+		}
 
 		resp := broker.invokeInterchain(stub, invokeArgs)
 		if resp.Status != shim.OK {
@@ -833,12 +916,16 @@ func (broker *Broker) invokeInterchains(stub shim.ChaincodeStubInterface, args [
 		}
 	}
 
-	return shim.Success(nil)
+	return successResponse(nil)
 }
 
+// invokeInterchain executes an interchain and responds with the Receipt it
+// stores for it, the payload of the destination chaincode being its
+// Result.Payload. The interchain's timeout height and JSON encoded group may
+// follow the 9 required arguments.
 func (broker *Broker) invokeInterchain(stub shim.ChaincodeStubInterface, args []string) pb.Response {
-	if len(args) != 9 {
-		return errorResponse("incorrect number of arguments, expecting 9")
+	if len(args) < 9 || len(args) > 11 {
+		return errorResponse("incorrect number of arguments, expecting 9 to 11")
 	}
 
 	srcFullID := args[0]
@@ -873,6 +960,18 @@ func (broker *Broker) invokeInterchain(stub shim.ChaincodeStubInterface, args []
 	if err != nil {
 		return errorResponse(err.Error())
 	}
+	var timeoutHeight int64
+	if len(args) > 9 {
+		if timeoutHeight, err = strconv.ParseInt(args[9], 10, 64); err != nil {
+			return errorResponse(fmt.Sprintf("invoke interchain parse timeout height error: %v", err.Error()))
+		}
+	}
+	var group map[string]uint64
+	if len(args) > 10 {
+		if err := json.Unmarshal([]byte(args[10]), &group); err != nil {
+			return errorResponse(fmt.Sprintf("unmarshal group failed for %s", args[10]))
+		}
+	}
 
 	threshold, err := broker.getValThreshold(stub)
 	if err != nil {
@@ -935,6 +1034,17 @@ func (broker *Broker) invokeInterchain(stub shim.ChaincodeStubInterface, args []
 	receipt.Encrypt = isEncrypt
 	receipt.Typ = typ
 	receipt.Result = response
+	receipt.Format = receiptFormatRaw
+	if response.Message == multiResultsMessage {
+		receipt.Format = receiptFormatMulti
+	}
+	receipt.Index = index
+	receipt.TimeoutHeight = timeoutHeight
+	receipt.Group = group
+	receipt.TxID = stub.GetTxID()
+	if ts, err := stub.GetTxTimestamp(); err == nil {
+		receipt.Timestamp = ts.Seconds*int64(time.Second) + int64(ts.Nanos)
+	}
 	receipts, err := broker.getReceiptMessages(stub)
 	if err != nil {
 		return errorResponse(err.Error())
@@ -948,7 +1058,12 @@ func (broker *Broker) invokeInterchain(stub shim.ChaincodeStubInterface, args []
 		return errorResponse(err.Error())
 	}
 
-	return successResponse(response.Payload)
+	receiptBytes, err := json.Marshal(receipt)
+	if err != nil {
+		return errorResponse(err.Error())
+	}
+
+	return successResponse(receiptBytes)
 }
 
 func (broker *Broker) invokeReceipt(stub shim.ChaincodeStubInterface, args []string) pb.Response {
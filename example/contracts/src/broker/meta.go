@@ -39,6 +39,31 @@ func (broker *Broker) getOutMessage(stub shim.ChaincodeStubInterface, args []str
 	return shim.Success(v)
 }
 
+// getOutMessageRange servicePair,from,to returns the out messages of
+// servicePair with indexes from through to, each carrying the id of the
+// transaction that emitted it
+func (broker *Broker) getOutMessageRange(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	servicePair, from, to, err := parseMessageRange(args)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("getOutMessages %s", err.Error()))
+	}
+	messages, err := broker.getOutMessages(stub)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	events := make([]Event, 0, to-from+1)
+	for index := from; index <= to; index++ {
+		if event, ok := messages[servicePair][index]; ok {
+			events = append(events, event)
+		}
+	}
+	v, err := json.Marshal(events)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success(v)
+}
+
 func (broker *Broker) getInnerMeta(stub shim.ChaincodeStubInterface) pb.Response {
 	v, err := stub.GetState(innerMeta)
 	if err != nil {
@@ -69,6 +94,51 @@ func (broker *Broker) getInMessage(stub shim.ChaincodeStubInterface, args []stri
 	return shim.Success(v)
 }
 
+// getInMessageRange servicePair,from,to returns the receipts of the
+// interchains of servicePair with indexes from through to, each carrying the
+// id of the transaction that executed it
+func (broker *Broker) getInMessageRange(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	servicePair, from, to, err := parseMessageRange(args)
+	if err != nil {
+		return shim.Error(fmt.Sprintf("getInMessages %s", err.Error()))
+	}
+	receipts, err := broker.getReceiptMessages(stub)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	ranged := make([]Receipt, 0, to-from+1)
+	for index := from; index <= to; index++ {
+		if receipt, ok := receipts[servicePair][index]; ok {
+			ranged = append(ranged, receipt)
+		}
+	}
+	v, err := json.Marshal(ranged)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	return shim.Success(v)
+}
+
+// parseMessageRange parses the servicePair,from,to arguments of a message
+// range query, which is limited to maxMessageRange messages
+func parseMessageRange(args []string) (string, uint64, uint64, error) {
+	if len(args) != 3 {
+		return "", 0, 0, fmt.Errorf("incorrect number of arguments, expecting 3")
+	}
+	from, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("parse from error: %v", err)
+	}
+	to, err := strconv.ParseUint(args[2], 10, 64)
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("parse to error: %v", err)
+	}
+	if from == 0 || to < from || to-from >= maxMessageRange {
+		return "", 0, 0, fmt.Errorf("invalid range %d to %d, expecting at most %d messages from index 1 on", from, to, maxMessageRange)
+	}
+	return args[0], from, to, nil
+}
+
 func (broker *Broker) getCallbackMeta(stub shim.ChaincodeStubInterface) pb.Response {
 	v, err := stub.GetState(callbackMeta)
 	if err != nil {
@@ -48,6 +48,28 @@ func onlyBroker(stub shim.ChaincodeStubInterface) bool {
 	return brokerCCID == invoker
 }
 
+// onlyBrokerOrLocalService tells whether the transaction was proposed to the
+// broker or to a local service registered with it. Chaincodes only see the
+// chaincode the client proposed, so the interchains a service emits through
+// the broker reach this chaincode as proposed to the service.
+func (transaction *Transaction) onlyBrokerOrLocalService(stub shim.ChaincodeStubInterface) bool {
+	if onlyBroker(stub) {
+		return true
+	}
+	invoker, err := getChaincodeID(stub)
+	if err != nil {
+		fmt.Printf("get Invoker failed: %s", err.Error())
+		return false
+	}
+	localServices, err := transaction.getMap(stub, localServicesMeta)
+	if err != nil {
+		fmt.Printf("get local services failed: %s", err.Error())
+		return false
+	}
+
+	return localServices[invoker] != 0
+}
+
 // putMap for persisting meta state into ledger
 func (transaction *Transaction) putMap(stub shim.ChaincodeStubInterface, metaName string, meta map[string]uint64) error {
 	if meta == nil {
@@ -16,6 +16,7 @@ const (
 	remoteWhiteListMeta   = "remote-white-list"
 	transactionStatusMeta = "transaction-status"
 	startTimestampMeta    = "start-timestamp"
+	localServicesMeta     = "local-services"
 	brokerContractName    = "broker"
 	channelID             = "mychannel"
 	delimiter             = "&"
@@ -89,6 +90,8 @@ func (transaction *Transaction) Invoke(stub shim.ChaincodeStubInterface) pb.Resp
 		return transaction.getRSWhiteList(stub, args)
 	case "getRemoteServiceList":
 		return transaction.getRemoteServiceList(stub)
+	case "registerLocalService":
+		return transaction.registerLocalService(stub, args)
 	case "startTransaction":
 		return transaction.startTransaction(stub, args)
 	case "rollbackTransaction":
@@ -235,11 +238,33 @@ func (transaction *Transaction) getRemoteServiceList(stub shim.ChaincodeStubInte
 	return shim.Success(v)
 }
 
-func (transaction *Transaction) startTransaction(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+// registerLocalService lets the local service, approved by the broker, start
+// the transactions of the interchains it emits through the broker.
+func (transaction *Transaction) registerLocalService(stub shim.ChaincodeStubInterface, args []string) pb.Response {
 	if onlyBroker := onlyBroker(stub); !onlyBroker {
 		return shim.Error(fmt.Sprintf("caller is not broker"))
 	}
 
+	if len(args) != 1 {
+		return shim.Error("incorrect number of arguments, expecting 1")
+	}
+	localServices, err := transaction.getMap(stub, localServicesMeta)
+	if err != nil {
+		return shim.Error(err.Error())
+	}
+	localServices[args[0]] = 1
+	if err := transaction.putMap(stub, localServicesMeta, localServices); err != nil {
+		return shim.Error(err.Error())
+	}
+
+	return shim.Success(nil)
+}
+
+func (transaction *Transaction) startTransaction(stub shim.ChaincodeStubInterface, args []string) pb.Response {
+	if ok := transaction.onlyBrokerOrLocalService(stub); !ok {
+		return shim.Error(fmt.Sprintf("caller is not broker"))
+	}
+
 	if len(args) != 3 {
 		return shim.Error("incorrect number of arguments, expecting 3")
 	}
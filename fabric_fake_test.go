@@ -0,0 +1,76 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/meshplus/bitxhub-model/pb"
+	"github.com/meshplus/pier-client-fabric/internal/simulator"
+	"github.com/meshplus/pier-client-fabric/verifier"
+)
+
+const (
+	fakeChannel = simulator.Channel
+	fakeUserMSP = simulator.UserMSP
+)
+
+// fakeFabric is a simulated appchain1 failing the test on setup errors.
+type fakeFabric struct {
+	*simulator.Chain
+	t testing.TB
+}
+
+func newFakeFabric(t testing.TB) *fakeFabric {
+	return newFakeChain(t, "appchain1")
+}
+
+func newFakeChain(t testing.TB, appchainID string) *fakeFabric {
+	chain, err := simulator.New(appchainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &fakeFabric{Chain: chain, t: t}
+}
+
+func (f *fakeFabric) Clients() Clients {
+	return simulatorClients(f.Chain)
+}
+
+func simulatorClients(chain *simulator.Chain) Clients {
+	return Clients{Executor: chain, Querier: chain, Ledger: chain, Events: chain}
+}
+
+func (f *fakeFabric) mustInvoke(mspID, name, fn string, args ...string) {
+	f.t.Helper()
+	if _, res := f.Invoke(mspID, name, fn, args...); res.Status != shim.OK {
+		f.t.Fatalf("%s.%s: %s", name, fn, res.Message)
+	}
+}
+
+func (f *fakeFabric) state(name, key string) string {
+	return string(f.State(name, key))
+}
+
+func (f *fakeFabric) invalidate(txID fab.TransactionID) {
+	f.t.Helper()
+	if err := f.Invalidate(txID); err != nil {
+		f.t.Fatal(err)
+	}
+}
+
+// verify checks ibtp the way bitxhub does, against the validator of f.
+func (f *fakeFabric) verify(ibtp *pb.IBTP) error {
+	f.t.Helper()
+	validator, err := f.Validator()
+	if err != nil {
+		f.t.Fatal(err)
+	}
+	v, err := verifier.New(validator)
+	if err != nil {
+		f.t.Fatal(err)
+	}
+
+	return v.Verify(ibtp)
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	HealthzPath = "/healthz"
+	ReadyzPath  = "/readyz"
+
+	EventStreamNotStarted   = "not started"
+	EventStreamConnected    = "connected"
+	EventStreamDisconnected = "disconnected"
+	EventStreamStopped      = "stopped"
+)
+
+// Health tracks whether the plugin is alive and can reach fabric: the polling
+// loop running, the last successful broker query, the chaincode event stream
+// and the last endorsement.
+type Health struct {
+	stalePeriod time.Duration
+	now         func() time.Time
+
+	mu              sync.Mutex
+	polling         bool
+	lastQuery       time.Time
+	queryErr        error
+	eventStream     string
+	lastEndorsement time.Time
+	endorsementErr  error
+	deadLetters     int
+}
+
+// HealthReport is the state of the plugin served by the health endpoints.
+type HealthReport struct {
+	Live             bool      `json:"live"`
+	Ready            bool      `json:"ready"`
+	Problems         []string  `json:"problems,omitempty"`
+	LastQuery        time.Time `json:"last_query"`
+	QueryError       string    `json:"query_error,omitempty"`
+	EventStream      string    `json:"event_stream"`
+	LastEndorsement  time.Time `json:"last_endorsement"`
+	EndorsementError string    `json:"endorsement_error,omitempty"`
+	DeadLetters      int       `json:"dead_letters"`
+}
+
+// NewHealth tracks the health of a plugin which is not ready once its last
+// successful broker query is older than stalePeriod.
+func NewHealth(stalePeriod time.Duration) *Health {
+	return &Health{
+		stalePeriod: stalePeriod,
+		now:         time.Now,
+		eventStream: EventStreamNotStarted,
+	}
+}
+
+func (h *Health) setStalePeriod(stalePeriod time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.stalePeriod = stalePeriod
+}
+
+func (h *Health) setPolling(polling bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.polling = polling
+}
+
+// queried records the outcome of a broker query.
+func (h *Health) queried(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.queryErr = err
+	if err == nil {
+		h.lastQuery = h.now()
+	}
+}
+
+// endorsed records the outcome of executing a transaction. Chaincode errors
+// are endorsed by the peers and don't count as failures.
+func (h *Health) endorsed(err error) {
+	if err != nil && strings.Contains(err.Error(), chaincodeErrorStatus) {
+		err = nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.endorsementErr = err
+	if err == nil {
+		h.lastEndorsement = h.now()
+	}
+}
+
+func (h *Health) setEventStream(status string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.eventStream = status
+}
+
+// eventStreamClosed marks a connected event stream closed by fabric as
+// disconnected.
+func (h *Health) eventStreamClosed() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.eventStream == EventStreamConnected {
+		h.eventStream = EventStreamDisconnected
+	}
+}
+
+// setDeadLetters records the number of dead letters parked.
+func (h *Health) setDeadLetters(n int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.deadLetters = n
+}
+
+func (h *Health) Report() *HealthReport {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	r := &HealthReport{
+		Live:            h.polling,
+		LastQuery:       h.lastQuery,
+		EventStream:     h.eventStream,
+		LastEndorsement: h.lastEndorsement,
+		DeadLetters:     h.deadLetters,
+	}
+	if h.queryErr != nil {
+		r.QueryError = h.queryErr.Error()
+	}
+	if h.endorsementErr != nil {
+		r.EndorsementError = h.endorsementErr.Error()
+	}
+
+	if !h.polling {
+		r.Problems = append(r.Problems, "polling is not running")
+	}
+	switch {
+	case h.lastQuery.IsZero():
+		r.Problems = append(r.Problems, "no broker query succeeded yet")
+	case h.now().Sub(h.lastQuery) > h.stalePeriod:
+		r.Problems = append(r.Problems, fmt.Sprintf("last successful broker query at %s", h.lastQuery.Format(time.RFC3339)))
+	}
+	if h.eventStream == EventStreamDisconnected {
+		r.Problems = append(r.Problems, "chaincode event stream disconnected")
+	}
+	if h.endorsementErr != nil {
+		r.Problems = append(r.Problems, "last endorsement failed")
+	}
+	// a parked message holds back the messages of its service pair
+	if h.deadLetters != 0 {
+		r.Problems = append(r.Problems, fmt.Sprintf("%d dead letters parked", h.deadLetters))
+	}
+	r.Ready = len(r.Problems) == 0
+
+	return r
+}
+
+// LivenessHandler answers 200 while the polling loop runs, 503 otherwise.
+func (h *Health) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		r := h.Report()
+		writeHealthReport(w, r, r.Live)
+	})
+}
+
+// ReadinessHandler answers 200 while the plugin can reach fabric, 503
+// otherwise.
+func (h *Health) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		r := h.Report()
+		writeHealthReport(w, r, r.Ready)
+	})
+}
+
+func writeHealthReport(w http.ResponseWriter, r *HealthReport, ok bool) {
+	w.Header().Set("Content-Type", "application/json")
+	if !ok {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(r)
+}
+
+// logSummary logs the health of the plugin to log, as a warning if it is not
+// ready.
+func (h *Health) logSummary(log hclog.Logger) {
+	r := h.Report()
+	args := []interface{}{
+		"live", r.Live,
+		"last_query", r.LastQuery.Format(time.RFC3339),
+		"event_stream", r.EventStream,
+		"last_endorsement", r.LastEndorsement.Format(time.RFC3339),
+	}
+	if r.QueryError != "" {
+		args = append(args, "query_error", r.QueryError)
+	}
+	if r.EndorsementError != "" {
+		args = append(args, "endorsement_error", r.EndorsementError)
+	}
+	if !r.Ready {
+		log.Warn("Plugin not ready", append(args, "problems", strings.Join(r.Problems, "; "))...)
+		return
+	}
+	log.Info("Plugin ready", args...)
+}
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+)
+
+func TestHealthReport(t *testing.T) {
+	now := time.Now()
+	h := NewHealth(30 * time.Second)
+	h.now = func() time.Time { return now }
+
+	steps := []struct {
+		name  string
+		do    func()
+		ready bool
+	}{
+		{"not started", func() {}, false},
+		{"polling without query", func() { h.setPolling(true) }, false},
+		{"queried", func() { h.queried(nil) }, true},
+		{"query failed", func() { h.queried(errors.New("peer down")) }, true},
+		{"query stale", func() { now = now.Add(time.Minute) }, false},
+		{"queried again", func() { h.queried(nil) }, true},
+		{"chaincode error", func() { h.endorsed(fmt.Errorf("%s: no balance", chaincodeErrorStatus)) }, true},
+		{"endorsement failed", func() { h.endorsed(errors.New("orderer down")) }, false},
+		{"endorsed", func() { h.endorsed(nil) }, true},
+		{"event stream connected", func() { h.setEventStream(EventStreamConnected) }, true},
+		{"event stream closed", func() { h.eventStreamClosed() }, false},
+		{"event stream stopped", func() {
+			h.setEventStream(EventStreamStopped)
+			h.eventStreamClosed()
+		}, true},
+		{"dead letter parked", func() { h.setDeadLetters(1) }, false},
+		{"dead letter redriven", func() { h.setDeadLetters(0) }, true},
+		{"polling stopped", func() { h.setPolling(false) }, false},
+	}
+	for _, step := range steps {
+		step.do()
+		r := h.Report()
+		if r.Ready != step.ready {
+			t.Fatalf("%s: got ready %v, want %v, problems %v", step.name, r.Ready, step.ready, r.Problems)
+		}
+
+		rec := httptest.NewRecorder()
+		h.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ReadyzPath, nil))
+		if (rec.Code == http.StatusOK) != step.ready {
+			t.Fatalf("%s: got status %d", step.name, rec.Code)
+		}
+	}
+}
+
+// flakyQuerier fails every query while down.
+type flakyQuerier struct {
+	Querier
+	down int32
+}
+
+func (q *flakyQuerier) Query(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	if atomic.LoadInt32(&q.down) != 0 {
+		return channel.Response{}, errors.New("peer unreachable")
+	}
+
+	return q.Querier.Query(request, options...)
+}
+
+func TestHealthEndpoints(t *testing.T) {
+	f := newFakeFabric(t)
+	clients := f.Clients()
+	querier := &flakyQuerier{Querier: clients.Querier}
+	clients.Querier = querier
+	c := newTestClientWith(t, clients)
+	c.ticker = time.NewTicker(10 * time.Millisecond)
+	c.health.stalePeriod = 100 * time.Millisecond
+	c.config.Health.Port = freePort(t)
+
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	waitHealth(t, c.config.Health.Port, ReadyzPath, http.StatusOK)
+	waitHealth(t, c.config.Health.Port, HealthzPath, http.StatusOK)
+
+	atomic.StoreInt32(&querier.down, 1)
+	r := waitHealth(t, c.config.Health.Port, ReadyzPath, http.StatusServiceUnavailable)
+	if r.QueryError == "" || len(r.Problems) == 0 {
+		t.Fatalf("unexpected report %+v", r)
+	}
+	// the plugin is alive all the same
+	waitHealth(t, c.config.Health.Port, HealthzPath, http.StatusOK)
+
+	atomic.StoreInt32(&querier.down, 0)
+	waitHealth(t, c.config.Health.Port, ReadyzPath, http.StatusOK)
+}
+
+// waitHealth waits until path on port answers with status and returns the
+// report.
+func waitHealth(t *testing.T, port int, path string, status int) *HealthReport {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d%s", port, path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := &HealthReport{}
+		err = json.NewDecoder(resp.Body).Decode(r)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode == status {
+			return r
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%s: got status %d, want %d, report %+v", path, resp.StatusCode, status, r)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,43 @@
+package chaincode_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/meshplus/pier-client-fabric/codec"
+)
+
+// The example chaincodes emit interchain args in the legacy layout, which the
+// plugin decodes before submitting them.
+func TestChaincodeArgsDecode(t *testing.T) {
+	n := relayNetwork(t)
+	registerService(t, n, "data_swapper")
+	setBalance(t, n, "alice", 100)
+
+	invoke(t, n, userMSP, "transfer", "transfer", relayRemote, "alice", "bob", "10")
+	invoke(t, n, userMSP, "data_swapper", "get", "1356:appchain2:"+dataSwapperCID, "key")
+
+	tests := []struct {
+		pair string
+		want [][]byte
+	}{
+		{servicePair(relayLocal, relayRemote), [][]byte{[]byte("alice"), []byte("bob"), amount(10)}},
+		{servicePair("1356:appchain1:"+dataSwapperCID, "1356:appchain2:"+dataSwapperCID), [][]byte{[]byte("key")}},
+	}
+	for _, tt := range tests {
+		ev := outMessage(t, n, tt.pair, 1)
+		env, err := codec.Decode(ev.CallFunc.Args)
+		if err != nil {
+			t.Fatalf("%s: %v", ev.CallFunc.Func, err)
+		}
+		values := env.Values()
+		if env.Version != 0 || env.Type != 0 || len(values) != len(tt.want) {
+			t.Fatalf("%s: unexpected envelope %+v", ev.CallFunc.Func, env)
+		}
+		for i, v := range tt.want {
+			if !bytes.Equal(values[i], v) {
+				t.Errorf("%s: arg %d: got %q, want %q", ev.CallFunc.Func, i, values[i], v)
+			}
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package chaincode_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/meshplus/pier-client-fabric/internal/chaincode/broker"
+	"github.com/meshplus/pier-client-fabric/internal/simulator"
+)
+
+// transaction statuses kept by the transaction chaincode
+const (
+	txBegin         = 1
+	txBeginRollback = 2
+	txSuccess       = 3
+	txFail          = 4
+)
+
+func checkTxStatus(t *testing.T, n *simulator.Chain, index string, want uint64) {
+	t.Helper()
+	var m broker.DirectTransactionMeta
+	id := directLocal + "-" + directRemote + "-" + index
+	if err := json.Unmarshal(invoke(t, n, userMSP, "broker", "getDirectTransactionMeta", id).Payload, &m); err != nil {
+		t.Fatal(err)
+	}
+	if m.TransactionStatus != want {
+		t.Fatalf("transaction %s: got status %d, want %d", id, m.TransactionStatus, want)
+	}
+}
+
+// emit sends an interchain transfer of 10 from alice in direct mode.
+func emit(t *testing.T, n *simulator.Chain, dst string) {
+	t.Helper()
+	invoke(t, n, userMSP, "transfer", "transfer", dst, "alice", "bob", "10")
+}
+
+func TestDirectSuccess(t *testing.T) {
+	n := directNetwork(t)
+	setBalance(t, n, "bob", 0)
+
+	r := decodeReceipt(t, interchain(n, directRemote, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10)))
+	if r.Typ != 1 {
+		t.Fatalf("unexpected receipt %+v", r)
+	}
+	checkBalance(t, n, "bob", 10)
+
+	setBalance(t, n, "alice", 100)
+	emit(t, n, directRemote)
+	checkBalance(t, n, "alice", 90)
+	checkTxStatus(t, n, "1", txBegin)
+
+	if res := receipt(n, transferCID, directRemote, 1, 1, 0); res.Status != shim.OK {
+		t.Fatalf("invokeReceipt: %s", res.Message)
+	}
+	checkBalance(t, n, "alice", 90)
+	checkTxStatus(t, n, "1", txSuccess)
+}
+
+func TestDirectFailure(t *testing.T) {
+	n := directNetwork(t)
+
+	r := decodeReceipt(t, interchain(n, directRemote, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("nobody"), amount(10)))
+	if r.Typ != 2 {
+		t.Fatalf("unexpected receipt %+v", r)
+	}
+	if n.State("transfer", "nobody") != nil {
+		t.Fatal("failed interchain call changed the destination state")
+	}
+
+	setBalance(t, n, "alice", 100)
+	emit(t, n, directRemote)
+	if res := receipt(n, transferCID, directRemote, 1, 2, 0); res.Status != shim.OK {
+		t.Fatalf("invokeReceipt: %s", res.Message)
+	}
+	checkBalance(t, n, "alice", 100)
+	checkTxStatus(t, n, "1", txFail)
+
+	// receipts of unknown types are rejected in direct mode
+	emit(t, n, directRemote)
+	if res := receipt(n, transferCID, directRemote, 2, 5, 0); res.Status == shim.OK {
+		t.Fatal("receipt of type 5 accepted")
+	}
+	checkTxStatus(t, n, "2", txBegin)
+}
+
+func TestDirectRollback(t *testing.T) {
+	n := directNetwork(t)
+	setBalance(t, n, "bob", 0)
+
+	decodeReceipt(t, interchain(n, directRemote, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10)))
+	r := decodeReceipt(t, interchain(n, directRemote, transferCID, 1, 1, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10)))
+	if r.Typ != 4 {
+		t.Fatalf("unexpected receipt %+v", r)
+	}
+	checkBalance(t, n, "bob", 0)
+
+	setBalance(t, n, "alice", 100)
+	emit(t, n, directRemote)
+	if res := receipt(n, transferCID, directRemote, 1, 3, 0); res.Status != shim.OK {
+		t.Fatalf("invokeReceipt: %s", res.Message)
+	}
+	checkBalance(t, n, "alice", 100)
+	checkTxStatus(t, n, "1", txBeginRollback)
+}
+
+func TestDirectDuplicateIndex(t *testing.T) {
+	n := directNetwork(t)
+	setBalance(t, n, "bob", 0)
+
+	decodeReceipt(t, interchain(n, directRemote, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10)))
+	if res := interchain(n, directRemote, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10)); res.Status == shim.OK {
+		t.Fatal("duplicate interchain index accepted")
+	}
+	checkBalance(t, n, "bob", 10)
+
+	setBalance(t, n, "alice", 100)
+	emit(t, n, directRemote)
+	if res := receipt(n, transferCID, directRemote, 1, 1, 0); res.Status != shim.OK {
+		t.Fatalf("invokeReceipt: %s", res.Message)
+	}
+	if res := receipt(n, transferCID, directRemote, 1, 2, 0); res.Status == shim.OK {
+		t.Fatal("duplicate receipt index accepted")
+	}
+	checkBalance(t, n, "alice", 90)
+	checkTxStatus(t, n, "1", txSuccess)
+}
+
+func TestDirectUnregisteredService(t *testing.T) {
+	n := directNetwork(t)
+	setBalance(t, n, "alice", 100)
+	setBalance(t, n, "bob", 0)
+	unknown := ":appchain3:" + transferCID
+
+	invokeFail(t, n, "remote service is not registered", userMSP, "transfer", "transfer", unknown, "alice", "bob", "10")
+	checkBalance(t, n, "alice", 100)
+
+	if res := interchain(n, unknown, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10)); res.Status == shim.OK {
+		t.Fatal("interchain call from unregistered service accepted")
+	}
+	checkBalance(t, n, "bob", 0)
+
+	invokeFail(t, n, "this appchain is not registered", adminMSP, "broker", "registerRemoteService", "appchain3", transferCID, "")
+
+	// only the broker and the services it approved start transactions
+	invokeFail(t, n, "caller is not broker", userMSP, "transaction", "startTransaction", directLocal, directRemote, "1")
+	invokeFail(t, n, "caller is not broker", userMSP, "transaction", "registerLocalService", simulator.Channel+"&data_swapper")
+}
+
+func TestDirectBannedMSP(t *testing.T) {
+	n := directNetwork(t)
+	setBalance(t, n, "alice", 100)
+
+	invokeFail(t, n, "not allowed to call dest address", bannedMSP, "transfer", "transfer", directRemote, "alice", "bob", "10")
+	checkBalance(t, n, "alice", 100)
+	if got := meta(t, n, "getOuterMeta")[servicePair(directLocal, directRemote)]; got != 0 {
+		t.Fatalf("outer meta: got %d, want 0", got)
+	}
+
+	emit(t, n, directRemote)
+	checkBalance(t, n, "alice", 90)
+}
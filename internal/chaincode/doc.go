@@ -0,0 +1,7 @@
+// Package chaincode holds copies of the example chaincodes that the plugin
+// tests run in shimtest.MockStub, and tests of the chaincodes on the
+// simulator. Run `go generate` here after changing a chaincode under
+// example/contracts/src.
+package chaincode
+
+//go:generate sh gen.sh
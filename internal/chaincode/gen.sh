@@ -0,0 +1,28 @@
+#!/bin/sh
+# Copies the example chaincodes into importable packages for the plugin tests.
+# The package clause is renamed and the fabric 1.4 imports are moved to their
+# fabric 2 modules, the code itself is left untouched. The copies are written
+# next to this script, or under the directory given as first argument.
+set -e
+
+cd "$(dirname "$0")"
+src=../../example/contracts/src
+dst=${1:-.}
+
+for cc in broker transaction transfer data_swapper; do
+	pkg=$(echo "$cc" | tr -d _)
+	rm -rf "${dst:?}/$pkg"
+	mkdir -p "$dst/$pkg"
+	for f in "$src/$cc"/*.go; do
+		out="$dst/$pkg/$(basename "$f")"
+		echo "// Code generated by gen.sh from example/contracts/src/$cc. DO NOT EDIT." >"$out"
+		echo >>"$out"
+		sed -e "s/^package main$/package $pkg/" \
+			-e 's#github.com/hyperledger/fabric/core/chaincode/shim#github.com/hyperledger/fabric-chaincode-go/shim#' \
+			-e 's#github.com/hyperledger/fabric/core/chaincode/lib/cid#github.com/hyperledger/fabric-chaincode-go/pkg/cid#' \
+			-e 's#github.com/hyperledger/fabric/protos/peer#github.com/hyperledger/fabric-protos-go/peer#' \
+			-e 's#github.com/hyperledger/fabric/protos/msp#github.com/hyperledger/fabric-protos-go/msp#' \
+			"$f" >>"$out"
+		gofmt -w "$out"
+	done
+done
@@ -0,0 +1,54 @@
+package chaincode_test
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestGenerated fails when the copies are out of date with the chaincodes
+// under example/contracts/src.
+func TestGenerated(t *testing.T) {
+	for _, tool := range []string{"sh", "gofmt"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			t.Skipf("%s is not installed", tool)
+		}
+	}
+
+	dir := t.TempDir()
+	if out, err := exec.Command("sh", "gen.sh", dir).CombinedOutput(); err != nil {
+		t.Fatalf("gen.sh: %v\n%s", err, out)
+	}
+
+	for _, pkg := range []string{"broker", "transaction", "transfer", "dataswapper"} {
+		want, err := filepath.Glob(filepath.Join(dir, pkg, "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := filepath.Glob(filepath.Join(pkg, "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(want) {
+			t.Errorf("%s: got %d files, want %d, run go generate", pkg, len(got), len(want))
+			continue
+		}
+		for _, f := range want {
+			name := filepath.Join(pkg, filepath.Base(f))
+			wantBytes, err := os.ReadFile(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotBytes, err := os.ReadFile(name)
+			if err != nil {
+				t.Errorf("%s: %v, run go generate", name, err)
+				continue
+			}
+			if !bytes.Equal(gotBytes, wantBytes) {
+				t.Errorf("%s is out of date, run go generate", name)
+			}
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package chaincode_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/meshplus/pier-client-fabric/internal/chaincode/broker"
+	"github.com/meshplus/pier-client-fabric/internal/simulator"
+)
+
+const (
+	adminMSP  = simulator.AdminMSP
+	userMSP   = simulator.UserMSP
+	bannedMSP = "BannedMSP"
+
+	transferCID    = simulator.Channel + "&transfer"
+	dataSwapperCID = simulator.Channel + "&data_swapper"
+
+	relayLocal  = "1356:appchain1:" + transferCID
+	relayRemote = "1356:appchain2:" + transferCID
+
+	directLocal  = ":appchain1:" + transferCID
+	directRemote = ":appchain2:" + transferCID
+)
+
+// deploy starts the broker, transaction, transfer and data_swapper
+// chaincodes with adminMSP as the broker admin.
+func deploy(t *testing.T) *simulator.Chain {
+	n, err := simulator.NewNetwork()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return n
+}
+
+// relayNetwork is deployed in relay mode with transfer registered in the
+// broker. data_swapper is left unregistered.
+func relayNetwork(t *testing.T) *simulator.Chain {
+	n := deploy(t)
+	registerService(t, n, "transfer")
+
+	return n
+}
+
+// directNetwork is deployed in direct mode with appchain2 and its transfer
+// service registered, the service bans bannedMSP.
+func directNetwork(t *testing.T) *simulator.Chain {
+	n := deploy(t)
+	invoke(t, n, adminMSP, "broker", "initialize", "", "appchain1", "0")
+	invoke(t, n, adminMSP, "broker", "registerAppchain", "appchain2", "broker", "rule", "root")
+	invoke(t, n, adminMSP, "broker", "registerRemoteService", "appchain2", transferCID, bannedMSP)
+	registerService(t, n, "transfer")
+
+	return n
+}
+
+func registerService(t *testing.T, n *simulator.Chain, name string) {
+	invoke(t, n, userMSP, name, "register", "false")
+	invoke(t, n, adminMSP, "broker", "audit", simulator.Channel, name, "1")
+}
+
+// call runs fn of chaincode name as a client of mspID.
+func call(n *simulator.Chain, mspID, name, fn string, args ...string) peer.Response {
+	_, res := n.Invoke(mspID, name, fn, args...)
+	return res
+}
+
+func invoke(t *testing.T, n *simulator.Chain, mspID, name, fn string, args ...string) peer.Response {
+	t.Helper()
+	res := call(n, mspID, name, fn, args...)
+	if res.Status != shim.OK {
+		t.Fatalf("%s.%s: %s", name, fn, res.Message)
+	}
+
+	return res
+}
+
+func invokeFail(t *testing.T, n *simulator.Chain, want, mspID, name, fn string, args ...string) {
+	t.Helper()
+	res := call(n, mspID, name, fn, args...)
+	if res.Status == shim.OK {
+		t.Fatalf("%s.%s: expect failure %q", name, fn, want)
+	}
+	if !strings.Contains(res.Message, want) {
+		t.Fatalf("%s.%s: got %q, want %q", name, fn, res.Message, want)
+	}
+}
+
+// interchain delivers an interchain call from src to the local service dst.
+func interchain(n *simulator.Chain, src, dst string, index, txStatus uint64, fn string, args ...[]byte) peer.Response {
+	argsBytes, _ := json.Marshal(args)
+	return call(n, adminMSP, "broker", "invokeInterchain", src, dst,
+		strconv.FormatUint(index, 10), "0", fn, string(argsBytes),
+		strconv.FormatUint(txStatus, 10), "[]", "false")
+}
+
+// receipt delivers the receipt of the interchain call sent from the local
+// service src to dst.
+func receipt(n *simulator.Chain, src, dst string, index, typ, txStatus uint64, results ...[]byte) peer.Response {
+	resultsBytes, _ := json.Marshal(results)
+	return call(n, adminMSP, "broker", "invokeReceipt", src, dst,
+		strconv.FormatUint(index, 10), strconv.FormatUint(typ, 10),
+		string(resultsBytes), strconv.FormatUint(txStatus, 10), "[]")
+}
+
+func decodeReceipt(t *testing.T, res peer.Response) broker.Receipt {
+	t.Helper()
+	if res.Status != shim.OK {
+		t.Fatalf("invokeInterchain: %s", res.Message)
+	}
+	var resp struct {
+		Data []byte `json:"data"`
+	}
+	if err := json.Unmarshal(res.Payload, &resp); err != nil {
+		t.Fatal(err)
+	}
+	var r broker.Receipt
+	if err := json.Unmarshal(resp.Data, &r); err != nil {
+		t.Fatal(err)
+	}
+
+	return r
+}
+
+func meta(t *testing.T, n *simulator.Chain, fn string) map[string]uint64 {
+	t.Helper()
+	m := make(map[string]uint64)
+	if err := json.Unmarshal(invoke(t, n, userMSP, "broker", fn).Payload, &m); err != nil {
+		t.Fatal(err)
+	}
+
+	return m
+}
+
+func outMessage(t *testing.T, n *simulator.Chain, servicePair string, index uint64) broker.Event {
+	t.Helper()
+	var ev broker.Event
+	res := invoke(t, n, userMSP, "broker", "getOutMessage", servicePair, strconv.FormatUint(index, 10))
+	if err := json.Unmarshal(res.Payload, &ev); err != nil {
+		t.Fatal(err)
+	}
+
+	return ev
+}
+
+func setBalance(t *testing.T, n *simulator.Chain, account string, balance uint64) {
+	invoke(t, n, userMSP, "transfer", "setBalance", account, strconv.FormatUint(balance, 10))
+}
+
+func checkBalance(t *testing.T, n *simulator.Chain, account string, want uint64) {
+	t.Helper()
+	got := string(n.State("transfer", account))
+	if got != strconv.FormatUint(want, 10) {
+		t.Fatalf("balance of %s: got %q, want %d", account, got, want)
+	}
+}
+
+func amount(v uint64) []byte {
+	ret := make([]byte, 8)
+	binary.BigEndian.PutUint64(ret, v)
+	return ret
+}
+
+func servicePair(from, to string) string {
+	return from + "-" + to
+}
@@ -0,0 +1,237 @@
+package chaincode_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/meshplus/pier-client-fabric/internal/chaincode/broker"
+	"github.com/meshplus/pier-client-fabric/internal/simulator"
+)
+
+func TestRelaySuccess(t *testing.T) {
+	n := relayNetwork(t)
+	setBalance(t, n, "alice", 100)
+	setBalance(t, n, "bob", 0)
+
+	// outgoing transfer and its receipt
+	invoke(t, n, userMSP, "transfer", "transfer", relayRemote, "alice", "bob", "10")
+	checkBalance(t, n, "alice", 90)
+	if got := meta(t, n, "getOuterMeta")[servicePair(relayLocal, relayRemote)]; got != 1 {
+		t.Fatalf("outer meta: got %d, want 1", got)
+	}
+	ev := outMessage(t, n, servicePair(relayLocal, relayRemote), 1)
+	if ev.Index != 1 || ev.CallFunc.Func != "interchainCharge" || ev.RollBack.Func != "interchainRollback" {
+		t.Fatalf("unexpected out message %+v", ev)
+	}
+
+	if res := receipt(n, transferCID, relayRemote, 1, 1, 0); res.Status != shim.OK {
+		t.Fatalf("invokeReceipt: %s", res.Message)
+	}
+	checkBalance(t, n, "alice", 90)
+	if got := meta(t, n, "getCallbackMeta")[servicePair(relayLocal, relayRemote)]; got != 1 {
+		t.Fatalf("callback meta: got %d, want 1", got)
+	}
+
+	// incoming transfer
+	r := decodeReceipt(t, interchain(n, relayRemote, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10)))
+	if r.Typ != 1 || r.Index != 1 || r.Result.Status != shim.OK {
+		t.Fatalf("unexpected receipt %+v", r)
+	}
+	checkBalance(t, n, "bob", 10)
+	if got := meta(t, n, "getInnerMeta")[servicePair(relayRemote, relayLocal)]; got != 1 {
+		t.Fatalf("inner meta: got %d, want 1", got)
+	}
+}
+
+func TestRelayDataSwapper(t *testing.T) {
+	n := relayNetwork(t)
+	registerService(t, n, "data_swapper")
+	local := "1356:appchain1:" + dataSwapperCID
+	remote := "1356:appchain2:" + dataSwapperCID
+
+	// the receipt of an incoming get carries the value
+	invoke(t, n, userMSP, "data_swapper", "set", "key", "local")
+	r := decodeReceipt(t, interchain(n, remote, dataSwapperCID, 1, 0, "interchainGet", []byte("key")))
+	if r.Typ != 1 || string(r.Result.Payload) != "local" {
+		t.Fatalf("unexpected receipt %+v", r)
+	}
+
+	// the callback of an outgoing get stores the remote value
+	invoke(t, n, userMSP, "data_swapper", "get", remote, "key")
+	if ev := outMessage(t, n, servicePair(local, remote), 1); ev.CallBack.Func != "interchainSet" {
+		t.Fatalf("unexpected out message %+v", ev)
+	}
+	if res := receipt(n, dataSwapperCID, remote, 1, 1, 0, []byte("remote")); res.Status != shim.OK {
+		t.Fatalf("invokeReceipt: %s", res.Message)
+	}
+	if got := string(n.State("data_swapper", "key")); got != "remote" {
+		t.Fatalf("key: got %q, want remote", got)
+	}
+}
+
+func TestRelayFailure(t *testing.T) {
+	n := relayNetwork(t)
+
+	// the destination chaincode fails, the broker still records the receipt
+	r := decodeReceipt(t, interchain(n, relayRemote, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("nobody"), amount(10)))
+	if r.Typ != 2 || r.Result.Status == shim.OK {
+		t.Fatalf("unexpected receipt %+v", r)
+	}
+	if n.State("transfer", "nobody") != nil {
+		t.Fatal("failed interchain call changed the destination state")
+	}
+	if got := meta(t, n, "getInnerMeta")[servicePair(relayRemote, relayLocal)]; got != 1 {
+		t.Fatalf("inner meta: got %d, want 1", got)
+	}
+
+	// not enough funds, no interchain event is emitted
+	setBalance(t, n, "alice", 5)
+	invokeFail(t, n, "not sufficient funds", userMSP, "transfer", "transfer", relayRemote, "alice", "bob", "10")
+	if got := meta(t, n, "getOuterMeta")[servicePair(relayLocal, relayRemote)]; got != 0 {
+		t.Fatalf("outer meta: got %d, want 0", got)
+	}
+}
+
+func TestRelayRollback(t *testing.T) {
+	n := relayNetwork(t)
+	setBalance(t, n, "alice", 100)
+	setBalance(t, n, "bob", 0)
+
+	// the destination failed, the source rolls back
+	invoke(t, n, userMSP, "transfer", "transfer", relayRemote, "alice", "bob", "10")
+	checkBalance(t, n, "alice", 90)
+	if res := receipt(n, transferCID, relayRemote, 1, 2, 1); res.Status != shim.OK {
+		t.Fatalf("invokeReceipt: %s", res.Message)
+	}
+	checkBalance(t, n, "alice", 100)
+
+	// the source timed out, the destination rolls back
+	decodeReceipt(t, interchain(n, relayRemote, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10)))
+	checkBalance(t, n, "bob", 10)
+	r := decodeReceipt(t, interchain(n, relayRemote, transferCID, 1, 1, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10)))
+	if r.Typ != 2 {
+		t.Fatalf("unexpected receipt %+v", r)
+	}
+	checkBalance(t, n, "bob", 0)
+	if got := meta(t, n, "getDstRollbackMeta")[servicePair(relayRemote, relayLocal)]; got != 1 {
+		t.Fatalf("dst rollback meta: got %d, want 1", got)
+	}
+}
+
+func TestRelayDuplicateIndex(t *testing.T) {
+	n := relayNetwork(t)
+	setBalance(t, n, "alice", 100)
+	setBalance(t, n, "bob", 0)
+
+	decodeReceipt(t, interchain(n, relayRemote, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10)))
+	res := interchain(n, relayRemote, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10))
+	if res.Status == shim.OK {
+		t.Fatal("duplicate interchain index accepted")
+	}
+	checkBalance(t, n, "bob", 10)
+
+	invoke(t, n, userMSP, "transfer", "transfer", relayRemote, "alice", "bob", "10")
+	if res := receipt(n, transferCID, relayRemote, 1, 2, 1); res.Status != shim.OK {
+		t.Fatalf("invokeReceipt: %s", res.Message)
+	}
+	if res := receipt(n, transferCID, relayRemote, 1, 2, 1); res.Status == shim.OK {
+		t.Fatal("duplicate receipt index accepted")
+	}
+	checkBalance(t, n, "alice", 100)
+}
+
+func TestRelayInterchainMeta(t *testing.T) {
+	n := relayNetwork(t)
+	setBalance(t, n, "bob", 0)
+
+	argsBytes, _ := json.Marshal([][]byte{[]byte("carol"), []byte("bob"), amount(10)})
+	args := []string{relayRemote, transferCID, "1", "0", "interchainCharge", string(argsBytes), "0", "[]", "false", "42", `{"1356:appchain2:mychannel&transfer":3}`}
+	r := decodeReceipt(t, call(n, adminMSP, "broker", "invokeInterchain", args...))
+	if r.Index != 1 || r.TimeoutHeight != 42 || r.Group["1356:appchain2:mychannel&transfer"] != 3 {
+		t.Fatalf("unexpected receipt %+v", r)
+	}
+	var stored broker.Receipt
+	if err := json.Unmarshal(invoke(t, n, userMSP, "broker", "getInMessage", servicePair(relayRemote, relayLocal), "1").Payload, &stored); err != nil {
+		t.Fatal(err)
+	}
+	if stored.TimeoutHeight != 42 || len(stored.Group) != 1 {
+		t.Fatalf("unexpected stored receipt %+v", stored)
+	}
+
+	args[2] = "2"
+	invokeFail(t, n, "expecting 9 to 11", adminMSP, "broker", "invokeInterchain", append(args, "extra")...)
+	args[9] = "forty-two"
+	invokeFail(t, n, "timeout height", adminMSP, "broker", "invokeInterchain", args...)
+}
+
+func TestRelayUnregisteredService(t *testing.T) {
+	n := relayNetwork(t)
+	remote := "1356:appchain2:" + dataSwapperCID
+
+	invokeFail(t, n, "unregister chaincode", userMSP, "data_swapper", "get", remote, "key")
+
+	res := interchain(n, remote, dataSwapperCID, 1, 0, "interchainGet", []byte("key"))
+	if res.Status == shim.OK {
+		t.Fatal("interchain call to unregistered service accepted")
+	}
+
+	// pending registration is not enough
+	invoke(t, n, userMSP, "data_swapper", "register", "false")
+	invokeFail(t, n, "unregister chaincode", userMSP, "data_swapper", "get", remote, "key")
+}
+
+func TestRelayNonAdmin(t *testing.T) {
+	n := relayNetwork(t)
+
+	invokeFail(t, n, "non-admin", userMSP, "broker", "invokeInterchain",
+		relayRemote, transferCID, "1", "0", "interchainCharge", "[]", "0", "[]", "false")
+	invokeFail(t, n, "non-admin", userMSP, "broker", "audit", simulator.Channel, "data_swapper", "1")
+}
+
+func TestRelayMessageRange(t *testing.T) {
+	n := relayNetwork(t)
+	setBalance(t, n, "alice", 100)
+	setBalance(t, n, "bob", 0)
+
+	for i := 0; i < 3; i++ {
+		invoke(t, n, userMSP, "transfer", "transfer", relayRemote, "alice", "bob", "10")
+	}
+	var events []broker.Event
+	res := invoke(t, n, userMSP, "broker", "getOutMessages", servicePair(relayLocal, relayRemote), "2", "5")
+	if err := json.Unmarshal(res.Payload, &events); err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 || events[0].Index != 2 || events[1].Index != 3 {
+		t.Fatalf("unexpected out messages %+v", events)
+	}
+	if events[0].TxID == "" || events[0].TxID == events[1].TxID {
+		t.Fatalf("out messages carry tx ids %q and %q", events[0].TxID, events[1].TxID)
+	}
+	if events[0].Timestamp == 0 || events[1].Timestamp < events[0].Timestamp {
+		t.Fatalf("out messages carry timestamps %d and %d", events[0].Timestamp, events[1].Timestamp)
+	}
+	if ev := outMessage(t, n, servicePair(relayLocal, relayRemote), 2); ev.TxID != events[0].TxID {
+		t.Fatalf("out message 2 carries tx id %q, range %q", ev.TxID, events[0].TxID)
+	}
+
+	r := decodeReceipt(t, interchain(n, relayRemote, transferCID, 1, 0, "interchainCharge",
+		[]byte("carol"), []byte("bob"), amount(10)))
+	var receipts []broker.Receipt
+	res = invoke(t, n, userMSP, "broker", "getInMessages", servicePair(relayRemote, relayLocal), "1", "1")
+	if err := json.Unmarshal(res.Payload, &receipts); err != nil {
+		t.Fatal(err)
+	}
+	if len(receipts) != 1 || receipts[0].Index != 1 || receipts[0].TxID == "" || receipts[0].TxID != r.TxID {
+		t.Fatalf("unexpected receipts %+v", receipts)
+	}
+
+	invokeFail(t, n, "invalid range", userMSP, "broker", "getOutMessages", servicePair(relayLocal, relayRemote), "3", "2")
+	invokeFail(t, n, "invalid range", userMSP, "broker", "getOutMessages", servicePair(relayLocal, relayRemote), "1", "1000000")
+}
@@ -0,0 +1,77 @@
+package simulator
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+)
+
+// eventBuffer is the capacity of a registration's channel. Like the sdk, the
+// simulator drops events a slow consumer has no room for.
+const eventBuffer = 100
+
+type registration struct {
+	ccID   string
+	filter *regexp.Regexp
+	ch     chan *fab.CCEvent
+}
+
+type eventHub struct {
+	mu   sync.Mutex
+	regs map[*registration]struct{}
+}
+
+// RegisterChaincodeEvent delivers the events of chaincode ccID whose name
+// matches eventFilter, see event.Client.
+func (c *Chain) RegisterChaincodeEvent(ccID, eventFilter string) (fab.Registration, <-chan *fab.CCEvent, error) {
+	filter, err := regexp.Compile(eventFilter)
+	if err != nil {
+		return nil, nil, fmt.Errorf("compile event filter %s: %w", eventFilter, err)
+	}
+	reg := &registration{
+		ccID:   ccID,
+		filter: filter,
+		ch:     make(chan *fab.CCEvent, eventBuffer),
+	}
+
+	c.events.mu.Lock()
+	defer c.events.mu.Unlock()
+	if c.events.regs == nil {
+		c.events.regs = make(map[*registration]struct{})
+	}
+	c.events.regs[reg] = struct{}{}
+
+	return reg, reg.ch, nil
+}
+
+// Unregister stops reg and closes its channel.
+func (c *Chain) Unregister(reg fab.Registration) {
+	r, ok := reg.(*registration)
+	if !ok {
+		return
+	}
+
+	c.events.mu.Lock()
+	defer c.events.mu.Unlock()
+	if _, ok := c.events.regs[r]; ok {
+		delete(c.events.regs, r)
+		close(r.ch)
+	}
+}
+
+func (h *eventHub) publish(ev *fab.CCEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for reg := range h.regs {
+		if reg.ccID != ev.ChaincodeID || !reg.filter.MatchString(ev.EventName) {
+			continue
+		}
+		select {
+		case reg.ch <- ev:
+		default:
+		}
+	}
+}
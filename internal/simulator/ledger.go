@@ -0,0 +1,225 @@
+package simulator
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/ledger"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/hyperledger/fabric/protoutil"
+)
+
+type committedTx struct {
+	tx    *peer.ProcessedTransaction
+	block uint64
+}
+
+// Execute submits request as AdminMSP, see channel.Client.
+func (c *Chain) Execute(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	return respond(c.invoke(AdminMSP, request.ChaincodeID, request.Fcn, request.Args, true))
+}
+
+// Query evaluates request as AdminMSP without committing it, see
+// channel.Client. Like the sdk, it answers with the endorsed proposal
+// responses.
+func (c *Chain) Query(request channel.Request, options ...channel.RequestOption) (channel.Response, error) {
+	return respond(c.invoke(AdminMSP, request.ChaincodeID, request.Fcn, request.Args, false))
+}
+
+func respond(txID fab.TransactionID, res peer.Response, e *endorsement) (channel.Response, error) {
+	if res.Status != shim.OK {
+		// the error the sdk reports for chaincode errors
+		return channel.Response{}, fmt.Errorf("Transaction processing for endorser [simulator]: Chaincode status Code: (%d) UNKNOWN. Description: %s", res.Status, res.Message)
+	}
+
+	ret := channel.Response{
+		Proposal:         &fab.TransactionProposal{TxnID: txID, Proposal: e.proposal},
+		TransactionID:    txID,
+		TxValidationCode: peer.TxValidationCode_VALID,
+		ChaincodeStatus:  res.Status,
+		Payload:          res.Payload,
+	}
+	for i, r := range e.responses {
+		ret.Responses = append(ret.Responses, &fab.TransactionProposalResponse{
+			Endorser:         fmt.Sprintf("peer%d.simulator", i),
+			Status:           r.Response.Status,
+			ChaincodeStatus:  res.Status,
+			ProposalResponse: r,
+		})
+	}
+
+	return ret, nil
+}
+
+// endorsement is a proposal and the responses of the endorsing peers to it.
+type endorsement struct {
+	header    *common.Header
+	proposal  *peer.Proposal
+	responses []*peer.ProposalResponse
+}
+
+// endorse has every peer sign the response res of chaincode name to input,
+// sent in transaction txID by the client of the running transaction, along
+// with the read-write set results of its simulation.
+func (c *Chain) endorse(txID fab.TransactionID, name string, input [][]byte, res peer.Response, results []byte) (*endorsement, error) {
+	chdr := protoutil.MakeChannelHeader(common.HeaderType_ENDORSER_TRANSACTION, 0, Channel, 0)
+	chdr.TxId = string(txID)
+	chdr.Timestamp = ptypes.TimestampNow()
+	chdr.Extension = protoutil.MarshalOrPanic(&peer.ChaincodeHeaderExtension{ChaincodeId: &peer.ChaincodeID{Name: name}})
+	header := protoutil.MakePayloadHeader(chdr, protoutil.MakeSignatureHeader(c.creator, nil))
+	payload := protoutil.MarshalOrPanic(&peer.ChaincodeProposalPayload{
+		Input: protoutil.MarshalOrPanic(invocationSpec(name, input)),
+	})
+	hash, err := protoutil.GetProposalHash1(header, payload)
+	if err != nil {
+		return nil, fmt.Errorf("hash proposal: %w", err)
+	}
+	prp := protoutil.MarshalOrPanic(&peer.ProposalResponsePayload{
+		ProposalHash: hash,
+		Extension: protoutil.MarshalOrPanic(&peer.ChaincodeAction{
+			Results:     results,
+			Response:    &res,
+			ChaincodeId: &peer.ChaincodeID{Name: name},
+		}),
+	})
+
+	e := &endorsement{
+		header:   header,
+		proposal: &peer.Proposal{Header: protoutil.MarshalOrPanic(header), Payload: payload},
+	}
+	for _, p := range c.peers {
+		signed := make([]byte, 0, len(prp)+len(p.serialized))
+		signed = append(append(signed, prp...), p.serialized...)
+		sig, err := p.sign(signed)
+		if err != nil {
+			return nil, fmt.Errorf("endorse: %w", err)
+		}
+		e.responses = append(e.responses, &peer.ProposalResponse{
+			Version:     1,
+			Timestamp:   chdr.Timestamp,
+			Response:    &peer.Response{Status: shim.OK, Payload: res.Payload},
+			Payload:     prp,
+			Endorsement: &peer.Endorsement{Endorser: p.serialized, Signature: sig},
+		})
+	}
+
+	return e, nil
+}
+
+func (c *Chain) QueryTransaction(txID fab.TransactionID, options ...ledger.RequestOption) (*peer.ProcessedTransaction, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t, ok := c.txs[txID]
+	if !ok {
+		return nil, fmt.Errorf("transaction %s not found", txID)
+	}
+
+	return proto.Clone(t.tx).(*peer.ProcessedTransaction), nil
+}
+
+func (c *Chain) QueryBlockByTxID(txID fab.TransactionID, options ...ledger.RequestOption) (*common.Block, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t, ok := c.txs[txID]
+	if !ok {
+		return nil, fmt.Errorf("transaction %s not found", txID)
+	}
+
+	return proto.Clone(c.blocks[t.block]).(*common.Block), nil
+}
+
+func (c *Chain) QueryConfig(options ...ledger.RequestOption) (fab.ChannelCfg, error) {
+	return nil, fmt.Errorf("channel config is not simulated")
+}
+
+// Height is the number of blocks, the genesis block included.
+func (c *Chain) Height() uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return uint64(len(c.blocks))
+}
+
+// Invalidate marks the committed transaction txID as invalid with an MVCC
+// read conflict.
+func (c *Chain) Invalidate(txID fab.TransactionID) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t, ok := c.txs[txID]
+	if !ok {
+		return fmt.Errorf("transaction %s not found", txID)
+	}
+	t.tx.ValidationCode = int32(peer.TxValidationCode_MVCC_READ_CONFLICT)
+	c.blocks[t.block].Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = []byte{byte(t.tx.ValidationCode)}
+
+	return nil
+}
+
+// commit lays the endorsed transaction out the way fabric does, signed by
+// the client of mspID, and appends it to the ledger in a new block, whose
+// number is returned.
+func (c *Chain) commit(txID fab.TransactionID, mspID string, e *endorsement) (uint64, error) {
+	cpp, err := protoutil.UnmarshalChaincodeProposalPayload(e.proposal.Payload)
+	if err != nil {
+		return 0, err
+	}
+	cppBytes, err := protoutil.GetBytesProposalPayloadForTx(cpp)
+	if err != nil {
+		return 0, err
+	}
+	action := &peer.ChaincodeEndorsedAction{ProposalResponsePayload: e.responses[0].Payload}
+	for _, r := range e.responses {
+		action.Endorsements = append(action.Endorsements, r.Endorsement)
+	}
+	tx := &peer.Transaction{
+		Actions: []*peer.TransactionAction{{
+			Header: e.header.SignatureHeader,
+			Payload: protoutil.MarshalOrPanic(&peer.ChaincodeActionPayload{
+				ChaincodeProposalPayload: cppBytes,
+				Action:                   action,
+			}),
+		}},
+	}
+
+	payload := protoutil.MarshalOrPanic(&common.Payload{Header: e.header, Data: protoutil.MarshalOrPanic(tx)})
+	sig, err := c.clients[mspID].sign(payload)
+	if err != nil {
+		return 0, fmt.Errorf("sign transaction: %w", err)
+	}
+	env := &common.Envelope{Payload: payload, Signature: sig}
+
+	number := uint64(len(c.blocks))
+	prev := c.blocks[number-1]
+	block := newBlock(number, protoutil.BlockHeaderHash(prev.Header), [][]byte{protoutil.MarshalOrPanic(env)})
+	c.blocks = append(c.blocks, block)
+	c.txs[txID] = &committedTx{
+		tx: &peer.ProcessedTransaction{
+			TransactionEnvelope: env,
+			ValidationCode:      int32(peer.TxValidationCode_VALID),
+		},
+		block: number,
+	}
+
+	return number, nil
+}
+
+func newBlock(number uint64, prevHash []byte, envelopes [][]byte) *common.Block {
+	block := protoutil.NewBlock(number, prevHash)
+	block.Data.Data = envelopes
+	block.Header.DataHash = protoutil.BlockDataHash(block.Data)
+	filter := make([]byte, len(envelopes))
+	for i := range filter {
+		filter[i] = byte(peer.TxValidationCode_VALID)
+	}
+	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = filter
+
+	return block
+}
@@ -0,0 +1,205 @@
+package simulator
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-protos-go/msp"
+	"github.com/hyperledger/fabric/bccsp/utils"
+	"github.com/hyperledger/fabric/common/policydsl"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/meshplus/pier-client-fabric/verifier"
+)
+
+// EndorsementPolicy is the policy the broker is endorsed with, the one the
+// validator command registers for fabric appchains.
+const EndorsementPolicy = "AND('Org2MSP.peer', 'Org1MSP.peer')"
+
+// endorsingOrgs run the peers endorsing every transaction.
+var endorsingOrgs = []string{AdminMSP, UserMSP}
+
+// org is an MSP with a CA of its own issuing client and peer certificates,
+// told apart by node OUs like the orgs of fabric test networks.
+type org struct {
+	mspID  string
+	key    *ecdsa.PrivateKey
+	cert   *x509.Certificate
+	pem    []byte
+	serial int64
+}
+
+func newOrg(mspID string) (*org, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate ca key of %s: %w", mspID, err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca." + mspID, Organization: []string{mspID}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, fmt.Errorf("create ca certificate of %s: %w", mspID, err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse ca certificate of %s: %w", mspID, err)
+	}
+
+	return &org{
+		mspID:  mspID,
+		key:    key,
+		cert:   cert,
+		pem:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		serial: 1,
+	}, nil
+}
+
+// identity is a client or peer of an org.
+type identity struct {
+	key *ecdsa.PrivateKey
+	// serialized is the msp.SerializedIdentity of the identity
+	serialized []byte
+}
+
+// issue returns a new identity of o with the node OU ou, client or peer.
+func (o *org) issue(ou string) (*identity, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("generate %s key of %s: %w", ou, o.mspID, err)
+	}
+	o.serial++
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(o.serial),
+		Subject: pkix.Name{
+			CommonName:         fmt.Sprintf("%s%d.%s", ou, o.serial, o.mspID),
+			Organization:       []string{o.mspID},
+			OrganizationalUnit: []string{ou},
+		},
+		NotBefore: time.Now().Add(-time.Hour),
+		NotAfter:  time.Now().Add(24 * time.Hour),
+		KeyUsage:  x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, o.cert, &key.PublicKey, o.key)
+	if err != nil {
+		return nil, fmt.Errorf("create %s certificate of %s: %w", ou, o.mspID, err)
+	}
+	serialized, err := protoutil.Marshal(&msp.SerializedIdentity{
+		Mspid:   o.mspID,
+		IdBytes: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity{key: key, serialized: serialized}, nil
+}
+
+// config returns the MSP config of o in text format, as the validator
+// command exports it from the channel config.
+func (o *org) config() (string, error) {
+	conf, err := protoutil.Marshal(&msp.FabricMSPConfig{
+		Name:      o.mspID,
+		RootCerts: [][]byte{o.pem},
+		FabricNodeOus: &msp.FabricNodeOUs{
+			Enable:             true,
+			ClientOuIdentifier: &msp.FabricOUIdentifier{OrganizationalUnitIdentifier: "client"},
+			PeerOuIdentifier:   &msp.FabricOUIdentifier{OrganizationalUnitIdentifier: "peer"},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return proto.MarshalTextString(&msp.MSPConfig{Config: conf}), nil
+}
+
+// sign signs msg the way fabric peers do, with a low-S ECDSA signature of its
+// SHA-256 digest.
+func (id *identity) sign(msg []byte) ([]byte, error) {
+	digest := sha256.Sum256(msg)
+	sig, err := ecdsa.SignASN1(rand.Reader, id.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return utils.SignatureToLowS(&id.key.PublicKey, sig)
+}
+
+// Validator returns the validator bitxhub checks the broker proofs of c
+// with: the broker chaincode id, EndorsementPolicy and the MSP configs of the
+// endorsing orgs.
+func (c *Chain) Validator() (*verifier.Validator, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	policy, err := policydsl.FromString(EndorsementPolicy)
+	if err != nil {
+		return nil, err
+	}
+	policyBytes, err := protoutil.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &verifier.Validator{Cid: "broker", Policy: string(policyBytes)}
+	for _, mspID := range endorsingOrgs {
+		o, err := c.org(mspID)
+		if err != nil {
+			return nil, err
+		}
+		conf, err := o.config()
+		if err != nil {
+			return nil, err
+		}
+		v.ConfByte = append(v.ConfByte, conf)
+	}
+
+	return v, nil
+}
+
+// org returns the org of mspID, creating it on first use.
+func (c *Chain) org(mspID string) (*org, error) {
+	if o, ok := c.orgs[mspID]; ok {
+		return o, nil
+	}
+	o, err := newOrg(mspID)
+	if err != nil {
+		return nil, err
+	}
+	c.orgs[mspID] = o
+
+	return o, nil
+}
+
+// client returns the client identity of mspID, creating it on first use.
+func (c *Chain) client(mspID string) (*identity, error) {
+	if id, ok := c.clients[mspID]; ok {
+		return id, nil
+	}
+	o, err := c.org(mspID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := o.issue("client")
+	if err != nil {
+		return nil, err
+	}
+	c.clients[mspID] = id
+
+	return id, nil
+}
@@ -0,0 +1,399 @@
+// Package simulator runs a fabric channel with the example chaincodes in a
+// single process for tests. A Chain serves the channel, ledger and event
+// clients the plugin talks to: it executes chaincodes in shimtest.MockStub,
+// commits every successful transaction in a block of its own, emits the
+// chaincode events of committed transactions and answers ledger queries.
+package simulator
+
+import (
+	"bytes"
+	"container/list"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-chaincode-go/shimtest"
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/meshplus/pier-client-fabric/internal/chaincode/broker"
+	"github.com/meshplus/pier-client-fabric/internal/chaincode/dataswapper"
+	"github.com/meshplus/pier-client-fabric/internal/chaincode/transaction"
+	"github.com/meshplus/pier-client-fabric/internal/chaincode/transfer"
+)
+
+const (
+	Channel   = "mychannel"
+	BitXHubID = "1356"
+
+	// AdminMSP deploys the chaincodes and is the broker admin, the plugin
+	// submits its transactions as AdminMSP
+	AdminMSP = "Org2MSP"
+	// UserMSP calls the business chaincodes
+	UserMSP = "Org1MSP"
+
+	// Services are the business chaincodes registered in the broker
+	Transfer    = "transfer"
+	DataSwapper = "data_swapper"
+)
+
+// Chain is a simulated channel. Chains started with New are an appchain
+// running the broker in relay mode with the transfer and data_swapper
+// services registered.
+type Chain struct {
+	appchainID string
+
+	mu       sync.Mutex
+	stubs    map[string]*shimtest.MockStub
+	orgs     map[string]*org
+	clients  map[string]*identity
+	peers    []*identity
+	creator  []byte
+	proposal *peer.SignedProposal
+	seq      uint64
+
+	blocks []*common.Block
+	txs    map[fab.TransactionID]*committedTx
+
+	events eventHub
+}
+
+// NewNetwork starts a channel with the example chaincodes deployed and the
+// broker left uninitialized, for tests of the chaincodes themselves.
+func NewNetwork() (*Chain, error) {
+	c := &Chain{
+		stubs:   make(map[string]*shimtest.MockStub),
+		orgs:    make(map[string]*org),
+		clients: make(map[string]*identity),
+		txs:     make(map[fab.TransactionID]*committedTx),
+	}
+	c.blocks = append(c.blocks, newBlock(0, nil, nil))
+	for _, mspID := range endorsingOrgs {
+		o, err := c.org(mspID)
+		if err != nil {
+			return nil, err
+		}
+		p, err := o.issue("peer")
+		if err != nil {
+			return nil, err
+		}
+		c.peers = append(c.peers, p)
+	}
+
+	for _, cc := range []struct {
+		name string
+		cc   shim.Chaincode
+	}{
+		{"broker", new(broker.Broker)},
+		{"transaction", new(transaction.Transaction)},
+		{Transfer, new(transfer.Transfer)},
+		{DataSwapper, new(dataswapper.DataSwapper)},
+	} {
+		if err := c.Deploy(cc.name, cc.cc); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// New starts appchainID with the example chaincodes deployed.
+func New(appchainID string) (*Chain, error) {
+	c, err := NewNetwork()
+	if err != nil {
+		return nil, err
+	}
+	c.appchainID = appchainID
+
+	if err := c.mustInvoke(AdminMSP, "broker", "initialize", BitXHubID, appchainID, "1"); err != nil {
+		return nil, err
+	}
+	for _, name := range []string{Transfer, DataSwapper} {
+		if err := c.mustInvoke(UserMSP, name, "register", "false"); err != nil {
+			return nil, err
+		}
+		if err := c.mustInvoke(AdminMSP, "broker", "audit", Channel, name, "1"); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+// AppchainID is the id of the chain in bitxhub.
+func (c *Chain) AppchainID() string {
+	return c.appchainID
+}
+
+// ServiceID returns the full id of the local service name.
+func (c *Chain) ServiceID(name string) string {
+	return fmt.Sprintf("%s:%s:%s&%s", BitXHubID, c.appchainID, Channel, name)
+}
+
+// Deploy starts cc as chaincode name, initialized by AdminMSP. It may call
+// and be called by the chaincodes deployed before.
+func (c *Chain) Deploy(name string, cc shim.Chaincode) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stub := shimtest.NewMockStub(name, &chaincode{chain: c, cc: cc})
+	stub.ChannelID = Channel
+	for other, otherStub := range c.stubs {
+		stub.MockPeerChaincode(other, otherStub, Channel)
+		otherStub.MockPeerChaincode(name, stub, Channel)
+	}
+	c.stubs[name] = stub
+
+	if err := c.begin(AdminMSP, name); err != nil {
+		return err
+	}
+	if res := stub.MockInit(fmt.Sprintf("init-%s", name), nil); res.Status != shim.OK {
+		return fmt.Errorf("init %s: %s", name, res.Message)
+	}
+
+	return nil
+}
+
+// Invoke runs fn of chaincode name as a client of mspID and commits the
+// transaction if it succeeds.
+func (c *Chain) Invoke(mspID, name, fn string, args ...string) (fab.TransactionID, peer.Response) {
+	var bargs [][]byte
+	for _, a := range args {
+		bargs = append(bargs, []byte(a))
+	}
+
+	txID, res, _ := c.invoke(mspID, name, fn, bargs, true)
+	return txID, res
+}
+
+func (c *Chain) mustInvoke(mspID, name, fn string, args ...string) error {
+	if _, res := c.Invoke(mspID, name, fn, args...); res.Status != shim.OK {
+		return fmt.Errorf("%s.%s: %s", name, fn, res.Message)
+	}
+
+	return nil
+}
+
+// invoke runs fn of chaincode name as a client of mspID and has the peers of
+// the endorsing orgs endorse it. State changes are kept and the transaction
+// committed only if it succeeds and commit is set.
+func (c *Chain) invoke(mspID, name, fn string, args [][]byte, commit bool) (fab.TransactionID, peer.Response, *endorsement) {
+	c.mu.Lock()
+
+	stub, ok := c.stubs[name]
+	if !ok {
+		c.mu.Unlock()
+		return "", shim.Error(fmt.Sprintf("chaincode %s is not deployed", name)), nil
+	}
+	if err := c.begin(mspID, name); err != nil {
+		c.mu.Unlock()
+		return "", shim.Error(err.Error()), nil
+	}
+
+	c.seq++
+	txID := fab.TransactionID(fmt.Sprintf("%064x", c.seq))
+	snapshot := c.snapshot()
+	input := append([][]byte{[]byte(fn)}, args...)
+	res := stub.MockInvokeWithSignedProposal(string(txID), input, c.proposal)
+	events := c.drainEvents()
+	if res.Status != shim.OK {
+		c.restore(snapshot)
+		c.mu.Unlock()
+		return txID, res, nil
+	}
+	results, err := c.writeSet(snapshot)
+	if err != nil {
+		c.restore(snapshot)
+		c.mu.Unlock()
+		return txID, shim.Error(err.Error()), nil
+	}
+	e, err := c.endorse(txID, name, input, res, results)
+	if err != nil {
+		c.restore(snapshot)
+		c.mu.Unlock()
+		return txID, shim.Error(err.Error()), nil
+	}
+	if !commit {
+		c.restore(snapshot)
+		c.mu.Unlock()
+		return txID, res, e
+	}
+
+	// a peer only keeps the last event set by the invoked chaincode
+	var ev *fab.CCEvent
+	if e := events[name]; e != nil {
+		ev = &fab.CCEvent{
+			TxID:        string(txID),
+			ChaincodeID: name,
+			EventName:   e.EventName,
+			Payload:     e.Payload,
+			SourceURL:   "simulator",
+		}
+	}
+	block, err := c.commit(txID, mspID, e)
+	c.mu.Unlock()
+	if err != nil {
+		return txID, shim.Error(err.Error()), nil
+	}
+
+	if ev != nil {
+		ev.BlockNumber = block
+		c.events.publish(ev)
+	}
+
+	return txID, res, e
+}
+
+// State returns the value of key in the state of chaincode name.
+func (c *Chain) State(name, key string) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stubs[name].State[key]
+}
+
+// begin makes mspID the client of the next transaction, sent to name.
+func (c *Chain) begin(mspID, name string) error {
+	client, err := c.client(mspID)
+	if err != nil {
+		return err
+	}
+	c.creator = client.serialized
+	c.proposal = &peer.SignedProposal{
+		ProposalBytes: protoutil.MarshalOrPanic(&peer.Proposal{
+			Payload: protoutil.MarshalOrPanic(&peer.ChaincodeProposalPayload{
+				Input: protoutil.MarshalOrPanic(invocationSpec(name, nil)),
+			}),
+		}),
+	}
+
+	return nil
+}
+
+// drainEvents returns the last event each chaincode set since the last call.
+func (c *Chain) drainEvents() map[string]*peer.ChaincodeEvent {
+	ret := make(map[string]*peer.ChaincodeEvent)
+	for name, stub := range c.stubs {
+		for drained := false; !drained; {
+			select {
+			case e := <-stub.ChaincodeEventsChannel:
+				ret[name] = e
+			default:
+				drained = true
+			}
+		}
+	}
+
+	return ret
+}
+
+type state struct {
+	values map[string][]byte
+	keys   []interface{}
+}
+
+func (c *Chain) snapshot() map[string]state {
+	ret := make(map[string]state, len(c.stubs))
+	for name, stub := range c.stubs {
+		s := state{values: make(map[string][]byte, len(stub.State))}
+		for k, v := range stub.State {
+			s.values[k] = v
+		}
+		for e := stub.Keys.Front(); e != nil; e = e.Next() {
+			s.keys = append(s.keys, e.Value)
+		}
+		ret[name] = s
+	}
+
+	return ret
+}
+
+// writeSet lays the state changes since snapshot out as the read-write set
+// of a transaction, with the writes of every chaincode in a namespace of its
+// own. Reads are not tracked.
+func (c *Chain) writeSet(snapshot map[string]state) ([]byte, error) {
+	txRWSet := &rwset.TxReadWriteSet{DataModel: rwset.TxReadWriteSet_KV}
+	names := make([]string, 0, len(c.stubs))
+	for name := range c.stubs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		before, after := snapshot[name].values, c.stubs[name].State
+		kvRWSet := &kvrwset.KVRWSet{}
+		for key, value := range after {
+			if old, ok := before[key]; !ok || !bytes.Equal(old, value) {
+				kvRWSet.Writes = append(kvRWSet.Writes, &kvrwset.KVWrite{Key: key, Value: value})
+			}
+		}
+		for key := range before {
+			if _, ok := after[key]; !ok {
+				kvRWSet.Writes = append(kvRWSet.Writes, &kvrwset.KVWrite{Key: key, IsDelete: true})
+			}
+		}
+		if len(kvRWSet.Writes) == 0 {
+			continue
+		}
+		sort.Slice(kvRWSet.Writes, func(i, j int) bool { return kvRWSet.Writes[i].Key < kvRWSet.Writes[j].Key })
+		nsRWSet, err := proto.Marshal(kvRWSet)
+		if err != nil {
+			return nil, fmt.Errorf("marshal write set of %s: %w", name, err)
+		}
+		txRWSet.NsRwset = append(txRWSet.NsRwset, &rwset.NsReadWriteSet{Namespace: name, Rwset: nsRWSet})
+	}
+
+	return proto.Marshal(txRWSet)
+}
+
+func (c *Chain) restore(snapshot map[string]state) {
+	for name, s := range snapshot {
+		stub := c.stubs[name]
+		stub.State = s.values
+		stub.Keys = list.New()
+		for _, k := range s.keys {
+			stub.Keys.PushBack(k)
+		}
+	}
+}
+
+// chaincode hands cc a stub reporting the client and proposal of the running
+// transaction, as a peer does for chaincode to chaincode calls.
+type chaincode struct {
+	chain *Chain
+	cc    shim.Chaincode
+}
+
+func (c *chaincode) Init(stub shim.ChaincodeStubInterface) peer.Response {
+	return c.cc.Init(&clientStub{ChaincodeStubInterface: stub, chain: c.chain})
+}
+
+func (c *chaincode) Invoke(stub shim.ChaincodeStubInterface) peer.Response {
+	return c.cc.Invoke(&clientStub{ChaincodeStubInterface: stub, chain: c.chain})
+}
+
+type clientStub struct {
+	shim.ChaincodeStubInterface
+	chain *Chain
+}
+
+func (s *clientStub) GetCreator() ([]byte, error) {
+	return s.chain.creator, nil
+}
+
+func (s *clientStub) GetSignedProposal() (*peer.SignedProposal, error) {
+	return s.chain.proposal, nil
+}
+
+func invocationSpec(name string, input [][]byte) *peer.ChaincodeInvocationSpec {
+	return &peer.ChaincodeInvocationSpec{
+		ChaincodeSpec: &peer.ChaincodeSpec{
+			ChaincodeId: &peer.ChaincodeID{Name: name},
+			Input:       &peer.ChaincodeInput{Args: input},
+		},
+	}
+}
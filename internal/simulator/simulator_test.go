@@ -0,0 +1,191 @@
+package simulator
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/meshplus/bitxhub-core/validator/validatorlib"
+	"github.com/meshplus/pier-client-fabric/proof"
+)
+
+// emitter sets an event named after its first argument.
+type emitter struct{}
+
+func (emitter) Init(stub shim.ChaincodeStubInterface) peer.Response {
+	return shim.Success(nil)
+}
+
+func (emitter) Invoke(stub shim.ChaincodeStubInterface) peer.Response {
+	_, args := stub.GetFunctionAndParameters()
+	if err := stub.SetEvent(args[0], []byte(args[1])); err != nil {
+		return shim.Error(err.Error())
+	}
+	if len(args) > 2 {
+		return shim.Error(args[2])
+	}
+
+	return shim.Success(nil)
+}
+
+func newChain(t *testing.T) *Chain {
+	c, err := New("appchain1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return c
+}
+
+func TestLedger(t *testing.T) {
+	c := newChain(t)
+	height := c.Height()
+
+	res, err := c.Execute(channel.Request{ChaincodeID: "broker", Fcn: "getChainId"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.Payload) != BitXHubID+"-appchain1" {
+		t.Fatalf("unexpected chain id %s", res.Payload)
+	}
+	if c.Height() != height+1 {
+		t.Fatalf("got height %d, want %d", c.Height(), height+1)
+	}
+
+	tx, err := c.QueryTransaction(res.TransactionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := c.QueryBlockByTxID(res.TransactionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if block.Header.Number != height {
+		t.Fatalf("got block %d, want %d", block.Header.Number, height)
+	}
+	p, err := proof.New(string(res.TransactionID), tx, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Header.Number != height {
+		t.Fatalf("proof of block %d, want %d", p.Header.Number, height)
+	}
+	if !bytes.Equal(block.Header.DataHash, protoutil.BlockDataHash(block.Data)) {
+		t.Fatal("block data hash does not match")
+	}
+
+	// queries are not committed
+	res, err = c.Query(channel.Request{ChaincodeID: "broker", Fcn: "getChainId"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.QueryTransaction(res.TransactionID); err == nil {
+		t.Fatal("query was committed")
+	}
+	if c.Height() != height+1 {
+		t.Fatalf("got height %d, want %d", c.Height(), height+1)
+	}
+}
+
+func TestFailedTransactionsLeaveNoState(t *testing.T) {
+	c := newChain(t)
+	if _, res := c.Invoke(UserMSP, Transfer, "setBalance", "alice", "100"); res.Status != shim.OK {
+		t.Fatal(res.Message)
+	}
+	height := c.Height()
+
+	if _, res := c.Invoke(UserMSP, Transfer, "transfer", "1356:appchain2:mychannel&transfer", "alice", "bob", "1000"); res.Status == shim.OK {
+		t.Fatal("transfer beyond the balance succeeded")
+	}
+	if got := string(c.State(Transfer, "alice")); got != "100" {
+		t.Fatalf("balance of alice: got %s, want 100", got)
+	}
+	if c.Height() != height {
+		t.Fatal("failed transaction was committed")
+	}
+}
+
+func TestEvents(t *testing.T) {
+	c := newChain(t)
+	if err := c.Deploy("emitter", emitter{}); err != nil {
+		t.Fatal(err)
+	}
+	reg, events, err := c.RegisterChaincodeEvent("emitter", "^interchain")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, args := range [][]string{
+		{"other", "ignored"},
+		{"interchain_event", "failed", "fail"},
+		{"interchain_event", "payload"},
+	} {
+		c.Invoke(UserMSP, "emitter", "emit", args...)
+	}
+
+	select {
+	case ev := <-events:
+		if ev.EventName != "interchain_event" || string(ev.Payload) != "payload" || ev.BlockNumber != c.Height()-1 {
+			t.Fatalf("unexpected event %+v", ev)
+		}
+		if _, err := c.QueryTransaction(fab.TransactionID(ev.TxID)); err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no event delivered")
+	}
+	select {
+	case ev := <-events:
+		t.Fatalf("unexpected event %+v", ev)
+	default:
+	}
+
+	c.Unregister(reg)
+	if _, ok := <-events; ok {
+		t.Fatal("events not closed on unregister")
+	}
+}
+
+func TestEndorsementsSatisfyPolicy(t *testing.T) {
+	c := newChain(t)
+	v, err := c.Validator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pe, err := validatorlib.NewPolicyEvaluator(v.ConfByte)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := c.Query(channel.Request{ChaincodeID: "broker", Fcn: "getChainId"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Responses) != len(endorsingOrgs) {
+		t.Fatalf("got %d endorsements, want %d", len(res.Responses), len(endorsingOrgs))
+	}
+	var signatures []*protoutil.SignedData
+	for _, r := range res.Responses {
+		signatures = append(signatures, &protoutil.SignedData{
+			Data:      append(append([]byte{}, r.Payload...), r.Endorsement.Endorser...),
+			Identity:  r.Endorsement.Endorser,
+			Signature: r.Endorsement.Signature,
+		})
+	}
+	if err := pe.Evaluate([]byte(v.Policy), signatures); err != nil {
+		t.Fatal(err)
+	}
+	if err := pe.Evaluate([]byte(v.Policy), signatures[:1]); err == nil {
+		t.Fatal("a single endorsement satisfies the policy")
+	}
+
+	signatures[0].Data = append(signatures[0].Data, 0)
+	if err := pe.Evaluate([]byte(v.Policy), signatures); err == nil {
+		t.Fatal("a tampered endorsement satisfies the policy")
+	}
+}
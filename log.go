@@ -0,0 +1,58 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/meshplus/bitxhub-model/pb"
+)
+
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+
+	// logTypeReceipt is the type logged for receipts polled from the broker
+	// before it is known whether they report success or failure
+	logTypeReceipt = "RECEIPT"
+)
+
+// NewLogger creates the logger of the plugin writing to output as configured.
+func NewLogger(config LogConfig, output io.Writer) (hclog.Logger, error) {
+	level := hclog.LevelFromString(config.Level)
+	if level == hclog.NoLevel {
+		return nil, fmt.Errorf("unknown log level %q", config.Level)
+	}
+
+	var json bool
+	switch config.Format {
+	case LogFormatText:
+	case LogFormatJSON:
+		json = true
+	default:
+		return nil, fmt.Errorf("unknown log format %q", config.Format)
+	}
+
+	return hclog.New(&hclog.LoggerOptions{
+		Name:       "client",
+		Output:     output,
+		Level:      level,
+		JSONFormat: json,
+	}), nil
+}
+
+// ibtpLogger adds the fields identifying an IBTP to log, so that the lines
+// about one cross-chain transaction can be followed end to end. Lines about
+// fabric transactions add their id as tx_id.
+func ibtpLogger(log hclog.Logger, servicePair string, index uint64, typ string) hclog.Logger {
+	return log.With("service_pair", servicePair, "index", index, "type", typ)
+}
+
+// kindType is the type logged for messages of dead letter kind.
+func kindType(kind string) string {
+	if kind == DeadLetterInterchain {
+		return pb.IBTP_INTERCHAIN.String()
+	}
+
+	return logTypeReceipt
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/meshplus/bitxhub-model/pb"
+)
+
+func TestNewLogger(t *testing.T) {
+	for _, config := range []LogConfig{
+		{Level: "loud", Format: LogFormatText},
+		{Level: "info", Format: "xml"},
+	} {
+		if _, err := NewLogger(config, &bytes.Buffer{}); err == nil {
+			t.Fatalf("logger created for %+v", config)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	log, err := NewLogger(LogConfig{Level: "warn", Format: LogFormatJSON}, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log.Info("dropped")
+	log.Warn("kept", "key", "value")
+
+	lines := logLines(t, buf)
+	if len(lines) != 1 || lines[0]["@message"] != "kept" || lines[0]["key"] != "value" {
+		t.Fatalf("unexpected log lines %v", lines)
+	}
+}
+
+// TestIBTPLogCorrelation checks that the lines about submitting an IBTP can
+// be found by its service pair and index and name the fabric transactions.
+func TestIBTPLogCorrelation(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "bob", "0")
+
+	buf := &bytes.Buffer{}
+	log, err := NewLogger(LogConfig{Level: "debug", Format: LogFormatJSON}, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.logger = log
+
+	ret, err := c.SubmitIBTP(testRemote, 1, testTransferCID, pb.IBTP_INTERCHAIN,
+		transferContent("carol", "bob", 10), &pb.BxhProof{TxStatus: pb.TransactionStatus_BEGIN}, false)
+	if err != nil || !ret.Status {
+		t.Fatalf("submit ibtp: %v %+v", err, ret)
+	}
+
+	var txIDs []string
+	for _, line := range logLines(t, buf) {
+		if line["service_pair"] != genServicePair(testRemote, testLocal) || line["index"] != float64(1) {
+			continue
+		}
+		if line["type"] != pb.IBTP_INTERCHAIN.String() {
+			t.Fatalf("unexpected type in %v", line)
+		}
+		if txID, ok := line["tx_id"].(string); ok && txID != "" {
+			txIDs = append(txIDs, txID)
+		}
+	}
+	if len(txIDs) == 0 {
+		t.Fatalf("no line about the ibtp names a fabric transaction:\n%s", buf)
+	}
+}
+
+func logLines(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var lines []map[string]interface{}
+	scanner := bufio.NewScanner(bytes.NewReader(buf.Bytes()))
+	for scanner.Scan() {
+		line := make(map[string]interface{})
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("log line %q is not json: %v", scanner.Text(), err)
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -12,12 +13,10 @@ import (
 	"github.com/fatih/color"
 	"github.com/gobuffalo/packd"
 	"github.com/gobuffalo/packr/v2"
-	"github.com/hashicorp/go-plugin"
-	"github.com/hyperledger/fabric-sdk-go/pkg/client/ledger"
-	"github.com/hyperledger/fabric-sdk-go/pkg/core/config"
-	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
 	"github.com/hyperledger/fabric-sdk-go/third_party/github.com/hyperledger/fabric/common/cauthdsl"
-	"github.com/meshplus/pier/pkg/plugins"
+	"github.com/meshplus/bitxhub-model/pb"
+	"github.com/meshplus/pier-client-fabric/verifier"
 	"github.com/urfave/cli"
 )
 
@@ -76,20 +75,14 @@ var getValidator = cli.Command{
 			ChannelID: fabricConfig.ChannelId,
 			ORG:       fabricConfig.Org,
 		}
-		configProvider := config.FromFile(filepath.Join(configPath, "config.yaml"))
-		sdk, err := fabsdk.New(configProvider)
-		if err != nil {
-			return fmt.Errorf("create sdk fail: %s\n", err)
-		}
-
-		channelProvider := sdk.ChannelContext(contractmeta.ChannelID, fabsdk.WithUser(contractmeta.Username), fabsdk.WithOrg(contractmeta.ORG))
-
-		l, err := ledger.New(channelProvider)
+		csm, err := NewConsumer(context.Background(), configPath, contractmeta, nil)
 		if err != nil {
 			return err
 		}
+		defer csm.Shutdown(context.Background())
+
 		// Get Fabric Channel Config
-		conf, err := l.QueryConfig()
+		conf, err := csm.Ledger.QueryConfig()
 		if err != nil {
 			return err
 		}
@@ -133,18 +126,214 @@ var getValidator = cli.Command{
 	},
 }
 
+var verifyProofCMD = cli.Command{
+	Name:  "verify-proof",
+	Usage: "Verify the proof of an IBTP against fabric validators like the validating rule does",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:     "ibtp",
+			Usage:    "Specify the IBTP json file",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:     "validators",
+			Usage:    "Specify the validators file generated by the validator command",
+			Required: true,
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		ibtpBytes, err := ioutil.ReadFile(ctx.String("ibtp"))
+		if err != nil {
+			return fmt.Errorf("read ibtp: %w", err)
+		}
+		ibtp := &pb.IBTP{}
+		if err := json.Unmarshal(ibtpBytes, ibtp); err != nil {
+			return fmt.Errorf("unmarshal ibtp: %w", err)
+		}
+		validators, err := ioutil.ReadFile(ctx.String("validators"))
+		if err != nil {
+			return fmt.Errorf("read validators: %w", err)
+		}
+
+		if err := verifier.Verify(ibtp, validators); err != nil {
+			return fmt.Errorf("verify proof of ibtp %s fail: %w", ibtp.ID(), err)
+		}
+
+		color.Green("verify proof of ibtp %s pass", ibtp.ID())
+		return nil
+	},
+}
+
+var configFlag = cli.StringFlag{
+	Name:     "config",
+	Usage:    "Specify config addr",
+	Required: true,
+}
+
+var idFlag = cli.StringFlag{
+	Name:     "id",
+	Usage:    "Specify the dead letter id",
+	Required: true,
+}
+
+var deadLetterCMD = cli.Command{
+	Name:  "dead-letter",
+	Usage: "Inspect and redrive broker messages that could not be turned into IBTPs",
+	Subcommands: []cli.Command{
+		{
+			Name:  "list",
+			Usage: "List dead letters",
+			Flags: []cli.Flag{configFlag},
+			Action: func(ctx *cli.Context) error {
+				store, err := openDeadLetterStore(ctx)
+				if err != nil {
+					return err
+				}
+				letters, err := store.List()
+				if err != nil {
+					return err
+				}
+
+				for _, letter := range letters {
+					fmt.Printf("%s\t%s\t%s\tredrive=%t\t%s\n", letter.ID,
+						time.Unix(0, letter.Timestamp).Format(time.RFC3339), letter.Kind, letter.Redrive, letter.Error)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "show",
+			Usage: "Show a dead letter with its raw payload",
+			Flags: []cli.Flag{configFlag, idFlag},
+			Action: func(ctx *cli.Context) error {
+				store, err := openDeadLetterStore(ctx)
+				if err != nil {
+					return err
+				}
+				letter, err := store.Get(ctx.String("id"))
+				if err != nil {
+					return err
+				}
+				if letter == nil {
+					return fmt.Errorf("dead letter %s not found", ctx.String("id"))
+				}
+
+				data, err := json.MarshalIndent(letter, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(data))
+				return nil
+			},
+		},
+		{
+			Name:  "redrive",
+			Usage: "Let the running plugin convert a dead letter again on its next polling round",
+			Flags: []cli.Flag{configFlag, idFlag},
+			Action: func(ctx *cli.Context) error {
+				store, err := openDeadLetterStore(ctx)
+				if err != nil {
+					return err
+				}
+				if err := store.MarkRedrive(ctx.String("id")); err != nil {
+					return err
+				}
+
+				color.Green("dead letter %s marked for redrive", ctx.String("id"))
+				return nil
+			},
+		},
+		{
+			Name:  "remove",
+			Usage: "Drop a dead letter for good",
+			Flags: []cli.Flag{configFlag, idFlag},
+			Action: func(ctx *cli.Context) error {
+				store, err := openDeadLetterStore(ctx)
+				if err != nil {
+					return err
+				}
+				return store.Remove(ctx.String("id"))
+			},
+		},
+	},
+}
+
+func openDeadLetterStore(ctx *cli.Context) (*DeadLetterStore, error) {
+	return NewDeadLetterStore(filepath.Join(ctx.String("config"), DeadLetterDir))
+}
+
+var configCMD = cli.Command{
+	Name:  "config",
+	Usage: "Inspect the plugin configuration",
+	Subcommands: []cli.Command{
+		{
+			Name:  "check",
+			Usage: "Validate fabric.toml and config.yaml, and optionally try reaching fabric with them",
+			Flags: []cli.Flag{
+				configFlag,
+				cli.BoolFlag{
+					Name:  "connect",
+					Usage: "Query the channel config and the broker chaincode",
+				},
+			},
+			Action: func(ctx *cli.Context) error {
+				if !checkConfig(ctx.String("config"), ctx.Bool("connect")) {
+					return fmt.Errorf("config check failed")
+				}
+
+				color.Green("config check passed")
+				return nil
+			},
+		},
+	},
+}
+
+// checkConfig prints the outcome of each check of the config in configPath,
+// returning whether all passed.
+func checkConfig(configPath string, connect bool) bool {
+	report := func(check string, err error) bool {
+		if err != nil {
+			color.Red("%s: %s", check, err)
+			return false
+		}
+		fmt.Printf("%s: ok\n", check)
+		return true
+	}
+
+	config, err := UnmarshalConfig(configPath)
+	if !report(ConfigName, err) {
+		return false
+	}
+	if !report(SDKConfigName, CheckSDKConfig(configPath, config.Fabric.Org, config.Fabric.Username)) {
+		return false
+	}
+	if !connect {
+		return true
+	}
+
+	meta := &ContractMeta{
+		Username:  config.Fabric.Username,
+		CCID:      config.Fabric.CCID,
+		ChannelID: config.Fabric.ChannelId,
+		ORG:       config.Fabric.Org,
+	}
+	csm, err := NewConsumer(context.Background(), configPath, meta, nil)
+	if !report("sdk", err) {
+		return false
+	}
+	defer csm.Shutdown(context.Background())
+
+	_, err = csm.Ledger.QueryConfig()
+	ok := report("channel "+meta.ChannelID, err)
+	_, err = csm.Querier.Query(channel.Request{ChaincodeID: meta.CCID, Fcn: GetChainId})
+	return report("chaincode "+meta.CCID, err) && ok
+}
+
 var startCMD = cli.Command{
 	Name:  "start",
 	Usage: "Start fabric appchain plugin",
 	Action: func(ctx *cli.Context) error {
-		plugin.Serve(&plugin.ServeConfig{
-			HandshakeConfig: plugins.Handshake,
-			Plugins: map[string]plugin.Plugin{
-				plugins.PluginName: &plugins.AppchainGRPCPlugin{Impl: &Client{}},
-			},
-			Logger:     logger,
-			GRPCServer: plugin.DefaultGRPCServer,
-		})
+		servePlugin(&Client{})
 
 		logger.Info("Plugin server down")
 
@@ -161,6 +350,9 @@ func main() {
 	app.Commands = []cli.Command{
 		initCMD,
 		startCMD,
+		verifyProofCMD,
+		deadLetterCMD,
+		configCMD,
 	}
 
 	err := app.Run(os.Args)
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/meshplus/bitxhub-model/pb"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	metricsNamespace = "pier_fabric"
+
+	// MetricsPath is where the metrics server serves the metrics
+	MetricsPath = "/metrics"
+
+	// operations of the submit and retry metrics
+	OpSubmitIBTP        = "submit_ibtp"
+	OpSubmitIBTPBatch   = "submit_ibtp_batch"
+	OpSubmitReceipt     = "submit_receipt"
+	OpInvokeInterchain  = "invoke_interchain"
+	OpInvokeInterchains = "invoke_interchains"
+	OpInvokeReceipt     = "invoke_receipt"
+	OpGetProof          = "get_proof"
+
+	// outcomes of submits: accepted by the broker, rejected by it with a
+	// failed response or not submitted because of an error
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+	OutcomeError   = "error"
+)
+
+// Metrics are the prometheus collectors of a client. Each client has its own
+// registry so that clients in one process don't collide.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	ibtpsEmitted    *prometheus.CounterVec
+	submitDuration  *prometheus.HistogramVec
+	retries         *prometheus.CounterVec
+	proofDuration   prometheus.Histogram
+	pollingLag      *prometheus.GaugeVec
+	deadLetters     *prometheus.GaugeVec
+	eventReconnects prometheus.Counter
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		ibtpsEmitted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "ibtps_emitted_total",
+			Help:      "IBTPs handed to pier per service pair and type.",
+		}, []string{"service_pair", "type"}),
+		submitDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "submit_duration_seconds",
+			Help:      "Latency of IBTPs and receipts submitted by pier per operation and outcome.",
+			Buckets:   prometheus.ExponentialBuckets(0.05, 2, 10),
+		}, []string{"operation", "outcome"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "retries_total",
+			Help:      "Retried fabric requests per operation.",
+		}, []string{"operation"}),
+		proofDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "proof_fetch_duration_seconds",
+			Help:      "Latency of fetching the proof of a transaction, retries included.",
+			Buckets:   prometheus.ExponentialBuckets(0.01, 2, 10),
+		}),
+		pollingLag: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "polling_lag",
+			Help:      "Broker counter minus the index delivered to pier per service pair and kind.",
+		}, []string{"service_pair", "kind"}),
+		deadLetters: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "dead_letters",
+			Help:      "Parked dead letters per kind, each blocking its service pair until redriven.",
+		}, []string{"kind"}),
+		eventReconnects: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "event_reconnects_total",
+			Help:      "Chaincode event registrations after the first one.",
+		}),
+	}
+	m.registry.MustRegister(
+		m.ibtpsEmitted,
+		m.submitDuration,
+		m.retries,
+		m.proofDuration,
+		m.pollingLag,
+		m.deadLetters,
+		m.eventReconnects,
+	)
+
+	return m
+}
+
+// Handler serves the metrics in the prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *Metrics) ibtpEmitted(ibtp *pb.IBTP) {
+	m.ibtpsEmitted.WithLabelValues(genServicePair(ibtp.From, ibtp.To), ibtp.Type.String()).Inc()
+}
+
+// submitted observes a submit started at start which returned ret and err.
+func (m *Metrics) submitted(op string, start time.Time, ret *pb.SubmitIBTPResponse, err error) {
+	outcome := OutcomeSuccess
+	switch {
+	case err != nil:
+		outcome = OutcomeError
+	case ret == nil || !ret.Status:
+		outcome = OutcomeFailure
+	}
+	m.submitDuration.WithLabelValues(op, outcome).Observe(time.Since(start).Seconds())
+}
+
+// attempted counts attempt of op as a retry unless it is the first one.
+func (m *Metrics) attempted(op string, attempt uint) {
+	if attempt > 0 {
+		m.retries.WithLabelValues(op).Inc()
+	}
+}
+
+func (m *Metrics) proofFetched(start time.Time) {
+	m.proofDuration.Observe(time.Since(start).Seconds())
+}
+
+// setPollingLag records how far the index delivered to pier is behind the
+// broker counter of servicePair.
+func (m *Metrics) setPollingLag(kind, servicePair string, counter, delivered uint64) {
+	var lag float64
+	if counter > delivered {
+		lag = float64(counter - delivered)
+	}
+	m.pollingLag.WithLabelValues(servicePair, kind).Set(lag)
+}
+
+// setDeadLetters records the dead letters parked of each kind.
+func (m *Metrics) setDeadLetters(letters []*DeadLetter) {
+	counts := map[string]float64{DeadLetterInterchain: 0, DeadLetterReceipt: 0, DeadLetterEvent: 0}
+	for _, letter := range letters {
+		counts[letter.Kind]++
+	}
+	for kind, n := range counts {
+		m.deadLetters.WithLabelValues(kind).Set(n)
+	}
+}
+
+func (m *Metrics) eventReconnected() {
+	m.eventReconnects.Inc()
+}
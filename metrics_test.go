@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/meshplus/bitxhub-model/pb"
+)
+
+func TestMetrics(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+	c.ticker = time.NewTicker(10 * time.Millisecond)
+	c.config.Metrics.Port = freePort(t)
+
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", "100")
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "bob", "0")
+	f.mustInvoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "10")
+
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-c.GetIBTPCh():
+	case <-time.After(5 * time.Second):
+		t.Fatal("no ibtp polled")
+	}
+
+	begin := &pb.BxhProof{TxStatus: pb.TransactionStatus_BEGIN}
+	if _, err := c.SubmitIBTP(testRemote, 1, testTransferCID, pb.IBTP_INTERCHAIN, transferContent("carol", "bob", 10), begin, false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.SubmitIBTP(testRemote, 2, testTransferCID, pb.IBTP_INTERCHAIN, &pb.Content{Func: "interchainCharge", Args: [][]byte{[]byte("bad header")}}, begin, false); err != nil {
+		t.Fatal(err)
+	}
+
+	pair := genServicePair(testLocal, testRemote)
+	want := []string{
+		fmt.Sprintf(`pier_fabric_ibtps_emitted_total{service_pair=%q,type="INTERCHAIN"} 1`, pair),
+		fmt.Sprintf(`pier_fabric_polling_lag{kind="interchain",service_pair=%q} 0`, pair),
+		`pier_fabric_dead_letters{kind="interchain"} 0`,
+		`pier_fabric_submit_duration_seconds_count{operation="submit_ibtp",outcome="success"} 1`,
+		`pier_fabric_submit_duration_seconds_count{operation="submit_ibtp",outcome="failure"} 1`,
+		`pier_fabric_proof_fetch_duration_seconds_count `,
+	}
+	// the counters of the polled ibtp are updated after pier received it
+	var body string
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		body = scrapeMetrics(t, c.config.Metrics.Port)
+		if containsAll(body, want) || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	for _, line := range want {
+		if !strings.Contains(body, line) {
+			t.Errorf("missing %s in metrics:\n%s", line, body)
+		}
+	}
+}
+
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func scrapeMetrics(t *testing.T, port int) string {
+	resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d%s", port, MetricsPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(body)
+}
+
+func containsAll(s string, subs []string) bool {
+	for _, sub := range subs {
+		if !strings.Contains(s, sub) {
+			return false
+		}
+	}
+
+	return true
+}
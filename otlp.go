@@ -0,0 +1,202 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// OTLPTracesPath is where OTLP/HTTP collectors receive spans.
+const OTLPTracesPath = "/v1/traces"
+
+// otlpExporter exports spans to an OTLP/HTTP collector in the JSON encoding
+// of OTLP, which unlike the protobuf one needs no grpc newer than fabric's.
+type otlpExporter struct {
+	url    string
+	client *http.Client
+}
+
+func newOTLPExporter(endpoint string) (*otlpExporter, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid otlp endpoint %q", endpoint)
+	}
+
+	return &otlpExporter{
+		url:    strings.TrimSuffix(endpoint, "/") + OTLPTracesPath,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (e *otlpExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	body, err := json.Marshal(otlpRequest(spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("export spans: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("export spans: collector answered %s", resp.Status)
+	}
+
+	return nil
+}
+
+func (e *otlpExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+// OTLPTraces is an OTLP ExportTraceServiceRequest in its JSON encoding.
+type OTLPTraces struct {
+	ResourceSpans []OTLPResourceSpans `json:"resourceSpans"`
+}
+
+type OTLPResourceSpans struct {
+	Resource   OTLPResource     `json:"resource"`
+	ScopeSpans []OTLPScopeSpans `json:"scopeSpans"`
+}
+
+type OTLPResource struct {
+	Attributes []OTLPKeyValue `json:"attributes,omitempty"`
+}
+
+type OTLPScopeSpans struct {
+	Scope OTLPScope  `json:"scope"`
+	Spans []OTLPSpan `json:"spans"`
+}
+
+type OTLPScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type OTLPSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []OTLPKeyValue `json:"attributes,omitempty"`
+	Status            OTLPStatus     `json:"status"`
+}
+
+type OTLPKeyValue struct {
+	Key   string       `json:"key"`
+	Value OTLPAnyValue `json:"value"`
+}
+
+// OTLPAnyValue holds one of its values, 64 bit integers being strings in
+// the JSON encoding.
+type OTLPAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type OTLPStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// status codes of OTLP, which differ from the ones of codes
+const (
+	otlpStatusOK    = 1
+	otlpStatusError = 2
+)
+
+func otlpRequest(spans []sdktrace.ReadOnlySpan) *OTLPTraces {
+	req := &OTLPTraces{}
+	if len(spans) == 0 {
+		return req
+	}
+
+	// spans of a tracer provider share its resource
+	rs := OTLPResourceSpans{Resource: OTLPResource{Attributes: otlpAttributes(spans[0].Resource().Attributes())}}
+	scopes := make(map[string]int)
+	for _, span := range spans {
+		scope := span.InstrumentationScope()
+		i, ok := scopes[scope.Name]
+		if !ok {
+			i = len(rs.ScopeSpans)
+			scopes[scope.Name] = i
+			rs.ScopeSpans = append(rs.ScopeSpans, OTLPScopeSpans{Scope: OTLPScope{Name: scope.Name, Version: scope.Version}})
+		}
+		rs.ScopeSpans[i].Spans = append(rs.ScopeSpans[i].Spans, otlpSpan(span))
+	}
+	req.ResourceSpans = append(req.ResourceSpans, rs)
+
+	return req
+}
+
+func otlpSpan(span sdktrace.ReadOnlySpan) OTLPSpan {
+	s := OTLPSpan{
+		TraceID: span.SpanContext().TraceID().String(),
+		SpanID:  span.SpanContext().SpanID().String(),
+		Name:    span.Name(),
+		// span kinds of OTLP number the kinds of trace alike
+		Kind:              int(span.SpanKind()),
+		StartTimeUnixNano: strconv.FormatInt(span.StartTime().UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.EndTime().UnixNano(), 10),
+		Attributes:        otlpAttributes(span.Attributes()),
+	}
+	if parent := span.Parent(); parent.IsValid() {
+		s.ParentSpanID = parent.SpanID().String()
+	}
+	switch span.Status().Code {
+	case codes.Ok:
+		s.Status.Code = otlpStatusOK
+	case codes.Error:
+		s.Status = OTLPStatus{Code: otlpStatusError, Message: span.Status().Description}
+	}
+
+	return s
+}
+
+func otlpAttributes(attrs []attribute.KeyValue) []OTLPKeyValue {
+	kvs := make([]OTLPKeyValue, 0, len(attrs))
+	for _, attr := range attrs {
+		var v OTLPAnyValue
+		switch attr.Value.Type() {
+		case attribute.BOOL:
+			b := attr.Value.AsBool()
+			v.BoolValue = &b
+		case attribute.INT64:
+			i := strconv.FormatInt(attr.Value.AsInt64(), 10)
+			v.IntValue = &i
+		case attribute.FLOAT64:
+			f := attr.Value.AsFloat64()
+			v.DoubleValue = &f
+		default:
+			s := attr.Value.Emit()
+			v.StringValue = &s
+		}
+		kvs = append(kvs, OTLPKeyValue{Key: string(attr.Key), Value: v})
+	}
+
+	return kvs
+}
@@ -11,147 +11,147 @@ import (
 )
 
 var _ = func() error {
-	const gk = "31ba334ab5189cf775dcaf34aff80ca1"
+	const gk = "313f1acd9e86bfc7d9826f5932916e6d"
 	g := packr.New(gk, "")
 	hgr, err := resolver.NewHexGzip(map[string]string{
-		"00df918ba569d55a776c7323292f2085": "1f8b08000000000000ff64cfc15283301485e13d4fd11770040d22cb9b784d339834a4d8b1ec00355015db8a04f2f48e8c3bcfeaccbffb2e7e47910bb5d246eca0c05586fba50652f03558044925a7f3896f2549c12267ecef3b5c531ebaca090a796e773adeb2ba7cfbeeea7d53c59f81873c7abf8eda6a3aa56126e61122cf7a7f8691b5061403300349e4548457d3b1cfb950b6295b8f6eee437c3a3481ba79adeb820dcf69d6be948fd9a6999d19f16348c8c176714208d958ed2fbfbae3ed0339334da67ba3310d1600aabbffa89f000000ffff37231178f1000000",
-		"0141069186605bc0efaf6df92a8a5da2": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"065df540e4ea07c285ec5aab92855351": "1f8b08000000000000ff6c93cf92b23814c5f73cc5ecbba61ab5b13f16b3c8cd1f881a3088d0b013c4208a62ab0478fa29e9a959cc7c59a5cefde5569273cf9faf05d4e1de1f980621671ca3908eaa2138c75b82313a140a690e487189274d8aaacd61776ccde559c129cad93b414b50ea569e2a7f2d2541150c42deb581654222291daa17d176a0a100eaa0c99662104b3965f75d9cb6796d354948630172ac4127bced943d394d7b2389ad6af7e555d9405301f9cfe152c86cb6b888a3752e1cf6c89deebcaabd360b692ee03a32a8136132a54fe3f7202a98367b41502fc856fb84f782c08e6973f8d1f8bf9a215cd1e1012d407911a02444e7281481d0f4e7699cea862471d7a4b5dd67f5b91441a2191a6b2ed593d048e20f155c1665567b4d3ef55a119c34d323b0a41db4799df7ab3afa48e289ce9ced3399da0f1168eda8912106edbc2ad958bf07d949539db8cb6bca87cac44826fc9f3d41322752216accd6c1a75db5ddeaf0553c6ed79509e18c0c625f3bb26eaff362b50a97bf0af3064d4f6e753f6d657c798392b99f1f61c4f785512c3efcd6d9ccd9e7533a31b9d83a826ee73d8473d7e475cdc05c23e9be03920429a71660be5cd82fa41c6d3530be3b486e19688141a96f509481cc090ad1faf5b16e20001d7e510811793512f83436204a1e0113666cde2ff1f1690d70b35b4d3133cdaa4bddaffd84571369b1ef9417d9d59a36c17f2751792e22400d74046517598dfbb83ae8f906d1f9b278e38f667e7d0bdb9afb7abff96e9277623f7da5b982c4cccbfd79161dcada3f0eb131e8b75970acecde43d75dd0d2895faead27fdf627ea2f638c0bf5c8ff23f477000000ffffed6d643e5f030000",
-		"071b0084b56f9e4420324ef130bc92d1": "1f8b08000000000000ff6c934d73b3380c80effc8abd67769ad292b647198c718a7903980f730bd09810c8574b4cfceb7708a79db73e691e3dd68c46d2bfd34398d0e01f1b479cbad4068e1fd46094daa9b66dd8ad24288a40d208fc2f6a1e5feab7fd7b206079b006110f75a56c29e8e7a9a0ba5d625054d52df60d060702cf09460db3d3948d58438464902290dc3e044d49babe7c590fdb0c8f6e0bc99cabb893064db947c4a87a771066a785f93e120ef92c9c38261ff742d3a1c89ba6ccd177115b6d692e474f433d3b8cbbc7e8dba8cca0f1fb5f65e53555c05a50ac8567e6c8fb146713d30f769f98f1802d3259f8adec5038691812acd669a23167083fdab311fb0c4df77b9b15b7aab7ce82e38ca1f09133d0c882c474078a8bbbc8ac769b076da971c150357f6e5858beac8f6c6f755fc4fda9c8d8f97d702b39de31b424c624c1c8b29a8c5a9878f85d4605438ca0fb85c4ecf5032426b63dc70628ecc1920282cba9bf98e6f3e8f0588c1fc3225f8551cd6fb8f413bedc26deeec5faa1f83ccaa6ac446787eb36f0de1ac3b4fe44d7be382eccfaa9be36fa2a2bd97b815a0c718bf6595b727465f03ab5533b0aa327154e3b80ceed3c0dc38b133ccf575e91c42e0a2b07a6855102a130f14061e528e1a4d19243e83d2148144c85349ce702e12ba6329469b761e951036d65e61f164d79f15efbcb1e5011adc52abfe4474db6e37e73b2e4ff36d200459d48818a804a727b769a7848de3741753a7b92d1ac14ab988d9b3fe9f96db434f157c7b7c8fbb4de6d8a7e365f673f3130da2d76225ded9fd6f9767beaf8adf66f5dfc7338f168150d03d1e9ca789c0d0e9cbf4fe9bf000000ffff25e62de567030000",
-		"085dba7e2c9f213147f352f1de862e99": "1f8b08000000000000ff6c93cf77ba3814c5f7fc15b3ef9953a4a5adcb975f081a3418a07127688320164b25c05f3f473a6716f36d5639f77df24e92fbeedff785a8e7877f611a499ff918249d548bfb3e8e25c6f071d4607c04da1751b9c65a25d16919ae60c977af7af17292b0445a5f8baa5c6f842050a2918bd65858289208e1511324f1482547d483594c31e24be1b0769feebabc761b2569ca91986aa8e761ecb09b4f7783a552b7dcbf876536d21d47f9cfe1828bec29b8f0ad7b3e7aec3bf7faf3aa0ebb4cd29ca3cf89819e4be5d09bf53b084766ec8113183889cd9af8032768cf8c3dfe68fe7f9ac517bcc723044887090225e19c481e71437f9ee653d31095f6cdae9e0f597d2e78a40c83a9b6a066262d953eebe81214591d36b913763caa0c3313b0a43deaf23aef5775f2acd299c9bcf8a69cf9378f8cf1f4c4108bf661a9b6eeef20ab0c356ab1fcdcf963696310caff774f40e44468a016a8eaedf5b321dd139b5d08dbf863670ef2588630b4eead6de7d7f609cf51528fdbee80578dd9767de0329d202ee241bad6312df490af6787a04a57439ec3fc58a7cbf09b7bad21f76b46f606c4e2118120a0bd9a23fbeec2211062b2d5c2b8f540c40c198e91d65f485386444e40c2e6feb18b8823f878a34802b937e2b89a1a102d4e08636239d1aabf7c1c5a87091b43b47928ddb76b5ddcca43637f258fc11efae7f54b248af27f93a8431f0862169c287c6d36ce2278f86802f711bd540d3cd963ab5ab6efaf57ec0ede7656e461bc355ea5b10fdd9a75af387e975f07fb7ab1d8373f9ef039cb59c69cb6f2dedf6bf3f0b82a3ab99d5b535c6848fe8cd03f010000ffff17359eef5f030000",
-		"08f8c16cb1184ecc2a086b2887cf60d3": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"0b1035fa92a2096684a96cba2b148d4c": "1f8b08000000000000ffbccf31aac3300c06e0dda7d0059e1f6fcdf6081dba345d7200d5fe1b04b61c14154a4f5f9c2e3d4146a15ffaf82f2d639ab721109d946f0503b93d1088c622509fe67386badc05d6434423ac8f891d03254e30df7e13c766cb5fc493eb5a1053ab3f7d1357d4fd6ab285555eecd294cbace25f7f29ed5620ba02760cb9021688fe73153d46e44e859ecab0a36ab60f16de010000ffff93db101ce8010000",
-		"0b80819b3df6c34ba6e8e36725b879c2": "1f8b08000000000000ff64cf414f833018c6f13b9f627762c4801b1cdbfae6a534251436156f5d15a6c008b3ac639fdeb878f3393df9df7e77bfa3803c5f15257f265b5809a86fd5931c53d2029154225d26ac6494901690b1bfef20a51838ed38254ab5eef2198bafe38ea0afc7b632def7bc6c50d8f82c55b68e157ce8665e60284e233b9424678428316593b5d6a8201864b33ff77e07afbbcd059707483cec6998f8fb8a0a53f3971033d5b1fa5afad1fbbcedf4e3f0766f8f4da823b38e529e1daea63875a3ab7bef0680fce93fea270000ffffbb2f2895f1000000",
-		"0cae28d103a9a7af6e5ce107effe46a3": "1f8b08000000000000ff6c93cd72ab381085f73cc5ec5353c1c4beb11777d11202cb581811fec4cee0048321fe8d1bf4f453c1b76673a395eaf4398bafbbcebfdf8f3097fbff501646dce1142236aa86e09ce69a52a8ac0a9013a8b8cc26afa7d964ab635a2fc220b95cebc2d51a3c5255e7fda1d90452dad0104bc82b1a542a3b91d265b84a62cd2241980b939851223c6939d76d9adfcb6e7652114b0591e38cf4c28f2de78bb37c30543a6bb699df149ae582948ff05ec8e265f529ea59fbee3ab7d2eddb75e7df8b887d08628e1ee845ba737b6d288b7dfd6c867707cd41d830083bc68dcd076193ad83a67e687cd48c6f5138d79e6a5891ca4f08a808da2412a140f6c0e30c4fb64afb53de2d86a26bf72254e8c0385b1a0c27914aa755f8b9da179d7f2a2dff2ec2392e1f619bf539e66932083abb975d39acbb64aad209166efca5acc5cd10ce0119aaa577ccb96e4c0a52f13f7f1b6469cb0ad8249b8b9b2ebbe4054e2f938858edaecc996849512c8d8ffaa0bced939fddb2a7099a41dda6a94dad6cf92c274d65bdab784d3cb917896b8685e3ac12b308bbe3f371ea6d48ab29315a1b36dfec4b3927f031670404255b782084664bc8c8cb3d5484c87809c85c4aaf2ec8d82128a82100bfcfb263c8c8334a2a00d0c331dc102a911fa14ada40249f1a7853a5ebc3d3be382fa7ddb90692872b43fdcaced9a776b77d1d1c67157a30864342192ea73c2ddda42d1bf8f9d48a3bc61f03d2eaffe531406ebf016028f91e847f457777a8d78b2f731664e85d0202d34b70d685f37622c62fe7cd8ff0b5c98e870bd4243924f176faba18dc7d726d877978d1bd0e7741afe176dfc042c7365158c4caac7eff36c63a31dffebb62ff050000ffff417f75ba7f030000",
-		"0e0743037d2b60aa902c9d64d33b7a8f": "1f8b08000000000000ff64cf414fc23018c6f1fb3e057763d6653ae0f8b67b79bbcd0ed602d98e8ad06917042236dda737126f3ca727ffdbeff16f1ca9a8272b5d6c618d930abb5b8d5441122c82e28a783893514f73b04842fc7f8f9213f3afbee0d03436abc6fd8ca5992d531f1c96111b56edce1cb53ce38b10c17d9fe206d11e7a667a0db500307cf4dd6e69da131fbdc8874e5d4a7867c976ba6ee45b444e1c17cbfec7507a71830c49dca6ee9ae4c327cca7cc7fb0e7c5c60685b3872bd75f6ecc2abd89c5fe10dd0058e7f7a8df000000ffff80f0f724f1000000",
-		"0e71edbfc97a442c85ba83370287a206": "1f8b08000000000000ff64934d93a2301086effc8abd6f6d097e8c7ad843e7938c0687883878437440047506a4915fbfa54ecd65734a3dfd56f59374f2e7be0897cafb45b90994501402fea096568a2a462924710aa808a4ca80dabd945f130e7bf4c5e9385d94833732b691a6919a9d37aacb6d0ea8300ef8dcd27094e0ac38c9340d43ddf20e0c49bd90401ad0a3976d65516e07afd778cd5b91c3ea594b02167ad9f640a49594e21af58b2eea4f5ac160f90c9c03da0f87d1dac1ad5c5da3feb4d62641e1472cf47dc6719447cb51b197a2b612d916f3d26bb601ec05da37cde0a6d90a174cdd3423b140bb7b32f5c3b4ac5adac1ebbd9945200aa008036d34f26707c5f1c2a2757bd994d3dbb62c326d2214f0a8b91c9d205a0f53737acdb6a577b192bed76803c8d24760ce71d724a5299232bc6963a3c46fedd6fc708f3ef5eff6d6539f6c34d192dc3ee5520fa7907249e9f71eb90bb602e26195c94168de5caa6e9fcbe5353c50b944ab3e1d2f359fe7fbf8d83bcdc6d8e5ae0f2fbbcfa670cff1a09c5688a28d173cf5fda6b3d7ef693a11fda4b639191d4cb5ccad2020730dc3fb28770c39e9a17f9fb19b32d0f74b728d26f031e19003685a3d720afda3a633508b4a5bf97e9c379be4dd1e9d4c76e21367ec1d7ed33dc522f34e621306ed50f68a76a2e1fc732c029a829d82e62b6a299f2dba2f6c2f6d944d3b33ab7b1f4a3967d9554933ad67a9f00e3b5d0f623918e23baa345eacf4d8e93b57d1f80767ed5abdbde3c8af99aa923113626efb4dd82e7670a9c2e4aff578eddc63ffff807f010000ffff0f58b6131e030000",
-		"10c19ad42cecb1a6935e778c276936fb": "1f8b08000000000000ff64cf416f823018c6f13b9fc2bb599893a91cdfc29b5ab59dc0622c37235aab4ca06316fae99791dd7c4e4ffeb7dfcbdf08522646db94ede013476b9443f538a34b50089c704afa86663c0841218da2ff6f7149e8ab3d58462049d498fdd4ec8c9debde54ba0eb437d93c6eefa204ddf8dfd363bc0d72ed7410d20df9b8a4202280b420526673e11ff52451f32833bca8dbbd991adbdbc65becadf173bcdb2a39f36e55cc58df96bbc3455a77bd9ef29285b7f1ea51c6d55d8899095db568953cd55fde0040113fa37e030000ffffd4b34516f1000000",
-		"11b25447579de2d8cd07ed9397c3783b": "1f8b08000000000000ff6c93c992e2381884ef7e8ab9574cb46d96a60e7df8b52253bf5d022f23dfc0500283dbb85864fcf41345f56d4627c5979907a522fffe3a844b15ff45f93255425148f9937aa8144d6a4a61272d3845c02a9dc831c4e21ca4e3e39c053b8bb35a57292c88b5ddfe5827ef5a33a84988fae23caa0dcbb596dc457936f014099710649c125ce8505cd64579af9ac9d9a4bc40a29f1ae931ce427153bc7c78a698d4eb7fe27a33f01249f51ddea3de8ca2df78989c76525c2bd99fde9af8be49f90712ffe9811e8badec07cf84fcf6ff66d809e73f90c10359e612a61ec8c85a387ff866eac9bc2f88e2d2d3012262e39c8049e194a7b844c7bf9fa7b83b3353f4e7b2797d6c9ad31e97c609786a738fbb2035c5d82e7f47fb4d139fab30bee372e6e6df61c648494a199c374772af9aeaf1d6e46353046e23b39b095faf1e8aa3e3cecc176da986daa7a08dfa7367a02ba62d70b5df4551fc0348ddcb9f68a3d0eff3f2a0e7196557af999ab0aea44bae9fc7d14b176dc2b4fbf4dd703fe16d9eaff211578cd84e1551fd73f9a8b6a44d5cc02669bc882f285be7316b58bef4df41cf7f10d00c6c61ff34bd8db47e7e1da517093a13c42121d67e12cb05d115831ad0fb2a6ebe44021f330e35007e99836cab9c3e225d8012653a8872e6a81a8bc3e4bd2da6fb97a86b2d14d9aa484edef43499f5cdc5d4d3c420b492d24eae70fc4a0029f81520d754c97532e2528c0c6baa647dbfcdceab08382e56d78337d8373f757dd9b71d9f95230d0732eaaeb06d0edde398bf066245a27beddcbd9d9ecec5cb6ec8135304e4f343b51f25fcfae53d17c163f6df95fc1b0000ffff161e4ba542030000",
-		"148e9bea854a1e67513e6329a3a6763f": "1f8b08000000000000ff6c934d73b3380c80effc8abd67769ad292b647198c718a7903980f730bd09810c8574b4cfceb7708a79db73e691e3dd68c46d2bfd34398d0e01f1b479cbad4068e1fd46094daa9b66dd8ad24288a40d208fc2f6a1e5feab7fd7b206079b006110f75a56c29e8e7a9a0ba5d625054d52df60d060702cf09460db3d3948d58438464902290dc3e044d49babe7c590fdb0c8f6e0bc99cabb893064db947c4a87a771066a785f93e120ef92c9c38261ff742d3a1c89ba6ccd177115b6d692e474f433d3b8cbbc7e8dba8cca0f1fb5f65e53555c05a50ac8567e6c8fb146713d30f769f98f1802d3259f8adec5038691812acd669a23167083fdab311fb0c4df77b9b15b7aab7ce82e38ca1f09133d0c882c474078a8bbbc8ac769b076da971c150357f6e5858beac8f6c6f755fc4fda9c8d8f97d702b39de31b424c624c1c8b29a8c5a9878f85d4605438ca0fb85c4ecf5032426b63dc70628ecc1920282cba9bf98e6f3e8f0588c1fc3225f8551cd6fb8f413bedc26deeec5faa1f83ccaa6ac446787eb36f0de1ac3b4fe44d7be382eccfaa9be36fa2a2bd97b815a0c718bf6595b727465f03ab5533b0aa327154e3b80ceed3c0dc38b133ccf575e91c42e0a2b07a6855102a130f14061e528e1a4d19243e83d2148144c85349ce702e12ba6329469b761e951036d65e61f164d79f15efbcb1e5011adc52abfe4474db6e37e73b2e4ff36d200459d48818a804a727b769a7848de3741753a7b92d1ac14ab988d9b3fe9f96db434f157c7b7c8fbb4de6d8a7e365f673f3130da2d76225ded9fd6f9767beaf8adf66f5dfc7338f168150d03d1e9ca789c0d0e9cbf4fe9bf000000ffff25e62de567030000",
-		"157a03d5cc512a59a5e031f007911242": "1f8b08000000000000ff6c93c972ab381486f73c45ef535dc1e3b5974713c88e88850558ec626c2b66723c60014fdf1572375df76aa5facea753251dfdff7e2f443d1efc8369a838e318141da82338c7718f319cc6062c4760b83c4dbcf909bf5d5139bf9ce6a23eba85b9e5b046c65c3f8bfc7d2325811c8d85bc5b074b4d62293d6a5771d4532510f56014518cc45a8ed9fd23499f5935fbd28a2602c9a1865a114463d6709a768e4e66f9c72ec8f73d4d05ca7e0e7f0ab99fac6ab19d95478f3d32af2ddfaae0b957f424903b38d08ae4e0b5bda3c7b4f9bb0c4766dd4e10e80489ec3be19d20e88359b7ff617c60ce3714be6e710f2b648218815650c64a84c2d29feb716abf884edaafb45a76fbaafc14a1b60c869aef503b523a999ab05e7deeabe02b1b074f111696d94158d3163db32a6bdfaa78aa9391dd7b51a3c7cb870817d6979a38b19484b6a9bbf7835c6f677f975961a9d5fefa92f23e773148cd7fef89033223d200addfcdc8a5bbb0db097ed835254b17f08a7f4dd56639b96fcae73cf68ecdea6d3a43d45d93d3a14955096c7b75ce8b55df258dbe1c3b51ed02534f45bc3e2d0e5170f3f03a7808ef6e89d1240edd0d48ff15812460bceaf7340ece4aca61be18df3d901143566064cc0d19ca90cc0828d87c3fae1f0a04a705450ac87723818b9f06c4c833c268f6e28e46b2bd050f3f688bd9c42f33f7657e58d948a3659f2bbcad51b90dc306abffff48074cc0812006670aee797769e5586a8b660bfafa5efaf35f74776edbeea55b88a49ed4fe55b619dbe70bccc9b939235d3b73b4a1d3b83e4fd4832b1a5dd6b7515a2c19851bbb1ea6799a159d7686d8d080fc19a5ff020000ffff881eb19267030000",
-		"191e7011dc62eb84a93de49b32f5ea78": "1f8b08000000000000ff6453cb92aa4810ddf315b33726446c6feb6216590fca520b1a28d07227b417047c74b798c0d74f8813338ba955c5c98c3c8f88f3e7f3112ea4f707e5a196aea4a0f9805a4a4afa51520ac728079404721982d7e7b7fb6d39fb5ea71abef4859951f889487323d7d7bdec4b9b034a3c68beb114540226312785a249a25ade4348722f21906b5a79452aea733a5d35872d6fdd12e2d72cd32cf18af44484959dddc638756f9c79eb32885e0b574d9de4cd6c27988ab831cee2aec20cddc0b0240818c75969a2597d14eeddca445b6fcede23d57074d1ee14834eb1187d263bc5c8c145bb7f61f25f4c89aaa53dac9e641601a3a14eb40a15f21783e4786366dbdef6e745979eeb4285065d18664b8e136db66f79785915e9d9bb5999e33d541820cf878535c7fb20ed3f657ca7483ce404adf28dc39bfdae28d21df9d947b3d24a1d1bb71a34c9b3afa22afd8f401292e7fffc1521e8518088f468323fdadd488f94d5467dafe0d39e24ef960e96a9a8e8c5f58b4724a6df55bdec26e3ddb46a26ac2e61f16ee3c99eb971de293e1f3524bc56fdaf75188fe9f1f7d5b1d13af4e03fc358067302bfe79c80a27030b87ce611da3521837fb9464348102f01a5a0f4474010bb0415b114cc9ffe3e397232c6c055a09e8770fd3ae0132ab9f47f54797c2f1ffb6c67cf2e6171e1f3c9bb771ad123b5b02ebc8bbb4f74fb26c6753b577015947e8948bd2d0635760e8ac75406cc46db54a391d7463f17f3dda4ac6f3fc082c9adec3af44e2c5944a739779b9933429907d347537c6cfceb21e1756ad3a31699ba399bcd61e5df117e71c48d338d036b5c99bfaca116dc63ffafcadf010000ffff779d601347030000",
-		"1a70bfe62a3dfb8acfe032c4192a2143": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefe7b375431b050961ec0240f64297122d74888d3a394850b94d1d6b33fbd734d9897750c4447e56bc6486e0f04a2290bd4e7e594a02e3781f510d104eb6364c7489123ccd7ffc8039e5c5ac6106bf9ebcba1a16c07b3dd59e5c52e55392f2afef592e2c604a20b60bb6b0db04074484574778cbb127a2ac17e50ae7e9cf00e0000ffff1e34df7fd4010000",
-		"1bb604b61a24d1d39bb7ef39a08abf82": "1f8b08000000000000ff6c934d73b3380c80effc8abd67769ad292b647198c718a7903980f730bd09810c8574b4cfceb7708a79db73e691e3dd68c46d2bfd34398d0e01f1b479cbad4068e1fd46094daa9b66dd8ad24288a40d208fc2f6a1e5feab7fd7b206079b006110f75a56c29e8e7a9a0ba5d625054d52df60d060702cf09460db3d3948d58438464902290dc3e044d49babe7c590fdb0c8f6e0bc99cabb893064db947c4a87a771066a785f93e120ef92c9c38261ff742d3a1c89ba6ccd177115b6d692e474f433d3b8cbbc7e8dba8cca0f1fb5f65e53555c05a50ac8567e6c8fb146713d30f769f98f1802d3259f8adec5038691812acd669a23167083fdab311fb0c4df77b9b15b7aab7ce82e38ca1f09133d0c882c474078a8bbbc8ac769b076da971c150357f6e5858beac8f6c6f755fc4fda9c8d8f97d702b39de31b424c624c1c8b29a8c5a9878f85d4605438ca0fb85c4ecf5032426b63dc70628ecc1920282cba9bf98e6f3e8f0588c1fc3225f8551cd6fb8f413bedc26deeec5faa1f83ccaa6ac446787eb36f0de1ac3b4fe44d7be382eccfaa9be36fa2a2bd97b815a0c718bf6595b727465f03ab5533b0aa327154e3b80ceed3c0dc38b133ccf575e91c42e0a2b07a6855102a130f14061e528e1a4d19243e83d2148144c85349ce702e12ba6329469b761e951036d65e61f164d79f15efbcb1e5011adc52abfe4474db6e37e73b2e4ff36d200459d48818a804a727b769a7848de3741753a7b92d1ac14ab988d9b3fe9f96db434f157c7b7c8fbb4de6d8a7e365f673f3130da2d76225ded9fd6f9767beaf8adf66f5dfc7338f168150d03d1e9ca789c0d0e9cbf4fe9bf000000ffff25e62de567030000",
-		"1cfa8caf594ef8afece24c016070cb29": "1f8b08000000000000ffecbb5d8c1cd7951f7e3faaababa7ba35455996690d6dde2a51d2702d9255d5f5d1d5a6f9e7a54dca04cde5c2abf5feb12fcdeaea6aced4cc74cff4f40cc995cc19d911360692400f4aa0073d6c82c5ae810488816c003d2c8209e0048b6c36418005e2873c08881ff629d8204890a73038e75675f70c3fc42c1c20083220a7aa6edd7bcfb9e7f377eead21e9ee162584d0efb7eeb2830372971f1c1cd003b8a7077709b94be10a8de4ae76a0ded103fae82ead1ac8ddda81baf247f85ac7c7037ac0af7c8b6b84b29a5eaf1b86a1698c304a0c42584d378c0621049a6ab44628e1468dd408a3754d5b8266b3a953ad46b7e9a953354ec929fdabfc80cac3c323621e10f9d94f16aef5efe9fa56be359e3c6464a9d71ba4d3b4978f069c9abdde5a9e6ef7fae96ece99916e6e8eb3749a13ba34c8670facb93b4d27d3de7e3e591f3e24bcf12a25929ad62b5f3af592d530cc335f5baa5feafc9ab7f47bbff3ad1ab1ce13b34de901bbcaae0a220fbed72270d322922e9b82486a690ebddea2822e9b1621aa6995982f43377abd4504115452ab669a6b941ebc4ea4d870f83922b83c5d0862bd0c13b3ab82afb233d09b4bab10d47a191a5e8179b9340ac15443f37a8b092e45710ec9cb0349acb7c913d7654a0f80acf5aacd045b364d644230eb2bcbe697ca7782096e9db635a12d9b2d72ed9ffded7ff89f7ffc37fffe1f7e7c004fbff793cffed5dffd971ffcbb3f3c301931cfe0f2055965b8540277b345bdf9c44b6914ab8c38041e288aa3669aaf5552fbe9e11191df907f797844ac0b20aee7bdfa33461988ccda70d83945d7264a60205d79486fb688609244ac098fd6cd16552f0eb53bf04650f9ffedc8433ae99297f049df9f35b4b0a1b92f9777e4e7d0d094dca64d6e1e1bb68c4f2fefcbbfc22e272779a99a6436abd46cda64a69289e17014cd3b28186a2d63a3cd4d25435e08da25a01d6896b48898d164c719685524cfeec823c526b3a989fa5d2e473a5ce05881d44821986c82155d7ecd144c5a602ec4fc1b94b2835274a82e568edd10148405733854f09b2d2698a45398141a6fad385492efb6188e642da57acd664d5a2e83492aa8a5dbc039982c1134629a2082458c98cb260e582526f852367302d028b240a033934681a62fade23c233127aac9802663d62428de198249adc0e880dd2cd7a682a163086a5e421b4723146c5148ec9890c0232ebf2689f96dca957fd30b043d1bd726a9cdf9559041616b824b5a38cce642c33ea6a996a7a9c51253fd5b29bd4b9255f68b0faf3a2019b6728a1042cc3709bb2afff2c32362091438de8387586f2d9bea8158b66992eafe4d731b8dbd1ac4eeb49e78567dc197df524ce333b76c54468b4a329b5b70ebdc32d816aa1ad6756bc5ba6cb31939c1cc3adcbc46cc09e707f4116acadd7028f89ed5b65a3613c4f2ada6cd51298259a1a0e7b9616b8259915d4391c6113b23a8a845ecb4a0428bb905f288d83920193121a81485fcef8748d447855b2bc4fc078cd64a7a1a8437bb8611cfd6e162d875b868b6011762f32a0880a07ff693ab92de681149ae7d70787868c5fc673fb90ab220f0f68fe16de1d422b81335c96eb5a82934b0352e0c5117ba15080d74b92a34f9c9e111b2aa491a3101e3ffc3875741eb3822626f0b8e39401265113c820e8a8f5f82c2afb790eae76a14088908bacaac5384c024e7b9117321e09528045b65cd5384ccc89838ed4181938a9aa4b75bd4c4a422a8f57562febc94d11141f7018b0547e6e8935a016a01e9682a240ac1aed1989f16e0cfa70b581693abe865daa297ada297698b5ea6e1ddaa60f2dccccb560bcb7dad724a4703e64504de2fd8797e3ae646c54e0d237f99067478a8091dd380e0d0d78821f4214d747e52ea14e304b81495cd42ad036671704d3541af9198810f35a50649b745f06e95986066e0d868749a323388b77feb8323327751c1e41129ce11f33f51aa2d48b1145dc97d8bfc9f2743aa64489f2743f3d87af9e27adf5021d7aa422e856cc904019161f03cfb1a21a646f09734cc7f7b9a1907f4d12ccbfe57c8b292d89087fefcf17b775a7063160e950f766c95df1ced3ac41abb86038c7d64599e051f968f1f3faedf4264a30b7acfa11bf2eccebea43bb09ee986bc52d835b4fb0d9b55d909dc745ac88fd86cf992402090447e15dc621fe6103579f6c6cad4ae4b621bfc6a2939f960c769401223a221f886d3c030291ac0bd6d8846b512db68a2c1bd82241a42170d41455d7e6d5f6a3ba05fa4fcce0abc58b1755197746ad7056d1148ed68d53a267a61b498092c48061ced4c215b0aba3111da0eb0ce553e10746367edc4aab8c9e74ceb82df117ac9f2cd1dbbae1664393a5a02f269eb60eb86a8afc05204831b067c08bd454c615c6f55c0e323ae5239ea5bf08d3b2d0651c4b01c95c5a505ef0cc1259f80c9188243f267924e940bb102b337f63d64375b15847cbd891331c9f71d26f9140c598563957175c8782806b683781682b7820a42176c82ee0ec989831d1a9539a2cfc2850104a2a68037c0d28c3f0427282c64d11486348a65730e688863480b2d533e00e3326fae385cb17976c7d1f761ae1dc439289fdb2d2e28809512b93e4b4fbad20fdaa7d0ed3a5a9a0e8aa16003a501d1eb2dcdac74094ad40583f5de74745011853cb302f663eb4d051ea83cd8052f600570392d9c7a6574205105b628c016b03da370ea37809ca88398ea422fc54484211f097e7f074300540b7cea544aa54aa973a135b9593a1815fa5432a1cfac75c7a916afd43a67e7057960150fc0beae58997351078b993a0c44c3178d8c2b232b4db6aa97f8be6354c68518f1136ed3b9a21b920a038dcc4023636064252f0d61a09131db30957519603d33eb828b113103fad623f60957e1f2232ef4656c3bee22f5d93aeab00e3e7311bee022cfe15eff62eef5a770af03f7fa09eef5e3dcf3927b06dcc3f0fa719fa93fe933f5059f997bce471c14734755761f63e4b8d5e21827b9fcc7875ae1e8d2dabf0aa67970a3a503649b3ac6f596ae90f5ef73a1cbc3c3f7779c5ae1d0089eb1fd0fb80376a0430911b13f40bffb94032280b2f0a7bc70349baa08489cbaca7b8d4218375a5c593e6d9166cd348f49102d4897cbb7b0a2224a0470fd08402d9bd91c2b1ca6201153eb9f0521ec4a4ab3c4f17580277393d43146493ec18c077360d28d98cac8cb6625b73f29754e8501400d1c21627f02c59d7c7c3662475cb5fc1444094bfe635e48a2a0c93fe2058015c2d11951c085d0d1c750fdf29542d42b8a0e9587ec9d1629297cca3143feff28ec8f01c4d2927d8892721521803c3c3ca411fb8c639a5b65cd16240efd760b43d1ad1614000d512b145e509aa172b9c054241f1425192af40d6515a2563875842d0652d5e5fb8530903074ab3f49181305241f87e188191990bc8e04f4858c338b172863d51b240cab12c6cd1510ad3044adb0eb4253d6501a8fa8df68d1b9c930b35c3151ce021730ae72b5c7939e0df2303620bcf3b9477cca05c63ab43c9c9e5d15752c9a25df91f41d558909a6c45525c8bae534a9a9ba369d065c0ca77603d15b039cb7014a2f5d1d6201c44dc9f7cbd0a9033f688e855d0777068af2d14e259f99a46661932fe4666ebd0eba46d3fddf989b95564bf35096c1b10efa98cf995473d232406188297b7dc49fb6942a9b2bc556600ea2827e0b8330e8af508f0cb56bd8b44ca4ffcb1e01194cde054b970f30400af0be9b2b4e43ae404f00eef692682890af103d83d0bc1433e3e96e5c39ba20f267bc100d6914d0da90670a15e2243a731de389436f812f37847e03516a63ae6540a1fa865371dfc0c245ed68957ad605b55e2fc50b7aae577aa68b3808902ce8b98e7a4671d5cb542deaca63c1c230a5182a953755ba69aafc6e98f35c70bb32b0bf9e2333f39f42d90a5c9c9e417cf04f870b702400b708dc5e291c28041d6d0581470d2b0a0c23e8da3565867ce6421cf70e84b6626bfcaad06c9d5d153587c33c9a9a406da7da1a58ba65d74c5103c1eba83d7053a8d62b40b4cace54b0cfa9e106e73be8b7584a71dcd522a828ec0a1eab2de3ee9a7c05528c25e88d95a2ec7dbd45cb9471ba42f158d021ef969af4f4bcfa50ef20e4a32d95afcd7fc1a876508619631faa7c496c702d7916cbd3aab0625217fc9ea3cd0a2bad2aac009242d56ca90af98c2042ab1639df43a9f6036b920a0dcd46afc203018d20a465efb4a8e0b6a6fcbf26f4120451155f8163dc3ac5124dab4a344d956890e6c03766c5154a188a46260814564468368752b6a205aead81d689324a4d4524dc483aad560311096ad865136ae3391b60d665dc8231e6e7356a1c48dc33124cfeebc7efdd6a11690956160a0c0a05dc6a78bf644b87bab6be089200f14bae7c09628621ea85dd1075a1dd5115c4a75c346eb44aa40ab71cbddad125db69e902667496441d229876a7a535755368e8d23598446a93f26e437e7068dc69d59a80d584b6e1200ae5a22634c0283b13a9a2b4213485457919271a339087db7bcc7aa3c94b172ec9de7ed6344c51e2f3e1a59333f06cd437c66f35cdcd721fb8e40e71186eefec88fa7cda3a4ccb663c0194445fa6e6b3975205ec3a6e8cc02b4d590486c667005eaddc35104bb308d9a8927043c5980698514385da3282344e26617d9684e7c81f18057b78b6d84b5eb527658febac3f39fc845c4c4195508860960dfe008ba682e14887438ad22402969d950d87c3ec6ad3167704df146cd914dcfc2f1ad50e10bbce300c82694d52287835345daa32aa06bd9872f94f20176b1b0e463a7474be23f93b18eb50c682abe60a822cd43e112300844b33c60451c91d637bad2c5e55c55803b9d7162ac652eedaa2dcb9e2b9c426e249b2b35d093c5f9921353e476a25f3c781079402b3fae0c44ccb4fa219d5fde3e3dd717a9cbcac35cada03200b3877ad142ba4a09944782511ae24c2f114e24695089a2ad23f4b224f63f718274a47e6b2f9e426cdf26c8f86cfcae8393ed024246908f47c5e3e13c5862678593e9359f94c4e94cf1075cdbf53c7a40ee19061f0ac2cd6a185adcde2265736c84b1b64d84b50c42ba5d2b0a48398af3c696e831425bd1805f02c4cc984095a60d8c7f5cf8130ab84ce94d0d90b98215f3043b069dc542f0d5113fcd9b491d2cc10b5b9216a330d2d1822457c0a16f9b4555411776688b482b3b3ee58ff9606404e9a22c6e9cf3848f78e4a459f627d5a3afbc7dca9c9f32823a8bd6d86289ba998008854e076b456c0033395dc1824e2f2e8fb675ced07b5a0ba55874d0466012b2da0542eeb2f00018fcfe2af5b2b362bd77504d987dfc6847f00281c2297f21c5a81d7b9e768951235a5448402285ead52a2f66c253e4dbec704a72c0b462b608daa0260ad663be2f20083c011c7f3ce85b5b3596dbf6c2ecf40b8f61410ce67684a9b83f0d2c9b8d04a10aedc5c391953fbf20bdbf3cbcf1431591432b063fe15806c0573e49f3f7e0fb031fcbfb5e2d00dc14a0c7f12f7385a219b8565e3b91554b355bd48410235c1d0776d0a6c3300483a5665301e229eda31542100250daeac68a24f6fe08140bd824ea2566e46951911025b0dc8d4e61914f74d8fa7cdc52dacc50cab4e8d164e7df07c979855fa63255d2cd2399ed7dc4636003c2ff28020884326d416f72ccbe457d16542b3de28f73739a4dd86ca7a5f26e62922ffc90747449e923fff407dbcc088b949abf353fcdc439d16978099cb338564eaeeed42b6f0ee1a8b019dc84f7e748467331cdacf410e96a200d8cb215dac628356444ca80320625d24e678466c76c6a3292382f557c4d88c1853c498fcecc747e541101263788a0ec4181eb1033d06ac0b756af5a73f3e427adde3dfb25cc3635e7e8dc6d51acf00e02f99c68333a5b88bc4f49e7b1cf517e5191693ca6badaf12136c37049310d44acc9f1bd4a88eefd48734e78a4aaa412158595469e52e5e977450c858a44128015f55f23e841f2de686e25554874cc8365c2c95a85d75595d2807303ad655ee24b68191d36940ae128d5b2bced2f51695d406b331a0963456c1a85659f314252d4d18b259d84c525bc7208ce526eab2263560fe1423d7f13b04ac1474b17407bfc978bfb04b8214b5613351834b0d49e91023f0b45c945fe8c0fd3945f47ab9957a9c5da60e5ac1032bb6c933d9e625dba20e35754d710f92910f0a3c83c4da5c5ac5054250e8a2a65041c40c496c28e6e7b0a0a68e365fd208659c98a2561ef9aa45e32956d9e4e0c97fe0687754fd0b4faea8ab330af0cf97de6931515f656471bcfcd30f8f08d407d63788590d5b5573bdad10581d376956f1653511393e91a90ce315080e11b34e9484355c804689ae996a7a44d16fbfd3a2cd9a59e9523522ff54f14e90775292d4505978e4dcc48d66a2f8125507bed0a15a18510bab68d89a29a98d7b0e9a723d8a5b0f28c5ed02c3e90d85f1571dad948126d4070a115bc57a454e95df2876c99d56296a74185b2bb37175eafc84a1d64a43a5cf32d4732f6ca89a89e59bd06f97e6885d0da8d2a6e5e60c648c85512d8e4a06f9fcf447ea2bb21fa9402c3f03796982a0bcb83a1217da89afdceaea13a1f2da34bf8e220693c6aad338f1b14f1323bfda177c62ecf168f5178cb303fa88cf53817c4bf98120d73efa37e477a0bb5d0334f516ee6371d928041eb9bc5f001c814e874e0d2e8f7ef8474e5d3e7ecc77e42fc9c831249dca5ffcf888149708f9262142978f0a511786fc25d9dc50fd4ef4d040359a20d71efff37f7fe907b62e6a36c1b36374b83f7274f91fc9f710ae0313f251e16805b60b7d4e577fe6fc66b955b2f4dd9373e8277a62fe561d0eca0e6ed1252576832420ab190af996d036ac2e7e84e52aed7d5ea3b5f2932db0bf6fc8c3c39f93d926f78e43f1ac55d40a75ae56038b33d4f783babc60d72b46b51df53d8002b30d2824b0f8b49704c38ddb067e13268fe88e3ca4370abb01c8b2b0a95802be97709fca10bc108d0ddb4027ac10a0a15c8ea85339eb9b6aa3e834005841e499027d1a6c17eb175206bb86306ebdf0285c82b1833b1bc686dd80285b7e164505b9405c67492c497e63e5258d114a98291a18861b209386a4fb36854b01770db35c192fc11fbdae8a88d31888e0d7995566298737f1eb538a875ab44b20a4b9ca3de66cff0a5952073d2553f4b94c61bbbda4789b090ebb619c50b3db0d6c531faf9cb60966e10610c0ef43aab58925dc3856d3e3b1d2e2ecb3b592a752227f6d4aa629a8f96ef5cd3145dbbe5125e655dcfe9c99020c69a97257cda77a30cc230be602b94c3323f5b12e827d825f3772b4ef2e519fb3e1777d68de0c2b20f59da369fe598311f529f62f6a8c10a295ff7979ad114274a27e2821a44e08c9d2cdcd7c20eeded99eae8f47ddeedee8fe24dd5e3d7f578c472215777f7d3ccaef8afd74732fdf9d649736d7fbd978925f1a63f78b93dddf2416f93221e47729c1ab4108b14a7a0d42c81221c4248434cb36b89613e1a7de9726e9fdde7e9e5d9cec66e9769aad4f1f8af17e3e196e8eef7f4a2cf21598872bde971778071aeba341fe408cf7a6623c14fdf1de68b0db15d3b55c6ce623b1be2b447f6f8acfaae3faae20e497c4220278a11679f957b17e7a7cfd8b9d7637d7b3fcd2d6787071b2ab58a8989da4a37bb9188e2702fb40d3663eba375d131f510b75f4f7a8451c42c8036a91d38490b5252503d7753dd777db6ee0866ee4c66ec74d3cd7f33cdf6b7b81177a91177b1d2ff15ddff37dbfed077ee8477eec77fca4edb6bdb6df6eb78376d88eda71bbd34e0237f0023f68074110065110079d2009ddd00bfdb01d06611846611c76c22472232ff2a376144461144571d48992d88dbdd88fdb7110877114c771274e3a6ec7ebf89d7627e8849da813773a9d2471132ff19376122461122571d2499245390db7a6a59408f97d6691570921ef6a84ac92f9f35d8d10fc4057fee2c323627ecec9fffbf9bffbe77fbcaa8dd2ad9cfeb757bf45be8bb1a2db2d8345b7fbfdf4fe0ff2ecf2bb6fcb2bdd6ef53723bdf551b7fbde7bd9e678776f92fff087ddee9a1b058334e874d2be9b26c33ca1b3bf36610b7f6cc28ffdad89d6ecf5267bbbd31ebeaebd543e95ddf5ea79a29eebdee57745ba2bc092bbdd74f4b0db95a38757badde9c3edbcb73e002612cf8b22b79f06612748e3d8f8e221fd611676fcc86fe783b4ef0fa286ab3a6f4f27dd2e50ee0d26e3eddefaa8b7bd996679b7bbe6e75e16bb03df1d80f377fa4b5f38a2edc7fd2cf4f2761cba691c78e6178e08865ee80e3b993f4cfb8330499abe1a312e83a70aa297dfbd52c551587c90ba7e98441daf13255e96b65e604c90266e1ce4c3b4dd8fd370d07fe99b97dfdcda9b8adf9e4b6db835ed767f7bb23ecdaf74bbf7e1dacbd6d249b7bb96f7bd2408bd30f1bc78d04ed2e58ba5f1a0e1a0d55c81f5ede6937d58549c059d240d632fe884be1fb5adee8b10c3a6b5a13f70c320ed0cf3c80b93243bf542637741c06b7edf0bdcdcf7d28e17b883287dd9df9d0ebadde170bddbcd549f6fff26fc1e4ec65b3d54ca5a967792b0937a5e120d82fe20fed20f2ee3a0dd87bbbd6cbcb505029dae4df274d003cbdeec766fe50fe7bc8cb777bb5d506ab7fb9dc978fb4af5b0960749126743af1379fdcc8de257629cb614dc201fa67b9ba53ff4f2c9643ce9ad8dc71b20803cf4fc6c10f4b34e92c7fdc1970df48df178eb55b3d79b0c36d5a0afb4d443e943a7cbc7d285be7a0a07f5f37beba3dedee8fefa68f05a880c6ca7a3f56c637d74afdbcdc6a3e9fa682fef615b29ff304f82a1e786c130f7e324cb56e293c3706635e4fefa74ade2db1ba4d1304dc370180fb3dcf7cfac5d7e1182bf01b7bf913edc1ca783b958b143b77b6dfce076fe5b20d5fef8416f2befed81689376ec79edb4ef2551e2859de1d7fabf4a4af772104316bbc3ce30e984d9c0cfe2a0fff5a5f9b2cfbe5cc62b15e2b04dc8c1e656a5dcd9dd776677eada4b37d7ef8db6f2119048ddbcdd19f803378afb6e980cecf845a680289384e9301dc6c16018f87edb75befdfc817ba3cdf5d1466f339ddc0397de1b81ba3a83c1201c04713f1a04791206af7fc124eba3dd7c323d31493f4fd33c8c8771e6457ee646e73acf9fa4b44e587c278afb7996f9ed4ed076fbc337ae3c7fe4607d777bbcbbc07e3ff6d3b43d6cb7fbc33049fd37c3e78f1f4e72884c79671807913768fb61160e63ffade40b649e6fa1cac0313a11acd38bbc4e27f593e16abbec335bd268b0992f7a3444a441d677fd6196b4bdac9db487e7839369b782e8bd0aa243081864ae3768bbfda81364c324f8b52f4c23fd30cf079d30ed0fe238cabcf81bd182752b97509ea0307d2f5bcb331063de0efb49e2b6fb719679c3387d3b50e3104397971e02edde663eea0dd3f54d4c8c61927506ed20eab4bd4e9a5d38ff746a1015622f8a82be3f6ca71d50f6c50bcf604c459f280f7d40c2fdc40bd2ccf32ffdfa42c81fed6d75bbeb5bdbddeee5f5adedcdc56cf09df5ddedcdf421e2ffbdb67fa56c5ecb53d71da6611006c3a4edfa6df7f58541983ac096827e906549bf9326be1f0fdade0be08fdcede7fd2488079dd8f532d7f78f45130c353747c33158d0ee6e7a0fc864411e85699406837ed88eb24ebbfdac3108a13095aff9717bd80f9270188238dc30b8726cd0f7663dd747d37c324a377bd978b43b9dec655334c04194c5d160e87706491207a91b5e7cc6f8e1fa2630390813a8583a6914b86db7d3899ed57f737d04fdd32419b6f334ccc3ac3f1cf4c3d87d46ff6cbcb9b7052b4af3b01f6541e2fbbe1ffb91df0916542227f7f6203cfec0eb7677d7c6f77b7bbbebbf0b74bcc41f7a419c055e3cc806a1975c7aaa610cb7a6bdbd28008f0822cf8df2d873c330f306fd6eb830e0c678b2954ea7f904181df44076f72690dbd73ac3a01325033f0bfc7610b687dffcf68b0c5320647b920fd71f00fe6977fa01c4b741e00edbe9f0b2be956f65db0fc96f35b627e3c15e964f7699b1998eeeeda5f772aa7d7f6f774a9adb937196efeee6830bfd87b40609267bc3bb18b817dd0ba3f57b6bd3cd8762354d833c8cb3b4237cd74b2e78ee85b677fe7f060000fffff787a040d93e0000",
-		"216fab6231115b24c6feeda8a1eb805f": "1f8b08000000000000ff64cf4d4f834010c6f13b9fa277634aa3021e67a6c3b0f222bbb8d87aa3e5a5b4dad8444be1d31b1b6f3ea727ffdbeff677c8a2b2596e54092f3c8b797dad4eaa24828e21c554703c4991de3f42c742f4f7078e50dca11a1482d6dd53dd54d8567d815f8db74bb64ee27daa2353b1dfe2a10ffce62164d32c01ca85b73390118079b57691f9daa5b65d9bcb77e24defe179deee65c2443b6aecee36cf16bce832f9714879ad89dec6835e5909e0a6fc88e7607adb1f732a37a720d214b872ae57ce15c0d9f23fea270000ffff5e627844f1000000",
-		"24424a4c2a6565a09b5115235ca0a284": "1f8b08000000000000ffbccf31aac3300c06e0dda7d0059e1f6fcdf6081dba345d7200d5fe1b04b61c14154a4f5f9c2e3d4146a15ffaf82f2d639ab721109d946f0503b93d1088c622509fe67386badc05d6434423ac8f891d03254e30df7e13c766cb5fc493eb5a1053ab3f7d1357d4fd6ab285555eecd294cbace25f7f29ed5620ba02760cb9021688fe73153d46e44e859ecab0a36ab60f16de010000ffff93db101ce8010000",
-		"2473198f46be236809b2bc408138ff1c": "1f8b08000000000000ff6453cb92aa4810ddf315b33726446c6feb6216590fca520b1a28d07227b417047c74b798c0d74f8813338ba955c5c98c3c8f88f3e7f3112ea4f707e5a196aea4a0f9805a4a4afa51520ac728079404721982d7e7b7fb6d39fb5ea71abef4859951f889487323d7d7bdec4b9b034a3c68beb114540226312785a249a25ade4348722f21906b5a79452aea733a5d35872d6fdd12e2d72cd32cf18af44484959dddc638756f9c79eb32885e0b574d9de4cd6c27988ab831cee2aec20cddc0b0240818c75969a2597d14eeddca445b6fcede23d57074d1ee14834eb1187d263bc5c8c145bb7f61f25f4c89aaa53dac9e641601a3a14eb40a15f21783e4786366dbdef6e745979eeb4285065d18664b8e136db66f79785915e9d9bb5999e33d541820cf878535c7fb20ed3f657ca7483ce404adf28dc39bfdae28d21df9d947b3d24a1d1bb71a34c9b3afa22afd8f401292e7fffc1521e8518088f468323fdadd488f94d5467dafe0d39e24ef960e96a9a8e8c5f58b4724a6df55bdec26e3ddb46a26ac2e61f16ee3c99eb971de293e1f3524bc56fdaf75188fe9f1f7d5b1d13af4e03fc358067302bfe79c80a27030b87ce611da3521837fb9464348102f01a5a0f4474010bb0415b114cc9ffe3e397232c6c055a09e8770fd3ae0132ab9f47f54797c2f1ffb6c67cf2e6171e1f3c9bb771ad123b5b02ebc8bbb4f74fb26c6753b577015947e8948bd2d0635760e8ac75406cc46db54a391d7463f17f3dda4ac6f3fc082c9adec3af44e2c5944a739779b9933429907d347537c6cfceb21e1756ad3a31699ba399bcd61e5df117e71c48d338d036b5c99bfaca116dc63ffafcadf010000ffff779d601347030000",
-		"2577c465c77ee6631778681231b52afd": "1f8b08000000000000ff6c93c972ab381486f73c45ef535dc1e3b5974713c88e88850558ec626c2b66723c60014fdf1572375df76aa5facea753251dfdff7e2f443d1efc8369a838e318141da82338c7718f319cc6062c4760b83c4dbcf909bf5d5139bf9ce6a23eba85b9e5b046c65c3f8bfc7d2325811c8d85bc5b074b4d62293d6a5771d4532510f56014518cc45a8ed9fd23499f5935fbd28a2602c9a1865a114463d6709a768e4e66f9c72ec8f73d4d05ca7e0e7f0ab99fac6ab19d95478f3d32af2ddfaae0b957f424903b38d08ae4e0b5bda3c7b4f9bb0c4766dd4e10e80489ec3be19d20e88359b7ff617c60ce3714be6e710f2b648218815650c64a84c2d29feb716abf884edaafb45a76fbaafc14a1b60c869aef503b523a999ab05e7deeabe02b1b074f111696d94158d3163db32a6bdfaa78aa9391dd7b51a3c7cb870817d6979a38b19484b6a9bbf7835c6f677f975961a9d5fefa92f23e773148cd7fef89033223d200addfcdc8a5bbb0db097ed835254b17f08a7f4dd56639b96fcae73cf68ecdea6d3a43d45d93d3a14955096c7b75ce8b55df258dbe1c3b51ed02534f45bc3e2d0e5170f3f03a7808ef6e89d1240edd0d48ff15812460bceaf7340ece4aca61be18df3d901143566064cc0d19ca90cc0828d87c3fae1f0a04a705450ac87723818b9f06c4c833c268f6e28e46b2bd050f3f688bd9c42f33f7657e58d948a3659f2bbcad51b90dc306abffff48074cc0812006670aee797769e5586a8b660bfafa5efaf35f74776edbeea55b88a49ed4fe55b619dbe70bccc9b939235d3b73b4a1d3b83e4fd4832b1a5dd6b7515a2c19851bbb1ea6799a159d7686d8d080fc19a5ff020000ffff881eb19267030000",
-		"25b4152de41b0df57d18db81b636bc5e": "1f8b08000000000000ff6c93cb72ab381086f73cc5eca7a64230ce4996ddba211c81215cbd33d88001df0f16e6e9a76ccf6e8e565d5f7faa92d4faff792c64427a7f111646924b02117b52434949969410d82e6ad012a196217c071fcb46635171b159a42ab40ffe755d6a52e772715cc9a9351968a9372dfb36147402de63868d2249a246364188b59720d411e9bca610fdbe98b9c33a65236f217ef5ca88265e53ec5018e59e0fb9d54fb9f5398a08b297708c98f8baaf2639acb2a62932bcae7ee66d6199a333c1e6e5a8881fc2ab515a5ef3bdffa3ac9da6f4540b5ab5f0ae687d7fd4e9834d4f767f30e3095b2c5470d524c86912048269378927162964cfeb11548bc0e2d775baba95fbf9298f58aa3078f60c1c95175b7c906c75cfd379bbcebcb69858a5d07c0aa0759a645e5fb61c8a997b50bb79bf15fc7729c6fe7befdd8c22c2954225f07e163fcafe829a0942feab3573c09480f8fb6636d7ee7e8c5c187c9887c74c0ce1e20391186eb26533cfda6c6c1fb59fe86ebe1737e7701a5c3ecf6ff1f2ee67c3f5641dce67db71b57bfc85bae4dad1fec66f0b8a674381fd38ea866a866f3a78cc971fe9eba59d9f98bd46575fb0661c83924290cb85ce1183d879fc0583424e93d08c2070de10a4060a97d766c55c0abb9a601a7f8e69a560e7633e441f7c3856bbf55902ef59df1aa7ed695ba9d545c8bfdb3d8505d6f5b9e95a7f1904146a4f02450e3b06f6c8de6fd7599f309badb77a5d1dfaf173fad519d930abbacf9b3daa43159fc3af8b6912e9c4915ad277d8664d48cdb78313b8cceb26db6fc95b6d55408fa6b8d78eeccea5f14c04f3e8ff53f26f000000ffff33c50ead42030000",
-		"2749db5b7c7daefa7a47056ac079e48e": "1f8b08000000000000ffbccf31aac3300c06e0dda7d0059e1f6fcdf6081dba345d7200d5fe1b04b61c14154a4f5f9c2e3d4146a15ffaf82f2d639ab721109d946f0503b93d1088c622509fe67386badc05d6434423ac8f891d03254e30df7e13c766cb5fc493eb5a1053ab3f7d1357d4fd6ab285555eecd294cbace25f7f29ed5620ba02760cb9021688fe73153d46e44e859ecab0a36ab60f16de010000ffff93db101ce8010000",
-		"29316eb7aad39120c720b4154a4e0b0c": "1f8b08000000000000ff64cfcd52833014c5f13d4fd117705a47b4b24ce825bdd044482bd025b62512b01f232490a777ecb8f3accefc77bf87df51602866a9c49cec6096c0fe5e3d8e6c4d14104e39a3d38d6db91f10052c0cffbe8535650b5b59a424cbd47888baca2d5be312fd7d74ca9b9bc69c9ff547f8da04d1007ec26f34d6d1d205ec53121112228bf7a97fab1e47a34569d21233babdee77355efa8345efb4e0d08b2fc1ceb1ac1bee63539dae51976fe46adad8211ea01e639d1fdba77242a46d77498b79f1a2bc3b00c4ea3fea270000ffff52ee3e52f1000000",
-		"2bb998056a14b0ba66102d9e2da77921": "1f8b08000000000000ff64cf416f823018c6f13b9fc2bb59a0581639be2fd452b0d5a2d3e10d071431cb329c43fae99791ddf69c9efc6fbfa7df21e342cdb6b938c09ecd32564cd5918227601848941cc74fbe933404c37814fdfd8125c8bda11c0482d66671ede22af8c275e8d686d417e7a49bb67bac1a92d9a5d8f8630fc6ae37e771975cda1c540490d379757bff28c36e1f7edfd28342b7e8cf2fd6a5c1835a276b9a2371ab7115972d06db67300551e67424a54f9567638cfad77a9950cdfdd45bccfdabbebfdd5319381380a9f83fea270000ffff3288240bf1000000",
-		"2facf2a57f0900858307be73ce414e86": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"31cb19a9c3e6f837ed81935061b0838b": "1f8b08000000000000ff64cf416fc22018c6f17b3f8577b3d8a8c3f4f84291228188a8536e9532aadd3466a62dfdf4cbcc6e3ea727ffdbefed6f9832ae46eb0ddfc3968e043d3e6b22392b20509058321cefccc87906813242fe7f470bccd2aeec3806ad830d04f567465481afa5f09764d57ae1dc349b9dc6433410ef06b530cba2b5a6de802200c64bbd5855629a0fe3cb6461c92345cda1ed51d91c3f3e93deab7cb7bd0171577573676ed5101ecb74eebcd613f11e5bb28fb43a7cb9f5e97b572f0d6aea0afd38933c0154e5afa8df000000ffffddb40f5df1000000",
-		"324f9215967e3c2f96d54f32b8ffe1f3": "1f8b08000000000000ff6c93c972ab381486f73c45ef535dc1e3b5974713c88e88850558ec626c2b66723c60014fdf1572375df76aa5facea753251dfdff7e2f443d1efc8369a838e318141da82338c7718f319cc6062c4760b83c4dbcf909bf5d5139bf9ce6a23eba85b9e5b046c65c3f8bfc7d2325811c8d85bc5b074b4d62293d6a5771d4532510f56014518cc45a8ed9fd23499f5935fbd28a2602c9a1865a114463d6709a768e4e66f9c72ec8f73d4d05ca7e0e7f0ab99fac6ab19d95478f3d32af2ddfaae0b957f424903b38d08ae4e0b5bda3c7b4f9bb0c4766dd4e10e80489ec3be19d20e88359b7ff617c60ce3714be6e710f2b648218815650c64a84c2d29feb716abf884edaafb45a76fbaafc14a1b60c869aef503b523a999ab05e7deeabe02b1b074f111696d94158d3163db32a6bdfaa78aa9391dd7b51a3c7cb870817d6979a38b19484b6a9bbf7835c6f677f975961a9d5fefa92f23e773148cd7fef89033223d200addfcdc8a5bbb0db097ed835254b17f08a7f4dd56639b96fcae73cf68ecdea6d3a43d45d93d3a14955096c7b75ce8b55df258dbe1c3b51ed02534f45bc3e2d0e5170f3f03a7808ef6e89d1240edd0d48ff15812460bceaf7340ece4aca61be18df3d901143566064cc0d19ca90cc0828d87c3fae1f0a04a705450ac87723818b9f06c4c833c268f6e28e46b2bd050f3f688bd9c42f33f7657e58d948a3659f2bbcad51b90dc306abffff48074cc0812006670aee797769e5586a8b660bfafa5efaf35f74776edbeea55b88a49ed4fe55b619dbe70bccc9b939235d3b73b4a1d3b83e4fd4832b1a5dd6b7515a2c19851bbb1ea6799a159d7686d8d080fc19a5ff020000ffff881eb19267030000",
-		"348d32f57f1586048bf3382c2d2a1fe9": "1f8b08000000000000ff6c93c972ab381486f73c45ef535dc1e3b5974713c88e88850558ec626c2b66723c60014fdf1572375df76aa5facea753251dfdff7e2f443d1efc8369a838e318141da82338c7718f319cc6062c4760b83c4dbcf909bf5d5139bf9ce6a23eba85b9e5b046c65c3f8bfc7d2325811c8d85bc5b074b4d62293d6a5771d4532510f56014518cc45a8ed9fd23499f5935fbd28a2602c9a1865a114463d6709a768e4e66f9c72ec8f73d4d05ca7e0e7f0ab99fac6ab19d95478f3d32af2ddfaae0b957f424903b38d08ae4e0b5bda3c7b4f9bb0c4766dd4e10e80489ec3be19d20e88359b7ff617c60ce3714be6e710f2b648218815650c64a84c2d29feb716abf884edaafb45a76fbaafc14a1b60c869aef503b523a999ab05e7deeabe02b1b074f111696d94158d3163db32a6bdfaa78aa9391dd7b51a3c7cb870817d6979a38b19484b6a9bbf7835c6f677f975961a9d5fefa92f23e773148cd7fef89033223d200addfcdc8a5bbb0db097ed835254b17f08a7f4dd56639b96fcae73cf68ecdea6d3a43d45d93d3a14955096c7b75ce8b55df258dbe1c3b51ed02534f45bc3e2d0e5170f3f03a7808ef6e89d1240edd0d48ff15812460bceaf7340ece4aca61be18df3d901143566064cc0d19ca90cc0828d87c3fae1f0a04a705450ac87723818b9f06c4c833c268f6e28e46b2bd050f3f688bd9c42f33f7657e58d948a3659f2bbcad51b90dc306abffff48074cc0812006670aee797769e5586a8b660bfafa5efaf35f74776edbeea55b88a49ed4fe55b619dbe70bccc9b939235d3b73b4a1d3b83e4fd4832b1a5dd6b7515a2c19851bbb1ea6799a159d7686d8d080fc19a5ff020000ffff881eb19267030000",
-		"35d4650a5b943111b94b92a7b0cf9d3e": "1f8b08000000000000ff64cfbd6e833014c5f19da7c88e2a1a20248cd7f4625bc5988f10011b0561d45610b70990b7af8abaf54c47ffedf7f43b8294c7bb24e31738e3ee15abad1a8253060a411041c943d35cb83e28a441f0f71764843e2fcdc209a4a992fcd376ac6361eead3c1aefa57165c92a5577aaba2cbc4c9e5992ce743ea650b78f2183380038b73e9b6d69e1cdef3c5bab390d933611ab5dd541631aef57f7fb26bed6633ed1914bfd16353e697a9762d1cf435c0cfb833b2aef544753793f405dc9de21050b8d0d80f1cb7fd44f000000ffffffa00deff1000000",
-		"35eebcf4cde7e931370f01aa7f6703dd": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefe7b375431b050961ec0240f64297122d74888d3a394850b94d1d6b33fbd734d9897750c4447e56bc6486e0f04a2290bd4e7e594a02e3781f510d104eb6364c7489123ccd7ffc8039e5c5ac6106bf9ebcba1a16c07b3dd59e5c52e55392f2afef592e2c604a20b60bb6b0db04074484574778cbb127a2ac17e50ae7e9cf00e0000ffff1e34df7fd4010000",
-		"39946a8e8dbdd9b56cbfa37045f66371": "1f8b08000000000000ff6c93cb92ba381487f73cc5ecbba6c06bb78b599c84100226dd412ec24e508308da5e30c0d34fa9b39b7f56a7bef3a52ac9c9efefe7428432f1172641c81c8621242f6a70c6f0f78031ec7c059a21502c801fe90d9de8f6316d0f5f6119b09d69e142639532ff9cb1a1b20868a6b715591a1c8e1446114125c771cc3b324080948811a8101f4599d3bac9275ebb4948e75410bd7b4568c7a2cc0f881a45e3b4e9b81ed2f157474358bf857348e8a2cf06d266ebb2ccd7e896ad66553eb63a7780eddbe1a1730a6e463116e5b2f9a3acddb210bc02cd2b18715bf5cf3a79b2e1c5fa27335eb0423997378d656ac75252a2bd381a48c811795d0f23eecbb173db24d9a36866bf6948128ee4ab67a08e8b68ecb48c647d9accaacd5a54f940f61c592f01b44e6412dc37c90cf28977e2ab59bda3cebda05dbd6cc4c3c8439471c429ea2f74c5a70b508462fc5fad890b160324f2c83ccff96f00d98f999c47c9e09bbc597ea6b7d6e847033efcbab2a6e5544bf3b19b7f3aede998e0fde9a3f859abf51c9b29650f709db9db39d5ae1d98e8f1be9e34556ea38bc161fa3cead6d604995a3ee7eb9cedf74bbbab88bc47a7ae481107c9c20699325fa708c9c87dfe05c386d48e032b04e99a0898061baeefcd9c78361c1446b30f6b3492dd55dc5dd11d6713b72eac8ff9d6d3518a16c65085787542f52a085a1c828f94ba94c7eafb474a1b9470c146040ea8f89c7f4cbdc36d3399e517596edbdafb2a1ec7436cc07cd17ffbfe7d6f22f37ebf520e4c897c7b8e77d628fd6d57bbdc5b2e8b6dc9a6f8325950aee411df274dba1fb5e73d8dfe315e8920c2fe7f4afe0d0000ffffa963854942030000",
-		"3ac9156724c363041ae471794ac21590": "1f8b08000000000000ff6c93cd92b2381486f75cc5eca7a654147f16dfe2e4078c98d8a141c41dd00e6883282807b8faa94fa77a339355ea799fa4ea4de5fcf57b11ee08f507e59e2f6c41c1e72f6a4821a8cb2885749e010a0299d0cab7ac45dc08d59773b338cd47307dd63eb824cbeef9f765f7a135830b19a46ed0a03a627bad1d8e9b7d30705f12eec024e09448579b761387c7362dad5be4f35012fdca48275560da4fc18fbd1185d6253ea84b32f0a324e9fb702e7532dd5ce5d92a4e8efd489daed896aa4d7c9e4a52bd1ce8a41f99fc69fcbf08271bc7bd64d04b16e08e895e3212db381ede4cfc30433a554707d8904ced09443e147b5f7a12f9bb9ae0786351d8dd8ee5aa4fca22975e8436bcb235c7896f44e12cf3ae9b3c29d52d35552bf51829be842d470f8fe1be97de12d7ef0b19ef8e6f46ad362dd3ded896fb59144e3071826764ae1ed2fe468ed1daad8e62b88c29e848fcbb67a053a633e0c9bcbbb64e33ee63d7330a3ed461bf7ddc83bcaa0eabc176b79b6439bbcf43e73b3283623719161760e2e063c3ce9feda44d6adcc5263d3cd775b15006ddc8f3f486e6e8ae94e40db22c627b6ffc017a3d22a01964279080bf5ffe8b232723d45402a08b2fef6210aa51549075416b4daafa9962dc264b02f99712cbd09b4cbf3ec52ad879b1f9b92ee7bbaf7ba091663fb5b80128d827007a5ae4a070d95ccbeb82c4d69ff1ad7d0443f578e8bfb176a78586d9345e04b3e9be68e090c31982d9a15f181f10826d8d1767ac8f93b0abe78a5bbaefe538b7bafbeae22e71a418fefa65bc7e3e57ecbfd3f04f000000ffff979595cf2a030000",
-		"40477c442328866ae2a5996bbf14682b": "1f8b08000000000000ff6c934d73b3380c80effc8abd67769ad292b647198c718a7903980f730bd09810c8574b4cfceb7708a79db73e691e3dd68c46d2bfd34398d0e01f1b479cbad4068e1fd46094daa9b66dd8ad24288a40d208fc2f6a1e5feab7fd7b206079b006110f75a56c29e8e7a9a0ba5d625054d52df60d060702cf09460db3d3948d58438464902290dc3e044d49babe7c590fdb0c8f6e0bc99cabb893064db947c4a87a771066a785f93e120ef92c9c38261ff742d3a1c89ba6ccd177115b6d692e474f433d3b8cbbc7e8dba8cca0f1fb5f65e53555c05a50ac8567e6c8fb146713d30f769f98f1802d3259f8adec5038691812acd669a23167083fdab311fb0c4df77b9b15b7aab7ce82e38ca1f09133d0c882c474078a8bbbc8ac769b076da971c150357f6e5858beac8f6c6f755fc4fda9c8d8f97d702b39de31b424c624c1c8b29a8c5a9878f85d4605438ca0fb85c4ecf5032426b63dc70628ecc1920282cba9bf98e6f3e8f0588c1fc3225f8551cd6fb8f413bedc26deeec5faa1f83ccaa6ac446787eb36f0de1ac3b4fe44d7be382eccfaa9be36fa2a2bd97b815a0c718bf6595b727465f03ab5533b0aa327154e3b80ceed3c0dc38b133ccf575e91c42e0a2b07a6855102a130f14061e528e1a4d19243e83d2148144c85349ce702e12ba6329469b761e951036d65e61f164d79f15efbcb1e5011adc52abfe4474db6e37e73b2e4ff36d200459d48818a804a727b769a7848de3741753a7b92d1ac14ab988d9b3fe9f96db434f157c7b7c8fbb4de6d8a7e365f673f3130da2d76225ded9fd6f9767beaf8adf66f5dfc7338f168150d03d1e9ca789c0d0e9cbf4fe9bf000000ffff25e62de567030000",
-		"42aafaaf22a37ea1d401ad4bd740803f": "1f8b08000000000000ff64cf414f833018c6f13b9f62f7c558740c38b6e5ddbb3a0a14c60cbb41520b56652432029fde48bcf99c9efc6fbf87df3140916cb25c5ce8193627a8d6ea4881476a804a2691cd031672175203c8f9df9fe0c8904cf5241855ca5c250f675692973abf7e9d9576da288897a7e5356b0ed69c6e95e7b95d136cf54c749bd384539a2f6fbdba63a44bd77ea451e1f5d692fde5d1ecf478f79dd12fe6216b387fef7a30836e6a5e83e8ec902eed2d2e3f478242555ee21ff6a972b7cf26a8e2f03b0c9c150049f41ff5130000ffff4d8e3f1ff1000000",
-		"43559e1a234e5aebf47050ad21b389f4": "1f8b08000000000000ff6453cb92aa4810ddf315b33726446c6feb6216590fca520b1a28d07227b417047c74b798c0d74f8813338ba955c5c98c3c8f88f3e7f3112ea4f707e5a196aea4a0f9805a4a4afa51520ac728079404721982d7e7b7fb6d39fb5ea71abef4859951f889487323d7d7bdec4b9b034a3c68beb114540226312785a249a25ade4348722f21906b5a79452aea733a5d35872d6fdd12e2d72cd32cf18af44484959dddc638756f9c79eb32885e0b574d9de4cd6c27988ab831cee2aec20cddc0b0240818c75969a2597d14eeddca445b6fcede23d57074d1ee14834eb1187d263bc5c8c145bb7f61f25f4c89aaa53dac9e641601a3a14eb40a15f21783e4786366dbdef6e745979eeb4285065d18664b8e136db66f79785915e9d9bb5999e33d541820cf878535c7fb20ed3f657ca7483ce404adf28dc39bfdae28d21df9d947b3d24a1d1bb71a34c9b3afa22afd8f401292e7fffc1521e8518088f468323fdadd488f94d5467dafe0d39e24ef960e96a9a8e8c5f58b4724a6df55bdec26e3ddb46a26ac2e61f16ee3c99eb971de293e1f3524bc56fdaf75188fe9f1f7d5b1d13af4e03fc358067302bfe79c80a27030b87ce611da3521837fb9464348102f01a5a0f4474010bb0415b114cc9ffe3e397232c6c055a09e8770fd3ae0132ab9f47f54797c2f1ffb6c67cf2e6171e1f3c9bb771ad123b5b02ebc8bbb4f74fb26c6753b577015947e8948bd2d0635760e8ac75406cc46db54a391d7463f17f3dda4ac6f3fc082c9adec3af44e2c5944a739779b9933429907d347537c6cfceb21e1756ad3a31699ba399bcd61e5df117e71c48d338d036b5c99bfaca116dc63ffafcadf010000ffff779d601347030000",
-		"4756ddb5e4159174bcab6d6a513464ae": "1f8b08000000000000ffbccf31aac3300c06e0dda7d0059e1fbc31db2374e8d274c90154fb6f10d8725054283d7d71baf40419857ee9e3bfb48c69de86407452be150ce4f640201a8b407d9acf19ea7217580f118db03e26760c9438c17cfb4d1c9b2d7f114fae6b414cadfef44d5c51f7abc9165679b14b532eb38a7ffda5b45b81e80ad831e40a5820facf55f418913b157a2ac38eaad93e5878070000ffff703512f3e8010000",
-		"48f16a8cd7db11da7b6227ab07d93149": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"4d2ae5312bd6eb19177acf35a2c669d4": "1f8b08000000000000ff6c934d73b3380c80effc8abd67769ad292b647198c718a7903980f730bd09810c8574b4cfceb7708a79db73e691e3dd68c46d2bfd34398d0e01f1b479cbad4068e1fd46094daa9b66dd8ad24288a40d208fc2f6a1e5feab7fd7b206079b006110f75a56c29e8e7a9a0ba5d625054d52df60d060702cf09460db3d3948d58438464902290dc3e044d49babe7c590fdb0c8f6e0bc99cabb893064db947c4a87a771066a785f93e120ef92c9c38261ff742d3a1c89ba6ccd177115b6d692e474f433d3b8cbbc7e8dba8cca0f1fb5f65e53555c05a50ac8567e6c8fb146713d30f769f98f1802d3259f8adec5038691812acd669a23167083fdab311fb0c4df77b9b15b7aab7ce82e38ca1f09133d0c882c474078a8bbbc8ac769b076da971c150357f6e5858beac8f6c6f755fc4fda9c8d8f97d702b39de31b424c624c1c8b29a8c5a9878f85d4605438ca0fb85c4ecf5032426b63dc70628ecc1920282cba9bf98e6f3e8f0588c1fc3225f8551cd6fb8f413bedc26deeec5faa1f83ccaa6ac446787eb36f0de1ac3b4fe44d7be382eccfaa9be36fa2a2bd97b815a0c718bf6595b727465f03ab5533b0aa327154e3b80ceed3c0dc38b133ccf575e91c42e0a2b07a6855102a130f14061e528e1a4d19243e83d2148144c85349ce702e12ba6329469b761e951036d65e61f164d79f15efbcb1e5011adc52abfe4474db6e37e73b2e4ff36d200459d48818a804a727b769a7848de3741753a7b92d1ac14ab988d9b3fe9f96db434f157c7b7c8fbb4de6d8a7e365f673f3130da2d76225ded9fd6f9767beaf8adf66f5dfc7338f168150d03d1e9ca789c0d0e9cbf4fe9bf000000ffff25e62de567030000",
-		"4f5ce8404764dea55a584cffb76e56ed": "1f8b08000000000000ff6c534b93aa3818ddf32b666f4d09b4b4bafcf20082060da0083b83368f06b5550cf0eba7d4a9995b756f96e751c93975f2f7f320ea30ff2f4c8388d90c43445fa8c619c38b0a6338e01c144390339184cb696334d960f30ea461f4299ecc082c509eff14dfd56a2d04810a0d5cdc94864542b642385479dbcd40238ea803c68662c417c2b46ffb387d648d7549221a73245e1ceab8bf31ed96d1b4d792d8aaf63bbf92034d39cadee6820bf9e19d7869d547c7be674e572f1bff21239a71747e69a0e35162d256fbb3108eb6d27b4ea0e764a35684f59ca0bdadf4e18db1ff308d3baac3037828f7b7089208ea6dc403aee83b1aa3ea4292b8bba4cdbc974d5df0205136bc38972a23d29278920727af908d7fc94cffc1c54c91b77949555a49a7aee54974ee0087f7259cdbf5764877ac13cebcd7d281b5e9ae28e40eddd2d0aaa4a9ab388208e5d9bf8d33f47ffb1c21e56380107be9bcd81e4e534d91d24d6ee3604dcdcd6789d6de94dc77f1f871e94ce6d1c068ca8f849f96fe4e36ad55b2afd5fccebc7bd17db6a3c9d1ef3ded6b8d8b334bbccd281ccebaaec20156cfa7ba6286e06b4611708c26a0c83377a04720dc3102a680c0f5a9d3dc90538f4099636287e3535cb6d6807ee60f45b1adeb5597babb83c12a4358f635654779b6cc4bf0cba8b4d7aa729f0141369414ca45d50d0d736f8b31960112c97deaad630b4fda6be7ca3eed3e17a5d74a276f6698b9df95e6d64ab7a632d7c9d2bb2d26d9848703db17c79157cb11b9d2d95df6f8ae6cedb57cea93df7fc33f010000ffff07730dc42a030000",
-		"53e26f1c6755797b0decf0a14643c4a1": "1f8b08000000000000ff6453cb92aa4810ddf315b33726446c6feb6216590fca520b1a28d07227b417047c74b798c0d74f8813338ba955c5c98c3c8f88f3e7f3112ea4f707e5a196aea4a0f9805a4a4afa51520ac728079404721982d7e7b7fb6d39fb5ea71abef4859951f889487323d7d7bdec4b9b034a3c68beb114540226312785a249a25ade4348722f21906b5a79452aea733a5d35872d6fdd12e2d72cd32cf18af44484959dddc638756f9c79eb32885e0b574d9de4cd6c27988ab831cee2aec20cddc0b0240818c75969a2597d14eeddca445b6fcede23d57074d1ee14834eb1187d263bc5c8c145bb7f61f25f4c89aaa53dac9e641601a3a14eb40a15f21783e4786366dbdef6e745979eeb4285065d18664b8e136db66f79785915e9d9bb5999e33d541820cf878535c7fb20ed3f657ca7483ce404adf28dc39bfdae28d21df9d947b3d24a1d1bb71a34c9b3afa22afd8f401292e7fffc1521e8518088f468323fdadd488f94d5467dafe0d39e24ef960e96a9a8e8c5f58b4724a6df55bdec26e3ddb46a26ac2e61f16ee3c99eb971de293e1f3524bc56fdaf75188fe9f1f7d5b1d13af4e03fc358067302bfe79c80a27030b87ce611da3521837fb9464348102f01a5a0f4474010bb0415b114cc9ffe3e397232c6c055a09e8770fd3ae0132ab9f47f54797c2f1ffb6c67cf2e6171e1f3c9bb771ad123b5b02ebc8bbb4f74fb26c6753b577015947e8948bd2d0635760e8ac75406cc46db54a391d7463f17f3dda4ac6f3fc082c9adec3af44e2c5944a739779b9933429907d347537c6cfceb21e1756ad3a31699ba399bcd61e5df117e71c48d338d036b5c99bfaca116dc63ffafcadf010000ffff779d601347030000",
-		"55a379be0206a813681e1fc6587b32f8": "1f8b08000000000000ff74934b73ab381085f7fc8ad9df9a8a4d6c5f7bd9124256b0c0c23c2c7680631c1e31f1ab815f3f159c9acdccd54a75fa9cc5d75de7efef471817ee5f94f981b00585808daa2185a04949291466012808144271bb68fcdbcc7217c5c535bdbdeb2dcedb001c52145fa7aaf4b64a59501253aa2b1a54692b528a337c8bc2810592300ed39051221d65dad7344e1e79336f75c06249d438239d7443d3be0b96f4868ee765ba77cb6c608924f9337c922a7b7dfb94bb79fdceed5bcebb7ad3b88f2c60474926a3073a191f783718da64f7ff37c3bb8d935e5ad04b2b44cf12bdb4486ae364786a62d48c6f51dad78e0ef0460a3722a003a8a340fa12d9134f306c2d1d776dd2acfaaca94fd2d768c3385b1b0ca7818e6785fff976ca1ab7cd4df721fd25ae9f618b75092671d4cbddfc913779b769a2998ea798f1f0aecdd5cd9076850cf5da39276228271494163f7f0b546ea902d8a4bf6de65eca3b273dee8fa5b737ebfa017a21a6f1c59868df5d9068fa914ef81445b3be71ebc1d8d182fae5debd5e226f7739ff0eefbfbf9caa2d0fdd6adbbf2c79d49c1c8fd40325466d81f7cdbe564b02c72523202949e189e04f6a42465ee1a02644856b40c629bd7250a14d505243027e9fe5c0909117545402a08363b82454a13843f17ebcb98750a643e60f79e21dbaf9deddde3f77b9cdc138e6b309562d2661156645850e8c619f5086eb9988731ed579f987536b611b3f06a4c5bfcb6380c2f211d00751a47d95392a766ebfe42e94434bb79b9766b6b9f3c5109c647b35d2b612dec7a5f2f74b2ad6b32f9faf5eafbb8247c1461fe2744597bf9c7db1d5efc339931fbb5af59b5530eca6c65825e65affadd73f010000ffff9fb6aaec7b030000",
-		"56236238c43d93a76fdfb79e14f73cfe": "1f8b08000000000000ff64cfc15283301485e13d4fd17dc6a9b5698bcb9bcb25019a1452405c629d2262b50147b04fefd871e7599df977dfcdef04c9c8cc521b9590d32ca1c76bf574241534045a6829be9ddc6b7e0f0d49c4bf3f9212f276acc74840963568e3aa5b1f26b6bd70ed82839738db7d72e30c3ed405b3d3735cf7859fc774777ab16010200babd7f376ad731c0c17cba0f3fbe551b5ed867187ad970a77bc2c2a952d16e369ff74b6735684e98a951bb11bb43f5f85547eb9a18fdfc0e2f4fec13a9b28dce5de154026f88ffa090000fffff036f832f1000000",
-		"5805459bcbf755934035c505de00f04b": "1f8b08000000000000ff6c934d73b3380c80effc8abd67769ad292b647198c718a7903980f730bd09810c8574b4cfceb7708a79db73e691e3dd68c46d2bfd34398d0e01f1b479cbad4068e1fd46094daa9b66dd8ad24288a40d208fc2f6a1e5feab7fd7b206079b006110f75a56c29e8e7a9a0ba5d625054d52df60d060702cf09460db3d3948d58438464902290dc3e044d49babe7c590fdb0c8f6e0bc99cabb893064db947c4a87a771066a785f93e120ef92c9c38261ff742d3a1c89ba6ccd177115b6d692e474f433d3b8cbbc7e8dba8cca0f1fb5f65e53555c05a50ac8567e6c8fb146713d30f769f98f1802d3259f8adec5038691812acd669a23167083fdab311fb0c4df77b9b15b7aab7ce82e38ca1f09133d0c882c474078a8bbbc8ac769b076da971c150357f6e5858beac8f6c6f755fc4fda9c8d8f97d702b39de31b424c624c1c8b29a8c5a9878f85d4605438ca0fb85c4ecf5032426b63dc70628ecc1920282cba9bf98e6f3e8f0588c1fc3225f8551cd6fb8f413bedc26deeec5faa1f83ccaa6ac446787eb36f0de1ac3b4fe44d7be382eccfaa9be36fa2a2bd97b815a0c718bf6595b727465f03ab5533b0aa327154e3b80ceed3c0dc38b133ccf575e91c42e0a2b07a6855102a130f14061e528e1a4d19243e83d2148144c85349ce702e12ba6329469b761e951036d65e61f164d79f15efbcb1e5011adc52abfe4474db6e37e73b2e4ff36d200459d48818a804a727b769a7848de3741753a7b92d1ac14ab988d9b3fe9f96db434f157c7b7c8fbb4de6d8a7e365f673f3130da2d76225ded9fd6f9767beaf8adf66f5dfc7338f168150d03d1e9ca789c0d0e9cbf4fe9bf000000ffff25e62de567030000",
-		"58a0bb6b59f040b7d1650b5addbcc67f": "1f8b08000000000000ff6453cb72ea3814dcfb2b667f6b0a839ddc78318ba32732c860f981ed1d3644c68f0408209baf9f22d4cc546ab453eb5475ab4ff79f8f832817c11f98aa58308121a6dfa82585c019c118f44a831108b45010b8bf8f719af9ed61b62ba35dc95c672aa9c13a178bcf42dc1b9b8211a62474694968394c138a6a89d3540ef40e0ae92045a063dc0675c9bbbe74fceb764307d640f27cab629206757940dcaa7a76cd67dd3d9fbd0d8c40f41cf88cf12c75f3cdd4943cb9e633ef22d5a7e19093340c091d985dce83268f5e3a6bcfd9a5e243b7ec835b19c39e197b9404464912b3226294046d99b1ef4f4cfc8b49d60ef80ebef564cc63e8d2582a6968f8cd22a839927c331c8bde1bcbbeaba5ca0d7b2a9853338df38dabd5875f5b651f1cab597093ca36dcfc2351ddaa5e75559f8e41f4d2fd54890a892447e38947d2f52cd09463fcbc80a173b00520df1dc857679ff3f5fa753db9b40b273ea9fd647e3ca9ba9830d70ed44a833f56b3da1a45dd3a2b6c67713dbc20f276fe58bcf99950d37d6abc6b11d174798a98731a8645a30b6d749118a273922a7b0de17c62210809e88d96c87eac73e787e146a29063fcc5214c18321221adcf485386c28a4003f2e1db5c4904ef6fd48206403e86a7c94e98b09578010237def5d2f51138fcbc7072e21c7ea16c19649fd9c73b3f8495de6df7f5bd93fee9dd92f83b483b1a8607893166dc1b0b9e8e4526a6cbfe6716b4aeff33378605d2fa64d56db35a878f6f04732088c201764dc87a266e0cec5732d96b5389dbb04d0ab77bdd9b1647d3f3d7af6527d3ada785b692fe6042af0d8eeab7460e0e9a4c2e5eaa48dffdf9368b62e6d59b77732133ffe6fe657dd78706e4ff95fa3b0000ffff8ef2aa9e6f030000",
-		"58d942e2ac61d6f0af9e958eadfc4eb4": "1f8b08000000000000ff6c934d73b3380c80effc8abd67769ad292b647198c718a7903980f730bd09810c8574b4cfceb7708a79db73e691e3dd68c46d2bfd34398d0e01f1b479cbad4068e1fd46094daa9b66dd8ad24288a40d208fc2f6a1e5feab7fd7b206079b006110f75a56c29e8e7a9a0ba5d625054d52df60d060702cf09460db3d3948d58438464902290dc3e044d49babe7c590fdb0c8f6e0bc99cabb893064db947c4a87a771066a785f93e120ef92c9c38261ff742d3a1c89ba6ccd177115b6d692e474f433d3b8cbbc7e8dba8cca0f1fb5f65e53555c05a50ac8567e6c8fb146713d30f769f98f1802d3259f8adec5038691812acd669a23167083fdab311fb0c4df77b9b15b7aab7ce82e38ca1f09133d0c882c474078a8bbbc8ac769b076da971c150357f6e5858beac8f6c6f755fc4fda9c8d8f97d702b39de31b424c624c1c8b29a8c5a9878f85d4605438ca0fb85c4ecf5032426b63dc70628ecc1920282cba9bf98e6f3e8f0588c1fc3225f8551cd6fb8f413bedc26deeec5faa1f83ccaa6ac446787eb36f0de1ac3b4fe44d7be382eccfaa9be36fa2a2bd97b815a0c718bf6595b727465f03ab5533b0aa327154e3b80ceed3c0dc38b133ccf575e91c42e0a2b07a6855102a130f14061e528e1a4d19243e83d2148144c85349ce702e12ba6329469b761e951036d65e61f164d79f15efbcb1e5011adc52abfe4474db6e37e73b2e4ff36d200459d48818a804a727b769a7848de3741753a7b92d1ac14ab988d9b3fe9f96db434f157c7b7c8fbb4de6d8a7e365f673f3130da2d76225ded9fd6f9767beaf8adf66f5dfc7338f168150d03d1e9ca789c0d0e9cbf4fe9bf000000ffff25e62de567030000",
-		"5cb60b0be2ef7cbf1a2bd352f6b8518c": "1f8b08000000000000ff6c93cf77ba3814c5f7fc15b3ef9953a4a5adcb975f081a3418a07127688320164b25c05f3f473a6716f36d5639f77df24e92fbeedff785a8e7877f611a499ff918249d548bfb3e8e25c6f071d4607c04da1751b9c65a25d16919ae60c977af7af17292b0445a5f8baa5c6f842050a2918bd65858289208e1511324f1482547d483594c31e24be1b0769feebabc761b2569ca91986aa8e761ecb09b4f7783a552b7dcbf876536d21d47f9cfe1828bec29b8f0ad7b3e7aec3bf7faf3aa0ebb4cd29ca3cf89819e4be5d09bf53b084766ec8113183889cd9af8032768cf8c3dfe68fe7f9ac517bcc723044887090225e19c481e71437f9ee653d31095f6cdae9e0f597d2e78a40c83a9b6a066262d953eebe81214591d36b913763caa0c3313b0a43deaf23aef5775f2acd299c9bcf8a69cf9378f8cf1f4c4108bf661a9b6eeef20ab0c356ab1fcdcf963696310caff774f40e44468a016a8eaedf5b321dd139b5d08dbf863670ef2588630b4eead6de7d7f609cf51528fdbee80578dd9767de0329d202ee241bad6312df490af6787a04a57439ec3fc58a7cbf09b7bad21f76b46f606c4e2118120a0bd9a23fbeec2211062b2d5c2b8f540c40c198e91d65f485386444e40c2e6feb18b8823f878a34802b937e2b89a1a102d4e08636239d1aabf7c1c5a87091b43b47928ddb76b5ddcca43637f258fc11efae7f54b248af27f93a8431f0862169c287c6d36ce2278f86802f711bd540d3cd963ab5ab6efaf57ec0ede7656e461bc355ea5b10fdd9a75af387e975f07fb7ab1d8373f9ef039cb59c69cb6f2dedf6bf3f0b82a3ab99d5b535c6848fe8cd03f010000ffff17359eef5f030000",
-		"5d24263f77728712a6c29557bb987ef4": "1f8b08000000000000ff6c93c972ab381486f73c45ef535dc1e3b5974713c88e88850558ec626c2b66723c60014fdf1572375df76aa5facea753251dfdff7e2f443d1efc8369a838e318141da82338c7718f319cc6062c4760b83c4dbcf909bf5d5139bf9ce6a23eba85b9e5b046c65c3f8bfc7d2325811c8d85bc5b074b4d62293d6a5771d4532510f56014518cc45a8ed9fd23499f5935fbd28a2602c9a1865a114463d6709a768e4e66f9c72ec8f73d4d05ca7e0e7f0ab99fac6ab19d95478f3d32af2ddfaae0b957f424903b38d08ae4e0b5bda3c7b4f9bb0c4766dd4e10e80489ec3be19d20e88359b7ff617c60ce3714be6e710f2b648218815650c64a84c2d29feb716abf884edaafb45a76fbaafc14a1b60c869aef503b523a999ab05e7deeabe02b1b074f111696d94158d3163db32a6bdfaa78aa9391dd7b51a3c7cb870817d6979a38b19484b6a9bbf7835c6f677f975961a9d5fefa92f23e773148cd7fef89033223d200addfcdc8a5bbb0db097ed835254b17f08a7f4dd56639b96fcae73cf68ecdea6d3a43d45d93d3a14955096c7b75ce8b55df258dbe1c3b51ed02534f45bc3e2d0e5170f3f03a7808ef6e89d1240edd0d48ff15812460bceaf7340ece4aca61be18df3d901143566064cc0d19ca90cc0828d87c3fae1f0a04a705450ac87723818b9f06c4c833c268f6e28e46b2bd050f3f688bd9c42f33f7657e58d948a3659f2bbcad51b90dc306abffff48074cc0812006670aee797769e5586a8b660bfafa5efaf35f74776edbeea55b88a49ed4fe55b619dbe70bccc9b939235d3b73b4a1d3b83e4fd4832b1a5dd6b7515a2c19851bbb1ea6799a159d7686d8d080fc19a5ff020000ffff881eb19267030000",
-		"5d5cb12d5fb236344d67c6f9d7a2c6fc": "1f8b08000000000000ff6c93cf77ba3814c5f7fc15b3ef9953a4a5adcb975f081a3418a07127688320164b25c05f3f473a6716f36d5639f77df24e92fbeedff785a8e7877f611a499ff918249d548bfb3e8e25c6f071d4607c04da1751b9c65a25d16919ae60c977af7af17292b0445a5f8baa5c6f842050a2918bd65858289208e1511324f1482547d483594c31e24be1b0769feebabc761b2569ca91986aa8e761ecb09b4f7783a552b7dcbf876536d21d47f9cfe1828bec29b8f0ad7b3e7aec3bf7faf3aa0ebb4cd29ca3cf89819e4be5d09bf53b084766ec8113183889cd9af8032768cf8c3dfe68fe7f9ac517bcc723044887090225e19c481e71437f9ee653d31095f6cdae9e0f597d2e78a40c83a9b6a066262d953eebe81214591d36b913763caa0c3313b0a43deaf23aef5775f2acd299c9bcf8a69cf9378f8cf1f4c4108bf661a9b6eeef20ab0c356ab1fcdcf963696310caff774f40e44468a016a8eaedf5b321dd139b5d08dbf863670ef2588630b4eead6de7d7f609cf51528fdbee80578dd9767de0329d202ee241bad6312df490af6787a04a57439ec3fc58a7cbf09b7bad21f76b46f606c4e2118120a0bd9a23fbeec2211062b2d5c2b8f540c40c198e91d65f485386444e40c2e6feb18b8823f878a34802b937e2b89a1a102d4e08636239d1aabf7c1c5a87091b43b47928ddb76b5ddcca43637f258fc11efae7f54b248af27f93a8431f0862169c287c6d36ce2278f86802f711bd540d3cd963ab5ab6efaf57ec0ede7656e461bc355ea5b10fdd9a75af387e975f07fb7ab1d8373f9ef039cb59c69cb6f2dedf6bf3f0b82a3ab99d5b535c6848fe8cd03f010000ffff17359eef5f030000",
-		"65c550a333766580098aa336b78b041e": "1f8b08000000000000ff64934b93aa3814c7f77c8ad9774d89af915ecce2e401c44ba089016fdc09da41203eda47c04f3fa576f52c6e56a9dff9579d5f7292bf1f0bd180c57f612a24f31906499fd4e18c6146308672adc132049a0988b984fbb0f98a653c993695f9aa48b057166bc57e1d56ec5ebb142cb36b4923874313c030a3a8e238cf7947ef20908e73045ae226ae8aa035c5787e5d2f69e7d790bd6aa524795c153b1438a5f1af6ad4ded5c8eb7c028b57e020f1289fa8e5d016417655a3f70b17a5f55345f23425d44e6bb598b6dbc0bf3865d0b591896f8584ad6fdd9e13e839c96c4258cf095afbd6bdbf18fb613c3877f80ef34733078192d0e6920b6ee9ab03a3f648d4b23baecc7b5f98b6e242591f9eb590daa154cb8916fb795598f8e894a3f8c60558a29f8188dacdad34a22d4dde73e1dac07e6b77e27fdebff41ff6ce4b1fad38e201ea4fc1824fde41d300e3efbda521b80c50329bcff9857b66e40a4dafe13da933d644cecc1065b36537ad7b8f6e1b4de68b743cdb20635b793b2dff314975c261efa61487a6f216b7f1f1eaaafde53490214e82da9112451c268f516e88a56860d3c78c434d803f2e29141cc1a747a106e0f8fccc319b361cff02969cb9536f67f56d55fe76a77b51eda9379cc5bb37bcc5b6ade2bdbfca653709066de77138fc1c0b01c7e06ae034c30e4bf147b8b894a4e5453e6724fe4c4ce85edf2cfe1a1c44b24bcbd1f9f745a849a4669966ba203b89e5069ba5fa5c41e639ebab1e5ec8c78c1e23fde635c7cd3841d22c3e70c4ff759eaf9dc6e4cf1ff05f000000ffff7c25d36a1e030000",
-		"68e2c43a6bd704be87766dad25e26996": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefe7b375431b050961ec0240f64297122d74888d3a394850b94d1d6b33fbd734d9897750c4447e56bc6486e0f04a2290bd4e7e594a02e3781f510d104eb6364c7489123ccd7ffc8039e5c5ac6106bf9ebcba1a16c07b3dd59e5c52e55392f2afef592e2c604a20b60bb6b0db04074484574778cbb127a2ac17e50ae7e9cf00e0000ffff1e34df7fd4010000",
-		"6bb2ab1d2c3162ee0d9f730c944a58c0": "1f8b08000000000000ff64cfc152c2301485e17d9f823de34cb102edf226dcdea634b185a0d65d1d693228162410dba77764dc715667fedd77f7378624d4a85c8927d0385a627dad8114948141904c12eb8fb4960f091824ceffbfc78c51e81b2f1854956916a8caf0bd6efd71bf4de265d03b3e8089da97e7947454b8c990d9af7d5126f06857a038803ef76b5de832770e6b56d878e8263ce7e3a9b997691a9cb6757c61d94140bff950e3a87a3de59bf9ecf37cd8758db1b1430676dab5f89dccde1ce5f3e612fef89d0dae00548b5bd46f000000ffff1c9d9553f1000000",
-		"6e11fe6062a36ecb9964c2c2c76489ee": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"6ef21145fdaefb158274a63bae6a8757": "1f8b08000000000000ff64534d73b24818bcf32bf6fed6960630d1c37b78e6031c75489061f8b801c64110881f64905fbfa56e65b776e7d85d5dd34f77f59ff787a8cbbc3f30dd0ae6300c823e50833386238231ec7f29d00c81627e3a7666575c87bcb0fba809f3137a5f0d15ac9152a7b2aede3f7c9f40852edcbf6803fb0991beef52bd92e1480547d485979062c4d7bee95cb228fd2e9ad9572268c491ffe0d0c0bdd0747a46d39b9144b32a8bbd2a1f69c8117b8a4bbe49e3b2cc6374498359959bd3c1ad2041ca9308b8a0f1f652985eb969a46d24d18bceddb04fccc595233b2682694e98f604d8bc629a3b5d4c047f60bcfac17444e88643ed1a0f4b25c752f2818eb07dfea204aebd32778f4d6eadfa2ca2835341f8e40a41a457e607e4168dd31b89791c13733e2c09643f16cdc52d75e52d8d59ff9f537424402055fc1d2643c63fc97284b48701c44649ba3567eddede8f97a4da07c1ab6cafceacc9deae732656594db6e661739c4861b5867538d9743a21c34bffd6469ff56b9eacf3a90ca6ad375aa2fe187869cde7c5acb3dd88335770b0ef41ef88a668a27d6a80664e476077b7bf0c42fabc559d91a20ef20b027ec2d63a41c80f97a099269010b99d0af09713044c1b40e0fc1473ba22705018ba497e4ed35a79c3e9763561617f2b791be5ab33b386ae5eb265d476c54ce0ac1da1351ee22da58cc0a72abdefa2d91e8b46deee1dffbb62a5763f1c87cec5f8e406dc5e1808388669019cfa98d178dd946fbb738287b23f7e90726155af57bcfe45e1bc794916e721db673a5fed26733880da888fd038a3afe9b2514354bd8f20bf82729063d114d482b9a7021c1064bd53f5fbb7f1980ef5c8ffe7f457000000ffff13e78bde6b030000",
-		"6fc35031122d492a920b38caadee00b4": "1f8b08000000000000ff6c93cd92aa381886f75cc5ecbba6b4a139632f66f1258418e1a34523083bc01608a0c7636380ab9f527b663327abb79ebca9ca939f3fef83302e823f28db48e10a0a923da88142504f520ac54b095a1028c50604f9b05eb334dba667966c4713567db52a342d13e19d5331a939032d743131df406838bcee18a99046110e6c820d2983884029691354396fbbdc5af559cc0657c1ee395748270aaabc26dc283ab74fcc764accc5c025ec9f85b364fc7d4c27d1a7fbaacaf7e49a6e6d959bf361e940f6eca0646650197ef7dba25e5645800a342a7845a71cef39beb3e9c1c67f99112b72c1f0aa699838511872a657d16e6212097ba851825e68bad72c4e6f4567ff4c248b91844fed01036367babd60e998c4b6caf681ca2716205c9f8b0764058fda42b12392f983c180f137eb736b7532b0b6db4fee7e157c68fd2eb8e592a4489093f1c2b7f8f60e25e3947e67cd96301740b8a5ae93bb9639ef8c0324a549866e89d2f18ee1e633d0d6cff7af2ff45eadf9c5b5dbf06c4f3ee18b6d66ddea0fea9f6e4a953f60b67e19bd93be19f555cc148bd8429a4a4ae223bcddb77a703423331ddeef7b593a80f7935f6e90c071c14001207d681e0ca1c306a9070223ffe590797f9d90ff3267108abddcaedde670b076f53a729af8aba9ec5e9db296219cffd3320820857909c87654840e261f619d7c621da67268378d9a5933a5929bd6075aec6d887bb7fb95d5cba62f45e936fb99f1a3afe5d699b7d362fda275eb1fcdb74200b998593ad68bcb5ba9e6c951c3dfc6e3e5b3c0f9ff6ff8270000ffff2377342c2a030000",
-		"71d88182ba0750f4f5ac594e37e18fef": "1f8b08000000000000ff6c93cf77ba3814c5f7fc15b3ef9953a4a5adcb975f081a3418a07127688320164b25c05f3f473a6716f36d5639f77df24e92fbeedff785a8e7877f611a499ff918249d548bfb3e8e25c6f071d4607c04da1751b9c65a25d16919ae60c977af7af17292b0445a5f8baa5c6f842050a2918bd65858289208e1511324f1482547d483594c31e24be1b0769feebabc761b2569ca91986aa8e761ecb09b4f7783a552b7dcbf876536d21d47f9cfe1828bec29b8f0ad7b3e7aec3bf7faf3aa0ebb4cd29ca3cf89819e4be5d09bf53b084766ec8113183889cd9af8032768cf8c3dfe68fe7f9ac517bcc723044887090225e19c481e71437f9ee653d31095f6cdae9e0f597d2e78a40c83a9b6a066262d953eebe81214591d36b913763caa0c3313b0a43deaf23aef5775f2acd299c9bcf8a69cf9378f8cf1f4c4108bf661a9b6eeef20ab0c356ab1fcdcf963696310caff774f40e44468a016a8eaedf5b321dd139b5d08dbf863670ef2588630b4eead6de7d7f609cf51528fdbee80578dd9767de0329d202ee241bad6312df490af6787a04a57439ec3fc58a7cbf09b7bad21f76b46f606c4e2118120a0bd9a23fbeec2211062b2d5c2b8f540c40c198e91d65f485386444e40c2e6feb18b8823f878a34802b937e2b89a1a102d4e08636239d1aabf7c1c5a87091b43b47928ddb76b5ddcca43637f258fc11efae7f54b248af27f93a8431f0862169c287c6d36ce2278f86802f711bd540d3cd963ab5ab6efaf57ec0ede7656e461bc355ea5b10fdd9a75af387e975f07fb7ab1d8373f9ef039cb59c69cb6f2dedf6bf3f0b82a3ab99d5b535c6848fe8cd03f010000ffff17359eef5f030000",
-		"737d2af311f701af0291f45249f72596": "1f8b08000000000000ff6c93c972ab381486f73c45ef535dc1e3b5974713c88e88850558ec626c2b66723c60014fdf1572375df76aa5facea753251dfdff7e2f443d1efc8369a838e318141da82338c7718f319cc6062c4760b83c4dbcf909bf5d5139bf9ce6a23eba85b9e5b046c65c3f8bfc7d2325811c8d85bc5b074b4d62293d6a5771d4532510f56014518cc45a8ed9fd23499f5935fbd28a2602c9a1865a114463d6709a768e4e66f9c72ec8f73d4d05ca7e0e7f0ab99fac6ab19d95478f3d32af2ddfaae0b957f424903b38d08ae4e0b5bda3c7b4f9bb0c4766dd4e10e80489ec3be19d20e88359b7ff617c60ce3714be6e710f2b648218815650c64a84c2d29feb716abf884edaafb45a76fbaafc14a1b60c869aef503b523a999ab05e7deeabe02b1b074f111696d94158d3163db32a6bdfaa78aa9391dd7b51a3c7cb870817d6979a38b19484b6a9bbf7835c6f677f975961a9d5fefa92f23e773148cd7fef89033223d200addfcdc8a5bbb0db097ed835254b17f08a7f4dd56639b96fcae73cf68ecdea6d3a43d45d93d3a14955096c7b75ce8b55df258dbe1c3b51ed02534f45bc3e2d0e5170f3f03a7808ef6e89d1240edd0d48ff15812460bceaf7340ece4aca61be18df3d901143566064cc0d19ca90cc0828d87c3fae1f0a04a705450ac87723818b9f06c4c833c268f6e28e46b2bd050f3f688bd9c42f33f7657e58d948a3659f2bbcad51b90dc306abffff48074cc0812006670aee797769e5586a8b660bfafa5efaf35f74776edbeea55b88a49ed4fe55b619dbe70bccc9b939235d3b73b4a1d3b83e4fd4832b1a5dd6b7515a2c19851bbb1ea6799a159d7686d8d080fc19a5ff020000ffff881eb19267030000",
-		"743993710a49c934d771a72ee260764d": "1f8b08000000000000ff6c934d93aa3a1086f7fc8abb9fba25a238ba388b4e08216a70c2f0bd131c831174183f82fcfa5be2a9bb3927abaea79f2cdeeeea7f9f0f11cafc7f300942e6320c2119a8c119c3798f31c89904cd104816c0a66ddcb91ea3d167e2d993207aa0281a4f3596195b9d73d62b9380667aa7c8dae070a4308e08aa388e63de911e0224fd18810cf1d1af0a5a37c56479db26a4731544af5e193ab15f1507448db2716f9955f79935ef6808e94b3887842e1e79cf6e795a55458a2ef9a7ad0acbecbc1e762f8787ee29b818a5e557ebe6afb2f6aad2e70a345730e68e7c3cebe4c9fa813d9ecc18a0420517178d45e6c44250a29771d493902332c4c388af84e55eb6497e2f1bfb3b0b49c291187a06eab81f59ee8d91fc9125b6daa6be2a7ab2e7c81c04e87852d2b82e15b91593e5891fecfa8bbad79276f5baf1ef4611a29c234ed1a3a59f7cba004928c6bf6b4d3c3019204fd9d6635bfd58fe87f5a6ebc8f41687eb35fc18a993e129febd5814e962c222bf25de7174c6484516aa8bf8a790939073b2baeedef5be82223b85fbf76d80edc7f4d8374ae6a536642eb42333270ecc0f10de0881704026f27784dd5288212fc6170a227291e60849f983247191281d0314f0e75abc8023d8cf092800fe94c7d18e6971e47805cccdc3decde71ab3a97bb03fcec9ac7a5bb6670949647c269b7a56dbf3aeb9646ab6c93896c3672244c5d11b764fa8ce53d6fd7dd5163650fa12389cff1f1e028ec12c81138119c4077a981da9ed5d02a14c113a78e38daf6df46eae97566bc4a7b9856c53564ebb850304f770da7ce17494aeefdff37adf16b0fa1eb791b9baac50ff75bf6ea63ee55f372de5af5fc6704ec477fe3cb1ff020000ffff6600dd847f030000",
-		"74e3b10abc0b5abb7fe40574f967b73a": "1f8b08000000000000ff64cfc17282301485e13d4fe1bed3915ab46579132f213217412992ee2c8ca18ea4529590b7efd4e9ae6775e6df7d8fbf6328643ac936b284022709aa7bf5488a1834023112ccf5624b41081a05e77fdf62cc846ff75632c8734dae5b374dcd0eb381e8eba6bd31a9e61f875e4d1fde7c7799e180c76a7fea86e7e6d66e20e50045b4327d3916e96a14ecc2330e06cf42b5e09e4ef3d64b6cf5caa3211aad4195c49cf2ddd414ae3e83ffada69a5db765b8337cbd90c745167c766150d3cbf2fdeadd01982effa37e020000ffffb472366df1000000",
-		"778d34766dba30f8889fbf6165e2d2ba": "1f8b08000000000000ff6c93cf92b23814c5f73cc5ecbba61ab5b13f16b3c8cd1f881a3088d0b013c4208a62ab0478fa29e9a959cc7c59a5cefde5569273cf9faf05d4e1de1f980621671ca3908eaa2138c75b82313a140a690e487189274d8aaacd61776ccde559c129cad93b414b50ea569e2a7f2d2541150c42deb581654222291daa17d176a0a100eaa0c99662104b3965f75d9cb6796d354948630172ac4127bced943d394d7b2389ad6af7e555d9405301f9cfe152c86cb6b888a3752e1cf6c89deebcaabd360b692ee03a32a8136132a54fe3f7202a98367b41502fc856fb84f782c08e6973f8d1f8bf9a215cd1e1012d407911a02444e7281481d0f4e7699cea862471d7a4b5dd67f5b91441a2191a6b2ed593d048e20f155c1665567b4d3ef55a119c34d323b0a41db4799df7ab3afa48e289ce9ced3399da0f1168eda8912106edbc2ad958bf07d949539db8cb6bca87cac44826fc9f3d41322752216accd6c1a75db5ddeaf0553c6ed79509e18c0c625f3bb26eaff362b50a97bf0af3064d4f6e753f6d657c798392b99f1f61c4f785512c3efcd6d9ccd9e7533a31b9d83a826ee73d8473d7e475cdc05c23e9be03920429a71660be5cd82fa41c6d3530be3b486e19688141a96f509481cc090ad1faf5b16e20001d7e510811793512f83436204a1e0113666cde2ff1f1690d70b35b4d3133cdaa4bddaffd84571369b1ef9417d9d59a36c17f2751792e22400d74046517598dfbb83ae8f906d1f9b278e38f667e7d0bdb9afb7abff96e9277623f7da5b982c4cccbfd79161dcada3f0eb131e8b75970acecde43d75dd0d2895faead27fdf627ea2f638c0bf5c8ff23f477000000ffffed6d643e5f030000",
-		"77b747ac15db981b00f5917697bffdcb": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"786ac599afee4a6211e43033f30c3c2b": "1f8b08000000000000ff6c934d73b3380c80effc8abd67769ad292b647198c718a7903980f730bd09810c8574b4cfceb7708a79db73e691e3dd68c46d2bfd34398d0e01f1b479cbad4068e1fd46094daa9b66dd8ad24288a40d208fc2f6a1e5feab7fd7b206079b006110f75a56c29e8e7a9a0ba5d625054d52df60d060702cf09460db3d3948d58438464902290dc3e044d49babe7c590fdb0c8f6e0bc99cabb893064db947c4a87a771066a785f93e120ef92c9c38261ff742d3a1c89ba6ccd177115b6d692e474f433d3b8cbbc7e8dba8cca0f1fb5f65e53555c05a50ac8567e6c8fb146713d30f769f98f1802d3259f8adec5038691812acd669a23167083fdab311fb0c4df77b9b15b7aab7ce82e38ca1f09133d0c882c474078a8bbbc8ac769b076da971c150357f6e5858beac8f6c6f755fc4fda9c8d8f97d702b39de31b424c624c1c8b29a8c5a9878f85d4605438ca0fb85c4ecf5032426b63dc70628ecc1920282cba9bf98e6f3e8f0588c1fc3225f8551cd6fb8f413bedc26deeec5faa1f83ccaa6ac446787eb36f0de1ac3b4fe44d7be382eccfaa9be36fa2a2bd97b815a0c718bf6595b727465f03ab5533b0aa327154e3b80ceed3c0dc38b133ccf575e91c42e0a2b07a6855102a130f14061e528e1a4d19243e83d2148144c85349ce702e12ba6329469b761e951036d65e61f164d79f15efbcb1e5011adc52abfe4474db6e37e73b2e4ff36d200459d48818a804a727b769a7848de3741753a7b92d1ac14ab988d9b3fe9f96db434f157c7b7c8fbb4de6d8a7e365f673f3130da2d76225ded9fd6f9767beaf8adf66f5dfc7338f168150d03d1e9ca789c0d0e9cbf4fe9bf000000ffff25e62de567030000",
-		"78909379d9b7903a7cb74ee327869a34": "1f8b08000000000000ff8c92516f2a291886af0fbf82706e7b14066686d9c40bad9aa6ed36adad59ad996c18f8505a9ca13863b4bf7ea375d39b4df6dcf1c1fb3c8437b4aef58007983c3a8804a15568625ba24ddb063cc042a442a0106263bfa6344568e59b75893cecc19f400355b726c8b8789a7cb3de11649d875a6dcfe2e020f67cb32628c249feb756dec3296c95df0142ab6d63a044ed319cf311bc3a12fc139f17b889d8b808bafdcaf5cebb2552c69cefdb34bbb6671afd0eb1e7ea1662adfc1f19a58c11f4d135b1dbe2014ed05e796754dbc41d1ee015c21863420f9452cb54aea8d45a0b09a9e1d44a2a53aa2dd336915c29ae2a43aefe25a0e0559158661495b648d2ca00080105833ccf24975481e49ce6df44c5a496699e024fa480bca88a84514a935471c67369a1928c52937d1332cd26c9a818a6d3a14ca66336a29c8dd9749ac98ccb4c8c47c3ebbc28d231b942e5a594af864a14002e2ffcf193f45d10fdffeaa7dfead0179426fd9084fed3d60a5b67bb177ebcdff0eee3b6be15293f0e379f41ebeafa294f17b61ecd672f63fed0ccc815faf1ff667e313fe772397f5854873775e7f6cfc7f571fc7e787b5d80591ec5cb34dbdbe1edecf1cfd9d2bccadf338b8bf935c0b51e3d8d5417ad7c9e4ff687b7d992d5f7cbe1cdcdfcf3e3affbc9c288ae71dda4b823a84468a542d01be5ea1205dfad5d7dfa3d5655d1e95fda3ba8db5fac2708d24d6dddfafb90a07f020000ffffbb739e0123030000",
-		"7a2d9285dc3a8c5955789bb0ed479fd3": "1f8b08000000000000ff6c934d73b3380c80effc8abd67769ad292b647198c718a7903980f730bd09810c8574b4cfceb7708a79db73e691e3dd68c46d2bfd34398d0e01f1b479cbad4068e1fd46094daa9b66dd8ad24288a40d208fc2f6a1e5feab7fd7b206079b006110f75a56c29e8e7a9a0ba5d625054d52df60d060702cf09460db3d3948d58438464902290dc3e044d49babe7c590fdb0c8f6e0bc99cabb893064db947c4a87a771066a785f93e120ef92c9c38261ff742d3a1c89ba6ccd177115b6d692e474f433d3b8cbbc7e8dba8cca0f1fb5f65e53555c05a50ac8567e6c8fb146713d30f769f98f1802d3259f8adec5038691812acd669a23167083fdab311fb0c4df77b9b15b7aab7ce82e38ca1f09133d0c882c474078a8bbbc8ac769b076da971c150357f6e5858beac8f6c6f755fc4fda9c8d8f97d702b39de31b424c624c1c8b29a8c5a9878f85d4605438ca0fb85c4ecf5032426b63dc70628ecc1920282cba9bf98e6f3e8f0588c1fc3225f8551cd6fb8f413bedc26deeec5faa1f83ccaa6ac446787eb36f0de1ac3b4fe44d7be382eccfaa9be36fa2a2bd97b815a0c718bf6595b727465f03ab5533b0aa327154e3b80ceed3c0dc38b133ccf575e91c42e0a2b07a6855102a130f14061e528e1a4d19243e83d2148144c85349ce702e12ba6329469b761e951036d65e61f164d79f15efbcb1e5011adc52abfe4474db6e37e73b2e4ff36d200459d48818a804a727b769a7848de3741753a7b92d1ac14ab988d9b3fe9f96db434f157c7b7c8fbb4de6d8a7e365f673f3130da2d76225ded9fd6f9767beaf8adf66f5dfc7338f168150d03d1e9ca789c0d0e9cbf4fe9bf000000ffff25e62de567030000",
-		"7a90f592a53c71d5b9ae1db98f80985f": "1f8b08000000000000ff64cfc14e83401485e13d4fd1bd314cab52bbbc17aec330ce149016e98e12a54021c1d84e99a73712779ed5c9bffbee7f87c4855ec4a9d843460b49c55c1d257808358142c5711af99b7adc404ddcf7ffbea1103933a511084952731792ee73cc3e567752f57474f4a13f0ffadac2f43dd585bf0ee381be9af6e15495a714b40f906093d94bd36dddea3dafd4eeb9ddbab8d49e6281b4bde34d7635daea95bde4472d374c5c06942e0d5d8e3bba6116c5e7a792079159ef599f16d7e86682a577289c19403af88ffa090000ffff239266d9f1000000",
-		"7b84f2b6e859f02997a77f605359f9a9": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"7c611eddf5db0f2c0dbc0e7dcbfedf66": "1f8b08000000000000ff6c93cf77ba3814c5f7fc15b3ef9953a4a5adcb975f081a3418a07127688320164b25c05f3f473a6716f36d5639f77df24e92fbeedff785a8e7877f611a499ff918249d548bfb3e8e25c6f071d4607c04da1751b9c65a25d16919ae60c977af7af17292b0445a5f8baa5c6f842050a2918bd65858289208e1511324f1482547d483594c31e24be1b0769feebabc761b2569ca91986aa8e761ecb09b4f7783a552b7dcbf876536d21d47f9cfe1828bec29b8f0ad7b3e7aec3bf7faf3aa0ebb4cd29ca3cf89819e4be5d09bf53b084766ec8113183889cd9af8032768cf8c3dfe68fe7f9ac517bcc723044887090225e19c481e71437f9ee653d31095f6cdae9e0f597d2e78a40c83a9b6a066262d953eebe81214591d36b913763caa0c3313b0a43deaf23aef5775f2acd299c9bcf8a69cf9378f8cf1f4c4108bf661a9b6eeef20ab0c356ab1fcdcf963696310caff774f40e44468a016a8eaedf5b321dd139b5d08dbf863670ef2588630b4eead6de7d7f609cf51528fdbee80578dd9767de0329d202ee241bad6312df490af6787a04a57439ec3fc58a7cbf09b7bad21f76b46f606c4e2118120a0bd9a23fbeec2211062b2d5c2b8f540c40c198e91d65f485386444e40c2e6feb18b8823f878a34802b937e2b89a1a102d4e08636239d1aabf7c1c5a87091b43b47928ddb76b5ddcca43637f258fc11efae7f54b248af27f93a8431f0862169c287c6d36ce2278f86802f711bd540d3cd963ab5ab6efaf57ec0ede7656e461bc355ea5b10fdd9a75af387e975f07fb7ab1d8373f9ef039cb59c69cb6f2dedf6bf3f0b82a3ab99d5b535c6848fe8cd03f010000ffff17359eef5f030000",
-		"7c9b2209b819fc912d6461878ff19c31": "1f8b08000000000000ff6453cd72f2480cbcfb29f69eda8a63cc1738ec41f36333183919f0cf989b6dc23883c101e30cf1d36f856c6dedd6a79bba4ba5564bfaf33b080f45fc07e5eb44048242c2efa88342d015a3147691062b086821e9d4ead92fcc48aebf8ebb4bb770f787370311d1fadc1ccccbab940c0ce951f6d6a1b260999421b7cb2c1d79828487f094724a30925ed097f9f6b33e4e3f8a84e748e49d23378c532f1804df7e39453e35a58a4d35f21489f8296e70b5554d5329d26f37535379ee2d3450101d670430e16addd75edcac8e99ef14f993adc27428bcf91589af58222c3261e3047c34c262d02996e01d43f32f6633c3570887d0b94b6a906619def808eb9f2e3aa187b8a9c2f6584d964399f35b6020fde1ea84657153bd93b03e06835378ed5878b3ff4a449e06872a6f071966feff140607cb6db188baad188d4b4116c2f92761206b2635f092456c96b246a800aa8f93b58ff376a796ebe76e7ee0508db1197f4d4bb6bff0abc916d4779462a7fad85df38fc72ead376a9f7d7e7eaccb6bf7e2bebd3f34273b3b4de01cf7187696e982656bf715e4e2918064a073ed2071bf9ddf2da5bcaf89d23e049906c422215a5f88e60191350303f83de2628d04f6330e0600697f377127ac3c208d4050331faeed710393f0124d0a36797f206a15ab4e9df6e1bbacf5ae7c6bc61697e73d4217527a0e9d0dfa730248c1d5803ca542527f5d511ec81b3b9fbba01475d53c2dbbc67f248a9e59bd5cb669db12bd79fe925668276e87e4abb7aee715251b36beaa6e0a536d23af6ba2f4250b1efae3b30e2f7670ff72eed7cf63f6fb47fc1d0000ffff816c511f2e030000",
-		"7e0dfe55b01ad1e1dc83c91e01497980": "1f8b08000000000000ff6c93cf96a23818c5f73cc5ecebcc6904abb516b3f8f2070cfaa1a142a8b02bd08a4d81a2b64479fa3965f56e26ab9cdfbd77919b73fffe3a84c722fd8bf24c89485050fc413d1482ae474a61175b70828015d2a8e56693e89eb2e83394fc69ee1fc59b8225b1f6b4ff6cd61b291934244079711e9586692963ee129d8f5c21e1314c724e092e65105dde8b72a8bbe7de285e20910f8ddc30cd83e82a7879f74cf1dcbcbfa54d35f21249fd1ddea3acc2e480afcfed2e8e7ed7f1ad5d75e95029fe81c47f78e086c536be8d9e09f8f5ffcdb08b9c7f47067764b95b33714746de23e78fdf4c3c98f70531badce80809b1a9266014b45a61868e7f3f4f70d73353dcfab27bb9575dbbc7ccb8081edac2e36ea24c31b5d921d9575ddad7413a6036778bef3063a4d475a0ef9893a1eeeadbaad353534c5c15e75713bcfcf630fa74dc99c5f2588ab1f1294823fedc19c89a490b5cb751aa767e0a8730e075f5ebe9f29c553fc2e9ede25e3d9fe2310dc616d6fb79fd74dfcb26ccc17675cfa3091960d396bf06c74d2bfad1b5c5cf81f830a4e41cea9fe905e3a3f398354c67fe06e4e20701c9c016f64fd3db44cac7d7517a8941e611714888b56762794464cda001f4be8a5b6448e063cea101c02ff324df0a273f912e412c0e33f5a6597c09fc3a8cd5a1dee951cd7aff4032bb08d75e8ac9b28e7512c5d304e118537a8a5f71fa420029f81690e7544896671b95cf457bf6fd323bac64dacd12fce82685379b25339bead9f6adbfb6a20a574e583554665d012bc469a179f3b139bd9793e42ccffbeb32d8adc84ba4dcf6b4afd655fe8ff758044fd97f57f26f000000ffff2c930a8242030000",
-		"7f28845decfaced317aa922d664d061f": "1f8b08000000000000ff6c93c972ab381486f73c45ef535dc1e3b5974713c88e88850558ec626c2b66723c60014fdf1572375df76aa5facea753251dfdff7e2f443d1efc8369a838e318141da82338c7718f319cc6062c4760b83c4dbcf909bf5d5139bf9ce6a23eba85b9e5b046c65c3f8bfc7d2325811c8d85bc5b074b4d62293d6a5771d4532510f56014518cc45a8ed9fd23499f5935fbd28a2602c9a1865a114463d6709a768e4e66f9c72ec8f73d4d05ca7e0e7f0ab99fac6ab19d95478f3d32af2ddfaae0b957f424903b38d08ae4e0b5bda3c7b4f9bb0c4766dd4e10e80489ec3be19d20e88359b7ff617c60ce3714be6e710f2b648218815650c64a84c2d29feb716abf884edaafb45a76fbaafc14a1b60c869aef503b523a999ab05e7deeabe02b1b074f111696d94158d3163db32a6bdfaa78aa9391dd7b51a3c7cb870817d6979a38b19484b6a9bbf7835c6f677f975961a9d5fefa92f23e773148cd7fef89033223d200addfcdc8a5bbb0db097ed835254b17f08a7f4dd56639b96fcae73cf68ecdea6d3a43d45d93d3a14955096c7b75ce8b55df258dbe1c3b51ed02534f45bc3e2d0e5170f3f03a7808ef6e89d1240edd0d48ff15812460bceaf7340ece4aca61be18df3d901143566064cc0d19ca90cc0828d87c3fae1f0a04a705450ac87723818b9f06c4c833c268f6e28e46b2bd050f3f688bd9c42f33f7657e58d948a3659f2bbcad51b90dc306abffff48074cc0812006670aee797769e5586a8b660bfafa5efaf35f74776edbeea55b88a49ed4fe55b619dbe70bccc9b939235d3b73b4a1d3b83e4fd4832b1a5dd6b7515a2c19851bbb1ea6799a159d7686d8d080fc19a5ff020000ffff881eb19267030000",
-		"8389377f2fbd70e36e7be2c7f5ca5281": "1f8b08000000000000ff6493c193aa3810c6effc157b9fda1a64d0d2c33b74878001c3180d60b8013a61a23e1d195f90bf7e4bddddda579b5bbeaeaefe255f7f7fde0fd288a57f10ba922c6404247da80e678c140321a0130d96216826247717c53acf8d54349a6449fcd966bf0c24a8f557bb37ef4b210230d871d159870815e44244d4c6793650c9914630ca28419e082fecaaa2fcd51cc7672569c1513c6ad8f334f3c22ba3e5cd51c5d8549bd4d403cd38b26773cb17e5a66deb0d76e57a6c6acfed23030a759a23704937abaef1d27671cc7d4715235b47d95579b36f8efe2690ccf280d95482cf0db33c3c6d02c91f1a37ff6ab69074c1611f390fa496933ce73d1d60f59ca225d9a76d1d1d8ef55b7cad0ada8706b267ad91419eb6f52746cd31bc3aca3b0cca9bf67309f53f886134bb95517e2b37ccbd63fe4619ee2db56a9e9c4a361897382014fbfb12806802a18162ee4fd62f86da4bb083ad1d2f93d95bef9faeafd7449f8cad7e4e6779efa6db26de1f9d49f6f17a40f9f271d859790996cb97d52aac2797e4560a2aba72b23cd3756bcd7ef28ec780e041c2fb9d742ea6081f53ea20708215d8f9ddce957b40543604c512ab104536074b2342ba084416a2e58483bd3bb5a596e2ab15c4e10036b18f6683445876029d2cbd8957942c68aaeeb2d5cbddc20f9b6eb46b77db6915e30d47e7c9fc3db3c7f3d43ac973f20a09b38cb1ac7e8bf7e5263e34465c7fdf058f90ff7c2e875344c857e4acb93fbb3f03dc063815842159a8a958a8eff865f125737fba5579359e1b5737fa6d67fc60f73a9ab92693de4ff80474d45587ab6da287c10cf13a0e7ad5314fe0598f62d68c2e972a6a66df8d1b12fde387f3880f4d83ff47eaaf000000ffff1c30db8a6f030000",
-		"8638edf15d5033bec9077fa35ad9b9a5": "1f8b08000000000000ff64cfcd72823014c5f13d4fe1bed34123a22c6f3eb80998d4a0607167a9426b45195b836fdfa9d39d6775e6bffb3dff8d0a5466b0c854012b31484579af9e5628a116a0a9467aeb70a983086a818cfd7f2724c5a1db3a45c1daba1b069aeff134cbdeca58e2b7876b125fe4f15826d1d472168f5342954ffad6fc34191806b0ac42fe6ab3779954ae19edc2bc681d9fcdaf8df6c73be73dadfd73be0983c3f5f32056f6642232f97ac9a148bbb96b17c976f2d14cd56d5311b6affbfcecf7234b2ea5f2ee0061f823ea370000fffff7547835f1000000",
-		"87a5d8157d70ac81ba171ee129e2c863": "1f8b08000000000000ff6c93c972ab381486f73c45ef535dc1e3b5974713c88e88850558ec626c2b66723c60014fdf1572375df76aa5facea753251dfdff7e2f443d1efc8369a838e318141da82338c7718f319cc6062c4760b83c4dbcf909bf5d5139bf9ce6a23eba85b9e5b046c65c3f8bfc7d2325811c8d85bc5b074b4d62293d6a5771d4532510f56014518cc45a8ed9fd23499f5935fbd28a2602c9a1865a114463d6709a768e4e66f9c72ec8f73d4d05ca7e0e7f0ab99fac6ab19d95478f3d32af2ddfaae0b957f424903b38d08ae4e0b5bda3c7b4f9bb0c4766dd4e10e80489ec3be19d20e88359b7ff617c60ce3714be6e710f2b648218815650c64a84c2d29feb716abf884edaafb45a76fbaafc14a1b60c869aef503b523a999ab05e7deeabe02b1b074f111696d94158d3163db32a6bdfaa78aa9391dd7b51a3c7cb870817d6979a38b19484b6a9bbf7835c6f677f975961a9d5fefa92f23e773148cd7fef89033223d200addfcdc8a5bbb0db097ed835254b17f08a7f4dd56639b96fcae73cf68ecdea6d3a43d45d93d3a14955096c7b75ce8b55df258dbe1c3b51ed02534f45bc3e2d0e5170f3f03a7808ef6e89d1240edd0d48ff15812460bceaf7340ece4aca61be18df3d901143566064cc0d19ca90cc0828d87c3fae1f0a04a705450ac87723818b9f06c4c833c268f6e28e46b2bd050f3f688bd9c42f33f7657e58d948a3659f2bbcad51b90dc306abffff48074cc0812006670aee797769e5586a8b660bfafa5efaf35f74776edbeea55b88a49ed4fe55b619dbe70bccc9b939235d3b73b4a1d3b83e4fd4832b1a5dd6b7515a2c19851bbb1ea6799a159d7686d8d080fc19a5ff020000ffff881eb19267030000",
-		"87df747867d473bd7662b2dad195d4c2": "1f8b08000000000000ff6c93cf92b23814c5f73cc5ecbba61ab5b13f16b3c8cd1f881a3088d0b013c4208a62ab0478fa29e9a959cc7c59a5cefde5569273cf9faf05d4e1de1f980621671ca3908eaa2138c75b82313a140a690e487189274d8aaacd61776ccde559c129cad93b414b50ea569e2a7f2d2541150c42deb581654222291daa17d176a0a100eaa0c99662104b3965f75d9cb6796d354948630172ac4127bced943d394d7b2389ad6af7e555d9405301f9cfe152c86cb6b888a3752e1cf6c89deebcaabd360b692ee03a32a8136132a54fe3f7202a98367b41502fc856fb84f782c08e6973f8d1f8bf9a215cd1e1012d407911a02444e7281481d0f4e7699cea862471d7a4b5dd67f5b91441a2191a6b2ed593d048e20f155c1665567b4d3ef55a119c34d323b0a41db4799df7ab3afa48e289ce9ced3399da0f1168eda8912106edbc2ad958bf07d949539db8cb6bca87cac44826fc9f3d41322752216accd6c1a75db5ddeaf0553c6ed79509e18c0c625f3bb26eaff362b50a97bf0af3064d4f6e753f6d657c798392b99f1f61c4f785512c3efcd6d9ccd9e7533a31b9d83a826ee73d8473d7e475cdc05c23e9be03920429a71660be5cd82fa41c6d3530be3b486e19688141a96f509481cc090ad1faf5b16e20001d7e510811793512f83436204a1e0113666cde2ff1f1690d70b35b4d3133cdaa4bddaffd84571369b1ef9417d9d59a36c17f2751792e22400d74046517598dfbb83ae8f906d1f9b278e38f667e7d0bdb9afb7abff96e9277623f7da5b982c4cccbfd79161dcada3f0eb131e8b75970acecde43d75dd0d2895faead27fdf627ea2f638c0bf5c8ff23f477000000ffffed6d643e5f030000",
-		"8ab19a2c0a28f2901f1b75e1458066e4": "1f8b08000000000000ff64934fb3aa3810c5f77c8ad9bf9a1211afb898452784103160b8e1ef0ed00b0fa4fc8318f1d34fe99d999a572fbb9caeaefe25a7cf9faf830865fe1f988492390c83246f55e38ce1d4c6186aaf06c510d44c0433b7bff90bb7fee4abeab86d6f276fd6d9e0a1babe345d1bec84b0a145031783d2b0c8ec58084ad4268e9e44724428cc238211f784e10c4592dfab7e79ce24493812ef1a7a703f329c91917cd2b264d916a9df964f1271c4be9b1bbecdd3a6295334e49fcbb634f4076d2143b51f23e092a4e150197eb3ed6353cb92b92a693466c6fac69199da92296e33e54b3079cb14774ea92df95be3ed7f9a4a24d972e8a8f6466a388e63fe204f08bfa7d412777e53d2635f2e3663919087d342f45daba41dfb4df913d1aa77462d338ecfccb01eae84f25f4487aea79cc6539eb2e985f90ba5d329a232d73be5ecd9ea580391b17f2e3688ca163590b1e0e4c7cca334b4a774a61b4eb09a8af17e301fa90ee50227fe794eee972effe17e68f77d28abfbd8ac17d1edc66f66ec5ef58fafe94b95f3704597fb6b1a0ae33af3a64b807a1ba3a384e045ea0a0bc1974534041ca30294fbb233d48f0865ca818c792a4348442e2842311e2888c8418a630eeae5d49e2882664a608d03284fbd9b5b84856227a8bd9df1612439b3ab62b8eeebdd616b3ad5303f3487bd556cd084e6e70f3788547fb694e67d4f0e11668a3116958b4d97a79b63d5b2f1d75d3030fedfe77238518c2f54fbe4e6faf50cd06be024c24ce0af64b54c60b75cbba6df87d8ecdce5609dfdab99e6509e3b8f78614e76eebcb9d6acd6dcaec578bc1fa41c00aff4cbd12a27e504e5ccaa48438261acdadbcfcdd51063f697f68e0ff1eddf23f577000000ffffa4c29e126f030000",
-		"8f78be627d563771ed2e20df4c64d152": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"9159111881ac7d5d179c63ce2f65687b": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"91c7247b736259991b05597da9c7d131": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"93798234d07d65cbf68e551cf94d95fd": "1f8b08000000000000ff64cfcb52833014c6f13d4fd17dc7a122966199cb9970a249532208dd79a1804ea9b540689fde9171e7b7fae6bffbddfc8e8240bd3029e6e409160f50ced553281252035154097a3909abc298d42018fbfb0e122a56eec52125db6d1d8d45d5910c4e571b7c7f4ccedb85605703f49f83ccfdca1edf628eba36e3996f9a9468468865721737f97b1739de26e5d94f0d04d9baa54646bcf78a677ffc9a029490de1edabb52758fba783d0cf72dee37718fb26fa6f5b00c2b7d917bc39a2396325bdaab370340f3ffa89f000000ffff4436763af1000000",
-		"93ca48fb36e6789338fa8384e49d9527": "1f8b08000000000000ff6c93c972ab381486f73c45ef535dc1e3b5974713c88e88850558ec626c2b66723c60014fdf1572375df76aa5facea753251dfdff7e2f443d1efc8369a838e318141da82338c7718f319cc6062c4760b83c4dbcf909bf5d5139bf9ce6a23eba85b9e5b046c65c3f8bfc7d2325811c8d85bc5b074b4d62293d6a5771d4532510f56014518cc45a8ed9fd23499f5935fbd28a2602c9a1865a114463d6709a768e4e66f9c72ec8f73d4d05ca7e0e7f0ab99fac6ab19d95478f3d32af2ddfaae0b957f424903b38d08ae4e0b5bda3c7b4f9bb0c4766dd4e10e80489ec3be19d20e88359b7ff617c60ce3714be6e710f2b648218815650c64a84c2d29feb716abf884edaafb45a76fbaafc14a1b60c869aef503b523a999ab05e7deeabe02b1b074f111696d94158d3163db32a6bdfaa78aa9391dd7b51a3c7cb870817d6979a38b19484b6a9bbf7835c6f677f975961a9d5fefa92f23e773148cd7fef89033223d200addfcdc8a5bbb0db097ed835254b17f08a7f4dd56639b96fcae73cf68ecdea6d3a43d45d93d3a14955096c7b75ce8b55df258dbe1c3b51ed02534f45bc3e2d0e5170f3f03a7808ef6e89d1240edd0d48ff15812460bceaf7340ece4aca61be18df3d901143566064cc0d19ca90cc0828d87c3fae1f0a04a705450ac87723818b9f06c4c833c268f6e28e46b2bd050f3f688bd9c42f33f7657e58d948a3659f2bbcad51b90dc306abffff48074cc0812006670aee797769e5586a8b660bfafa5efaf35f74776edbeea55b88a49ed4fe55b619dbe70bccc9b939235d3b73b4a1d3b83e4fd4832b1a5dd6b7515a2c19851bbb1ea6799a159d7686d8d080fc19a5ff020000ffff881eb19267030000",
-		"9541dc1384883f1ef2a272237589c045": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefe7b375431b050961ec0240f64297122d74888d3a394850b94d1d6b33fbd734d9897750c4447e56bc6486e0f04a2290bd4e7e594a02e3781f510d104eb6364c7489123ccd7ffc8039e5c5ac6106bf9ebcba1a16c07b3dd59e5c52e55392f2afef592e2c604a20b60bb6b0db04074484574778cbb127a2ac17e50ae7e9cf00e0000ffff1e34df7fd4010000",
-		"95495d34a907a5e2e03665addb86dfdb": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"97b032d96068a4906f4423874bcda7fd": "1f8b08000000000000ff64cfcb72823014c6f13d4fe1dee94c65a8c2f2241e0396a084786307438de30568088dfaf49d32ddf9adbef9ef7e6f7f23c8a264b416d116248e3ef1305487472c0485c00967e4f1cd32ee05a09051faff2d8684bddbc24604d254995bd977f97ef6d54eda4efe10c7f63a10e7b20e3771b5d816baf9489fe1f3011cb293808402c89d49dd758974d3f6459b296be7aeb81b7dcd5d365e3814a64baf8ce34b5e7bcbaaa9cca5eb8e93d32a48fc33f8fbdd754c6ea8575339d3dc6f82fb51d81a953c38030093f92bea370000ffff3ab9f8d3f1000000",
-		"97b94c71adcf85997c8476db6519876c": "1f8b08000000000000ff7c8ebdaac3300c46773d85d170e74b3367e8738410144789456b39c84e4bdfbe98a6f467e8780e1ce9eb661a4d7c0f5b66538aec5a87c7298a22782f53c5d1d2890dc10752e5f3f0b0f1b63342b2a59a64cb01a148e4b49521b02ca1b8d635ffb514ddbb0601ba2eb35dc473ee7bf83af7578c34cff5e173cfcbfc2e272a34e42badeb7bfd61ef010000ffff61833a0af0000000",
-		"9aea375be26e78aaae4fff9705ea4456": "1f8b08000000000000ff6c93cf92b23814c5f73cc5ecbba61ab5b13f16b3c8cd1f881a3088d0b013c4208a62ab0478fa29e9a959cc7c59a5cefde5569273cf9faf05d4e1de1f980621671ca3908eaa2138c75b82313a140a690e487189274d8aaacd61776ccde559c129cad93b414b50ea569e2a7f2d2541150c42deb581654222291daa17d176a0a100eaa0c99662104b3965f75d9cb6796d354948630172ac4127bced943d394d7b2389ad6af7e555d9405301f9cfe152c86cb6b888a3752e1cf6c89deebcaabd360b692ee03a32a8136132a54fe3f7202a98367b41502fc856fb84f782c08e6973f8d1f8bf9a215cd1e1012d407911a02444e7281481d0f4e7699cea862471d7a4b5dd67f5b91441a2191a6b2ed593d048e20f155c1665567b4d3ef55a119c34d323b0a41db4799df7ab3afa48e289ce9ced3399da0f1168eda8912106edbc2ad958bf07d949539db8cb6bca87cac44826fc9f3d41322752216accd6c1a75db5ddeaf0553c6ed79509e18c0c625f3bb26eaff362b50a97bf0af3064d4f6e753f6d657c798392b99f1f61c4f785512c3efcd6d9ccd9e7533a31b9d83a826ee73d8473d7e475cdc05c23e9be03920429a71660be5cd82fa41c6d3530be3b486e19688141a96f509481cc090ad1faf5b16e20001d7e510811793512f83436204a1e0113666cde2ff1f1690d70b35b4d3133cdaa4bddaffd84571369b1ef9417d9d59a36c17f2751792e22400d74046517598dfbb83ae8f906d1f9b278e38f667e7d0bdb9afb7abff96e9277623f7da5b982c4cccbfd79161dcada3f0eb131e8b75970acecde43d75dd0d2895faead27fdf627ea2f638c0bf5c8ff23f477000000ffffed6d643e5f030000",
-		"9bc52dee72564b7d25797f988be56fdd": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefe7b375431b050961ec0240f64297122d74888d3a394850b94d1d6b33fbd734d9897750c4447e56bc6486e0f04a2290bd4e7e594a02e3781f510d104eb6364c7489123ccd7ffc8039e5c5ac6106bf9ebcba1a16c07b3dd59e5c52e55392f2afef592e2c604a20b60bb6b0db04074484574778cbb127a2ac17e50ae7e9cf00e0000ffff1e34df7fd4010000",
-		"9cd901e9e9d0854cff4c6acf95fd2b75": "1f8b08000000000000ff64cf414f833018c6f13b9f627762265567766ccb4b29aea5edc636bd91125a1d4a70611d7e7a23f1b6e7f4e47ffbddfd8d00e372a10cdfe31d2c5ee075ae91e02cc70eb020829169605bf1b8c60e18a5ff3f404ed87da80327586be7281c4ee18927f5991e7fb226e2619cc665f990556ae045c18fc5a53aed92b7d697de60493136bde8d55597e06d45c6cf364d97689fd82e1f3f90d611b908a4d477d7cbf8bc45b2b21bdfd8b299eaf7a4fe8abdb916cdfa10c75d3620f76c569b156bd3a9b5349a0120d35bd46f000000fffffeae92aef1000000",
-		"9d5035faca939ccede91b17749822e2e": "1f8b08000000000000ff64934f93aa3814c5f77c8ad9bf9a6a41edd6c55bdc1b428c183474f81376823688d8a83413e5d34f69cf4ccdab975dcead5bf7979c7bfe7c1ca48c077f101a2aee71028a3e554b704e92811028fd120c4728b9c4e4f031fe7119719118ef1295ea946f3e07f0b12c2fd5b15e6fa474a1c64ec8ce58446a37969251b38ca3812a8194811d5182c2978ed76d93ecafe2343d6b451381f259c39b0822c7eb39cdee964ea6f5360dea7ca09140fedd5c89559656559e6297bd4febdc19dd580d1acb2046108aa661573841b53ac5134b27b6c959d46b67fe257092ba8a1be172132898889a1be1b5a9abc45313f57f9a49145d093832eb89540912c7e2460708bfa7948a1c832a67cd291f2ffb6d426f5e0dd177ad506e1c54f9015971f27a4b3bcda09dd96da120ff17d163f37bc6e27b96f2e181f90ba57734d4e885df667ca847c402a9f93f171764e1ca1268d4d82fed4aa327cb4b7218efe4a7ad6578de65de7ae78ebd7ef4998ea687652183a56b9def97e9f5f5b5f76c1842274fa609b9065f2f5d76e9376d5598664a766fef5ba52e6b3cb9041b05eb07e942ce103e66d4421004b760160f3bc35183a88d079afb4623ca68018632423a0632f2d00822c03c9cda5143f1c548620900e39b67738d441ade42e96f9c5727c9b85b6cbbebaedcec5713afe8ec7db5dfcdb64bbca37d7e5dac23733acf8ce57f4f0e9170c3398ff2f1f298a5cba6a845ffeb2e3884fcef7305b48c900bb3dec564fe78068c0a1054124e422e9a1fac6d1bc76157e76db3dfe963f25567f3f1f258b4f70f3b42f1960e87d5040ef866ad27fe4453360f7b799dd1fdd66663a34eecb35d07373b2ef2f2accbf3198f7ef9f3a7f58c0f0ddcdf23f577000000ffff52ecd0c06f030000",
-		"9e9679553d3f5533efafa0482fca2803": "1f8b08000000000000ff6c93cd92aa381886f75cc5ecbba6b4a139632f66f1258418e1a34523083bc01608a0c7636380ab9f527b663327abb79ebca9ca939f3fef83302e823f28db48e10a0a923da88142504f520ac54b095a1028c50604f9b05eb334dba667966c4713567db52a342d13e19d5331a939032d743131df406838bcee18a99046110e6c820d2983884029691354396fbbdc5af559cc0657c1ee395748270aaabc26dc283ab74fcc764accc5c025ec9f85b364fc7d4c27d1a7fbaacaf7e49a6e6d959bf361e940f6eca0646650197ef7dba25e5645800a342a7845a71cef39beb3e9c1c67f99112b72c1f0aa699838511872a657d16e6212097ba851825e68bad72c4e6f4567ff4c248b91844fed01036367babd60e998c4b6caf681ca2716205c9f8b0764058fda42b12392f983c180f137eb736b7532b0b6db4fee7e157c68fd2eb8e592a4489093f1c2b7f8f60e25e3947e67cd96301740b8a5ae93bb9639ef8c0324a549866e89d2f18ee1e633d0d6cff7af2ff45eadf9c5b5dbf06c4f3ee18b6d66ddea0fea9f6e4a953f60b67e19bd93be19f555cc148bd8429a4a4ae223bcddb77a703423331ddeef7b593a80f7935f6e90c071c14001207d681e0ca1c306a9070223ffe590797f9d90ff3267108abddcaedde670b076f53a729af8aba9ec5e9db296219cffd3320820857909c87654840e261f619d7c621da67268378d9a5933a5929bd6075aec6d887bb7fb95d5cba62f45e936fb99f1a3afe5d699b7d362fda275eb1fcdb74200b998593ad68bcb5ba9e6c951c3dfc6e3e5b3c0f9ff6ff8270000ffff2377342c2a030000",
-		"9ea6533fbb45a7419927d6d7005cbd3a": "1f8b08000000000000ff64cf416f823018c6f13b9fc23b590648458e6fb17b5b58ab052681db92b18a5122ba0de8a75f6676f3393df9df7e4f7fa30c855aec72b187922d3256dfab230572300c249548e7010b19c6601826c9ff1f19a7e88defa3a0a0b5a996fbb658a5e463e2f6eb2df69cc836975b274ddafbea73dbfd6c49129fafc5ae82ee90834a00741378ea158eeb742a5b7d76eb67550fab17a34a20e5d2a96c5e6d7aedadfdcc9fa6469c4ec16574ddf6802189829959779807157282fa9845fe8de3d542fddd3b7700539b47d46f000000ffff6f07ae2df1000000",
-		"9ebfcab8c9863ceae18a50fe41a1d6ad": "1f8b08000000000000ff6c93cf77ba3814c5f7fc15b3ef9953a4a5adcb975f081a3418a07127688320164b25c05f3f473a6716f36d5639f77df24e92fbeedff785a8e7877f611a499ff918249d548bfb3e8e25c6f071d4607c04da1751b9c65a25d16919ae60c977af7af17292b0445a5f8baa5c6f842050a2918bd65858289208e1511324f1482547d483594c31e24be1b0769feebabc761b2569ca91986aa8e761ecb09b4f7783a552b7dcbf876536d21d47f9cfe1828bec29b8f0ad7b3e7aec3bf7faf3aa0ebb4cd29ca3cf89819e4be5d09bf53b084766ec8113183889cd9af8032768cf8c3dfe68fe7f9ac517bcc723044887090225e19c481e71437f9ee653d31095f6cdae9e0f597d2e78a40c83a9b6a066262d953eebe81214591d36b913763caa0c3313b0a43deaf23aef5775f2acd299c9bcf8a69cf9378f8cf1f4c4108bf661a9b6eeef20ab0c356ab1fcdcf963696310caff774f40e44468a016a8eaedf5b321dd139b5d08dbf863670ef2588630b4eead6de7d7f609cf51528fdbee80578dd9767de0329d202ee241bad6312df490af6787a04a57439ec3fc58a7cbf09b7bad21f76b46f606c4e2118120a0bd9a23fbeec2211062b2d5c2b8f540c40c198e91d65f485386444e40c2e6feb18b8823f878a34802b937e2b89a1a102d4e08636239d1aabf7c1c5a87091b43b47928ddb76b5ddcca43637f258fc11efae7f54b248af27f93a8431f0862169c287c6d36ce2278f86802f711bd540d3cd963ab5ab6efaf57ec0ede7656e461bc355ea5b10fdd9a75af387e975f07fb7ab1d8373f9ef039cb59c69cb6f2dedf6bf3f0b82a3ab99d5b535c6848fe8cd03f010000ffff17359eef5f030000",
-		"a0da285509578cb8bb6cee5eaf169493": "1f8b08000000000000ff6453cb92aa4810ddf315b33726446c6feb6216590fca520b1a28d07227b417047c74b798c0d74f8813338ba955c5c98c3c8f88f3e7f3112ea4f707e5a196aea4a0f9805a4a4afa51520ac728079404721982d7e7b7fb6d39fb5ea71abef4859951f889487323d7d7bdec4b9b034a3c68beb114540226312785a249a25ade4348722f21906b5a79452aea733a5d35872d6fdd12e2d72cd32cf18af44484959dddc638756f9c79eb32885e0b574d9de4cd6c27988ab831cee2aec20cddc0b0240818c75969a2597d14eeddca445b6fcede23d57074d1ee14834eb1187d263bc5c8c145bb7f61f25f4c89aaa53dac9e641601a3a14eb40a15f21783e4786366dbdef6e745979eeb4285065d18664b8e136db66f79785915e9d9bb5999e33d541820cf878535c7fb20ed3f657ca7483ce404adf28dc39bfdae28d21df9d947b3d24a1d1bb71a34c9b3afa22afd8f401292e7fffc1521e8518088f468323fdadd488f94d5467dafe0d39e24ef960e96a9a8e8c5f58b4724a6df55bdec26e3ddb46a26ac2e61f16ee3c99eb971de293e1f3524bc56fdaf75188fe9f1f7d5b1d13af4e03fc358067302bfe79c80a27030b87ce611da3521837fb9464348102f01a5a0f4474010bb0415b114cc9ffe3e397232c6c055a09e8770fd3ae0132ab9f47f54797c2f1ffb6c67cf2e6171e1f3c9bb771ad123b5b02ebc8bbb4f74fb26c6753b577015947e8948bd2d0635760e8ac75406cc46db54a391d7463f17f3dda4ac6f3fc082c9adec3af44e2c5944a739779b9933429907d347537c6cfceb21e1756ad3a31699ba399bcd61e5df117e71c48d338d036b5c99bfaca116dc63ffafcadf010000ffff779d601347030000",
-		"a32120157fd05843b3436aaf6d42df6b": "1f8b08000000000000ff6c93cb92b2381886f75cc5ecad2911b57f5cfc8b2f2184a8498986e34e50a02334ad3606b8fa297566363359bdf5e44d559e1cfe7c0e4428137f60b297cc6518247951833386370ec6904f4ad00c41c9f6b05eec67ebcefc323fc7c58d85f378b38cb781c665c2366dca466512d04ce723d91a1c2e1466014115c761c87b32c21e952244504a7c115546eb269bafbb63447a5741f09ecba5138a2afb44d4c81bb74bac7a4c2cbba712e277a19584ae8674245d1a575516a37b7a58aacc327bcf81e3bbc325b144656c9bff2d6aafca0557a0b9821977cae199a3271b5f6cf88719914257eedf35f61327f47d4af43a0c462239222f358cf8c6b7dcfb314a1f79b3fc4e248938f2dfda3d174660b91d23e990444b758c85ca462238dcdf8b7b4e721ad6b9220547e68b41cfa337832e9bafbf0c7e58d667eafee4b4afb78d786412a51c718a862b3df0c50a4a4231fe3b6be281c9006d26eab4b4f4c197cc28d68f7b625fc5cafe983693dbae0adb8686935b7b0f909a20564d8654fab1c77793475bd0f208673a9aed4a4fddfb940673e3a67516cd8a4b4acf4a4ab4e5b0786ef5e46882a6da7fdeb7573ac09f27efed3982c226a000387e699e0ca6fd0bc71b606c9792073dadac31091d9f2132fdd87d0dd723fd10d6d7e127b6ebd6f1ca5fdf2ea61cda7fb50c041c839903273e668ec7ebd375aa75a16aacedf2384f7ea68dbb5dc5c4393926f26fddd99eed65a74ef0094921acd8e88ad9e05c58fe73ce45612fd648d1d2ff2526418284a9779ef467a382dfbf8dd7cb27c2f9ef6ff82b0000ffff849769a12a030000",
-		"a6f7577515d7f3182b6f004ed911b1a1": "1f8b08000000000000ff64cfcd6e824014c5f13d4fe1de343554dbbabc83d3cb8017910f0576102868a9207498384fdfd474e7599dfc77bfa7bf318ec29bf9813840c4672e4fefd5208136d41c8811b2db15435aaea1e66859ff5f719be142e54a30d8efeb5616ed41843c73bec7ec42a6516afb75655ecb6377360bb70135ef93517fe4ded66d02f02c80601ab07acf75e4743dc6d1eea710d2ec433c359f2f141bddd84efa34f4691e9cb3307e4b7c49404de95b6ae7b8cfc7b59c6035a44eb5ac64322ec4d76d5b5d36c5dcb803b8b77944fd060000ffff22ba4dc1f1000000",
-		"a7c10c393a51b1844037b10845b3c522": "1f8b08000000000000ffec5adb721b37d2be9fa7e812ff2adbff92a38393dd847bb12bcb71acb2136b4d39b948522970a6c941880126008632b3b5efbed50d60383c48966c679d4ae9c63207a746a3fbebaf815ea275d2e8311ce747f95196154aa2f6e32c0318c0f7952c2a30762eb4fc4d78693494061df84a3a104da36411be4aedbcd005c21495d173f0e61f7059212c856a11ead6799822f80a418b1ac1cc40689a97572971263596d0ea122d1cf4977307196cac3fa65f27249d32f3b9d4f3710600a070896a0c52cf4c16447f6d8ca77568cd6f2617504a8b853756a2832be92b58e0ca81d0251468bdcb3380c2ae1a6f0aa367324edb085f8de1fffe7df6eadb67e75fff7c717af9fc3f87a1db28f40b8b4d4c8d3079fac2816b9bc6580f8d6ae7733155082fbe03e78d453764591a6b1ab49ec488fb2d2c96a8bd146a42fd0e7846611164dd28ac51fba063d7602167b260493786046107f0c3ab867a0af5530e6f1c96305d41ebd0060172f8d678d088259620672094ea4de47849aca75872bb86b0c1d6f2ea7105d2176a6b9422b9c29806edccd81a4b40e5ae2ab498f7947770e8ebe6d079e171e4c2fef6483b897b036f584b67ace3492bfd8e1adab8b1af5f91c673989899bf12164753412d9bbd5d5ccbe2afadb452cf41d0c127855cbc389b0c8e8f61efd060eadaf8b09d70ec3d7df3c16fc9cd47aa56b4d00b5c7d47d6cf23c057c2c354140bc7fdc2643d51e28c4167acb2da3549534fcece2617f13c60666c98821d757dd0511f1984ee414687456ba55f258951934d9663f0b6c5f8adc49968954f5d804c74294bb46338987c7f103f57c255a76a6eacf4554d2dcf4f4f529b3333ff1d5a395b6d4c1c9df2e4f3bfd246bc7267e469d158b76c15dcca79acd91949a1c22334c628b8aa904d5163e125230b3488d60dc1d8122d5a070f49251ae7c64bc17d2e5f4e1ec1d3b82d9809e58248610d92e2c2181565dd15e78c35cb8793f081b57ef9720295d0a5abc4020388b028dc2b49136c258268d0c302573de5be13520e69d2577d103c24cccbf1ad2003cd0b531f9257bbc3d3b296fa9f3b8d5eb943877689365fe02aae4cbbf8545214d667d920db74f927ad87da384f71c4c195695509955862082e8e8e9b40cf04d7292aa1352a30d35fb0f00e0aa129a214463b6fdbc263990da21b1b1dfcc3684fc74811e92a87730e39a269403a82bd602971da80cd1aa4072517a85670259522df67bc6491b241122acf06591a1802e50faf195f02aefe1c1d8a87c7d8f677483bdfe8115b7fca19f3536864e9c3fc1940bd8aff1f273850d27168eb1ca04427e75af8000434bc1f9bbd21cc0cb8618576a208f0c67a920921e322395c92fa7989a86301161d494b51bb28d039d6ad350a1e1e3c7b727ec01d8d56abd47c10253b3d78446e1a57a0b827a2124a2ca46329bc015751185146940e66d6d47de91d889a094585dd7e7358730bc7e422ae3045382025ba83a09eb9bb915b1c36d0321e9210b47c8258c269d4e5983fb29944e8256f3f888b95d858249c2ac77cd2160b53d7a84b2c8734a159a2b5b244107a9544df8ca9203dd66e081480fd0a6ae18b8a8ed355ec0c53e45897c7f5003a058cd79f0046e9735e482f7f43edc8fd12aced35cc805aef36cfd0af6fa47df30bad7c608db05e16b2094e458a0f0c003b61c38fe37c1b26d69084ba34d6493dbf400a3dbd3802649c5217a6c47fb568575b8d0acb39da7d2db844ed27a6b5056eb40c5898a36b8519dc28cee0668106378834d82b142163a7d46d4daecd95381963a3467f65ec221b641bede340958fc32e6ad7c8720cafecfcf89bc945820e766e63e70f1c9362e61ef0504c9d512d455ce189ee834525bc5cb263449ed127c78f7a84e8822309ec8d16c737458bcdc68eedf46c66b43ea68dcebdc6e3ddc63decf2ac472a4e5b5f118921022e9d6bb14f391ca3a4644a3c4bf8d9b4b6310e59ff029e89698833719d7818395cae1a5908a5569b13329922a8a3f394442a3586991c36c232cfb18661d3b4de1160841090986babbdac71bdced9294d23423a20d40663127173ab10342391a3deafbf9a5cc2e9c539ef3021d84a8b5a161b33d4428b399360781888fe102c2ecd02e9ef287c7a943300cf8c52e68af695a2351917c581f51a67a71048804bf0d15bad77166b481b4121f2d954eec0d920e54f33a93004688a4342d2114d952c38fdb37538353135addf7220e32b24f22c0243e093f03165a54814c2f065850e39b3d148e722ec6a63666fa02602d80ba6a0e40c8b55a128b934763104a90bd572ec203f3b7fca24314bd013535091047ff3fa250742d4e5c6b4942c1a27948b0aef364ed8dd6ddecaa5f0c893f7e5b4c89a2fd9a66bcaecac4bf4a2af9721b8b6a8403810e49b1bbc371ed490272f8809cca5f356d89805c2f953eeebb0b0e88780bec8b3804327db3874f2c970e80eacf51d387472130e6d3632acbfdca66afb4e99951df92d33533c6c9b92bc91d256749e6d139ec5c48fe080c82e7284207723434e0c83e303a5f84c79eb987bea60ec5d301fc255b8dad10873f45d524db833a03c92fa5e73ff90c399d14c08511415757e40b056b4ebae8ca2de71b6a458ccb50a728e5b6b2293d8cb4e1c6ead1ac3dc36851b1f1e56c6f9bc34c5026d2eb547ab851affede8f3a36451bef35ae7852e852dfb572d8906467a3c7f7d71064a4eadb0abf5f8c8faa7647fce854b10e146924f8d87041b5c671d11e648c8106e3ab6e39c1a7961e7e847c44847890d8ef7edb76307017a282cd4e837a9a0431f2e658c2da50e6a6610a1fd2c101ba1c8511aa364b14a395080de6137bfa02056989ae805f15e879e784648a722cba7459471f1d452e64d594f9ae57b4a95ca446223a23c2019462cc4882cf4017016e7497747e4cb8a425c121682b4519fbd0dd30196d2f12d45973e6f4c3c8623b7bfc5b47e0c27fb5a1bb4b5dcb80a009809a94633e1b63e0f4050541b49cd7727d8d984170bca10354191d114a8138b9f81284b4bdbab04d16a323c8228b5f6363963aa17b24c3217d2082a87c1c0e3da9b2b27b9b8d12b7776dabb3fe1d8da8bdbca1429eef53034bb75b61fad72133ab7d2f942847ffba63ba2289137b8857721c825b05b0a2b4debfa70d68f90816a33d20ce15722cd59bc6924aaccd41835da2ef8a41cbc83e8fd3471dc79b6741c61a50b3897c4eaadcbcbf1d29d90b7c7a0e3ac4b36dedca6ffe3bb83c60d44f81e37ee71e3d3e1c62df2be3e726c37f6e003ae49e8fe376efcc51dddf88bf776e33d29ebbd1bdfbbf19fcb8dafbb5bfbbdddf8cb3bbaf1971f168d77d3c17b37be77e33f981bef3ccbf5ddf8e4ddd1f813b8f1f1d11dfd98067c483cbe77e47b47feb33af2208b17f11bcf060ba94b32967d6f23abf472c01766cf570ddaf0a2c5af1fe1e69095e3b2ad1bfdfefb8137744efcdeb194bd17881c4e7bafd3b558415119e3303d548bee062fdb56eb5a40a99d471176703a242f096215c26d551084ca026db8022dde618657d5c1b6f45bef11fcd0b74b76525304b2cafb8670695fcf98ec87de9b36135f6f6983a6aec568125f83cade53a0af5caf67ac0daa4c8d87ae12e6178987c15c7e5e1eff5e7c6e43d06bea62269397db7531642767a7bdd1eb8a98f4a5ab8b597ff8e87b0c57fd6f1cdae3dd2747afdc617c540865326b4152b1cc1f4034ae9d499284f7b45062e8baf73b2ef15b57e5ed781b1c5863fc01f71b82c81779ef216708d2f5e68fe581dea4071e3a69a9976681a0f18ae7707937a09ba6afad30f2bc1c8707a59d96093f16c5d6e6aab7fafad196dfbb4c2a4ae857c69c9dae972fc4b78242d01e138e65a05f716dc537a9b422d4bf816ba7ce4bdfa688191f5781b8051790042b765e78596c166d0465a5b2a4de1343ac96a945d36099c36b9ce3dbf491195228dc932e3d28f3446fac9af484f9ea6d93c660ddf85544b51a850ed1da89f55b70276e1714dbf02e7dd3b473b9441da6112e4acb3c88d06cd86d6caba1bf919b16e002aeb95142cfc936f0edbaaa45c905c2714e9151750775327ecc7a698427269785fa9205c6146bfb19787c7cf2f8b384f4462f094cafe99a0a058ff2674fcee3aa3b5385b374ea92d9e1abc80bf7ec2d3c75870a8c78084a6a7420823decf60f6f753389aa74306f6c22a5f9756c94085f43316b896a950de05c73e51007b4701acf8df30f5c2a46ea9f399f5b3c32320a3a8b18f1d88c98f1e0a62b10b5e3e31c6c368cb3eddf2117a0bfa374506378f8e3d5ff3fbae6e29ddb12d5df52cd5dafd3ef3c3ca401b738d41b6fd5d71abfb65b768d42f65c617e8042762e26ef3cfc8e0ab9e67e725b21fb2b6fae3590938fa58f9d1b9e3b0f7f1f03d9cd0ff71ac8c96df4b127a9fe007deca6ca771fff3e16f26e8decab89e0c01ddf1a3bcab0a9a4bf3cea86f0af357dd8a79b7db58fa12ea01b76ab7dedada1eca6e86fedba9eefdacdc96db6c3718acee97df6d00dfeb8823fbe85e07793f4e3caf759906ffcf0c7f25dea3dee138f3b6ab737f67de4df9366aeaef7806d3673a723e80bb5af962ecab41be67b8eb90d5c7baa563e2cca1fdd1e73aeab98d9a7fc7e9fff060000ffff187c9dd1db360000",
-		"aac60746a0f1604009acbd63f189cc9a": "1f8b08000000000000ff64cf416f823018c6f13b9fc23b595888c1f4f8beec4d6d6a2b52022bb7a1d01974ae73dae0a75f4676db737af2bffd9e7e87c4855e14a5a8a1a285243bd74809be0647a050719c3c376ac9c011cff3bf1f688dfc39bc0581b0dbb970b2365831984c895beaa768e3757bdfef8f32d8ca60ddbfc63d9cdd35b496bf97a07300337c7a56f67675beb2e45175ab6dd68847dc8cdc237d44c044c742d216593d4202b2a9bf587cbae1b495f7cb018fcb34bde86672df872e1fb5dc903158901ba21940fae53fea270000ffffa608e084f1000000",
-		"b25727d202d27507aa1ec8d432fa245c": "1f8b08000000000000ffbccf31aac3300c06e0dda7d0059e1fbc31db2374e8d274c90154fb6f10d8725054283d7d71baf40419857ee9e3bfb48c69de86407452be150ce4f640201a8b407d9acf19ea7217580f118db03e26760c9438c17cfb4d1c9b2d7f114fae6b414cadfef44d5c51f7abc9165679b14b532eb38a7ffda5b45b81e80ad831e40a5820facf55f418913b157a2ac38eaad93e5878070000ffff703512f3e8010000",
-		"b4cf17a6993658eebeb54a5001d78e7f": "1f8b08000000000000ff6492c972a33a1486f73cc5ddbb6e19d390e0a5264001c9088b7167e88e30433cd06e619efe56ec9b6c5a2bd5778eea7c3af5fffb7920f129ff079144528f2220c9831a8c521474088126574053081415f9c7747676a6d41f6e52aed3defdd0e36b0742a8d4a5edbb5d2c04061d3c3331690389126742f844bf65e9422483c4079b9420c8426179d321affe34a3732e25c919148f1a9c194f2def46497537cadce90e05efea85a40cd2e7e3964555d1b67501a76aef74b565cede0232a87806019378e46d346676996fb451fbe9adb4b6bf19b40b2ca966986a2e81cd3aaa99772ab0640fc6ba6fa60f0b8918e81fc30cd83294656c260b489e1394443d6f6b7f18eb1f6fb7434e66af03e9b3d6489cf1b63e42bf19bd5b690d8b515aee4c30d83d1b2609adedbdf2b37b55d03990a0fed2f6fc6fbef9d2ffb4371efa5eaf892e83f054d1a533111025fdff8e8168b050807cbc1cfea4c5b873aef1bb6b5aabe5361cd97a381bf0a292371deffd75135b72355420d668180eebf773ad9aee787d094479e3bf2f389b4e5191eb33de1f67d22b39d3e085738391496355e22c3163208235040203f50b30a03f97f4936802d75a2006800ef5a3af8348687a02ea45dfb9f17adb6d87f95d8e3f7dbf914b315991fd6647282756930d591ca87a55045a23f5fd2d0234c589063a015419911fd20db557abd75f44593f4c61ee2fbb7476a38969376e20a37bb2b54351c61a51e487d7f21a1ef29ebb7db301cefd6c84179e6873130aa91c683b9c1fdde366eb39c623e984e3bfd3ff5f000000ffff9099f8d71a030000",
-		"b604b6d273ca3102d16c5e01d33b547f": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"b6aa8a205096e7169733ca3f25e8cf8e": "1f8b08000000000000ffbccf31aac3300c06e0dda7d0059e1fbc31db2374e8d274c90154fb6f10d8725054283d7d71baf40419857ee9e3bfb48c69de86407452be150ce4f640201a8b407d9acf19ea7217580f118db03e26760c9438c17cfb4d1c9b2d7f114fae6b414cadfef44d5c51f7abc9165679b14b532eb38a7ffda5b45b81e80ad831e40a5820facf55f418913b157a2ac38eaad93e5878070000ffff703512f3e8010000",
-		"b82026ca74ebd5f523f5c818bb86dc9b": "1f8b08000000000000ff6493cf92a2301087ef3cc5dead2dc1911938eca113428c1218f9a778035410038ec34880a7df52a7660f9b53eaeb5f557f4927bfef0b11cadc5f98f821b31986903ca8c219c3ccc218f2b400c91014cc07c7358de3256864eeed9d49a7abc7e1b3901217095b5d766cac540292c934248ec2e14c418b082a398e63de93117c54b8318222c467b7cca8a8b397e52ddd90deae207ad6f2d08add323b21aae4b57d4b66624c66466f5b103c039710cfe279b2d16446a35b3233bfb89f4b7b9d58f17a6d11a95749a08b03b5bf949cf6c2a9dd2e0be1604b75e0160cdc8aa467b1815b28b5a53a3e19fb619cb63d1e61796fa62048421071c87d2ec9b30323f2c34a36fdc7ae3687ac1625f71369c3a3b620520b93cdbcf09b6599d5ee8792cfdc8efb20ade2117088dc7779ed8bbc8e07eeab92ca6feddeffc74f4ffdbbbdf2d4473b8e3845c395067c6e424128c6df7b4916a032401c8b89ad579360b978778e1a9e68ae1877b6a2b633b3ddb79e3a16d1c5d15fb5a1eafbb4b1a9695ead5c15a47fbdf18b1b90e134ef5b7be37787abb1bc06e638565eb3aa9430440e87f97d947b4b123495ebfb8c178505fc7e490b9f23381a042a008edb478ec9f599e31530afe54a7578abba5dbe55f5c62f1b62686fee69820f588ad26dec5d1cf6733a15bdc1e1f2732c041c835a00271156d81aeb3c35f69d4e171e14adf91244932a10086f8f1e4dbded327d75c72d36daa3f12959b191e179faeea859bd34c55b142a024f23c394c073753631ca62d568f397ae0599247f94c76b27aef5ff0ff81b0000ffff3ef2fddc1e030000",
-		"b91577afee99582ac76023ddec1c3de9": "1f8b08000000000000ffbccf31aac3300c06e0dda7d0059e1f6fcdf6081dba345d7200d5fe1b04b61c14154a4f5f9c2e3d4146a15ffaf82f2d639ab721109d946f0503b93d1088c622509fe67386badc05d6434423ac8f891d03254e30df7e13c766cb5fc493eb5a1053ab3f7d1357d4fd6ab285555eecd294cbace25f7f29ed5620ba02760cb9021688fe73153d46e44e859ecab0a36ab60f16de010000ffff93db101ce8010000",
-		"b9c8f6b8a8f5479ba10f39f6f2d04c75": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefe7b375431b050961ec0240f64297122d74888d3a394850b94d1d6b33fbd734d9897750c4447e56bc6486e0f04a2290bd4e7e594a02e3781f510d104eb6364c7489123ccd7ffc8039e5c5ac6106bf9ebcba1a16c07b3dd59e5c52e55392f2afef592e2c604a20b60bb6b0db04074484574778cbb127a2ac17e50ae7e9cf00e0000ffff1e34df7fd4010000",
-		"bc0feb967285bdc87ad50170d154de57": "1f8b08000000000000ff64cfcb4e834014c6f13d4fd17d63303289edf2cca5034ecf58a6482d3b8672134da9c101faf4c6c65dbfd597ffeef7f0372a64a4173b13a590888512c75bf5309221d40290a2a4f345ee91aca11692b1ff3f8a90cac7311f230a715cbf5a5f2d49a6b79f9cbc77137a9ce7fb54f5136f6c5b49ff79dc1c76d96c4f65fbd518d00c2081be6acaa3dfb5e63a07ebd80d49563c99d3563b1b5a8f0f48368a2dcba138af8a433f1957e575a05f0c96df1f8abf3578fe414bd3ce0617c7f2ce152b72ed67ef06109adfa37e030000ffffd6be6a2cf1000000",
-		"bd09959288ad01a00225c2a79c742814": "1f8b08000000000000ff6492cd92ba4614c5f73c45f6ff4a0d228cb8bcfd01b46337833660b3034b1b41071de4439e3e154d32a9a497bf7bbbceb9a7ceef7f3e447d267ec3742399c73048faa406670c0715c6b0df6a181802cda260e58be2101c50da066aceb3a5c8ce92c007d2fa56d655f81945042a74e5513b1838522489229f0eab249ea8e488fa308b2946fc23b2bc364fb37e7f71ae4ad294a3e839432317b1e5758c660f43a54e95ef44554c34e688bd3e977c9dedcab2d8a136db3a556199a3374182b4481070492ea25c5f125ba5b3c128fcb853d6f2ce91bd23920d9cb04148b079c506ee353b22f993f1ea1f36e4155d73a89f62062a394e123ed209362f052d712dcac23f5f8af9aacb533a7a15c4afd95e924494c509f9fb8bd729eb3c19ca72474a207c2db41259cb47e6278f6cc7c68040feb76dfac3bb7f9f67149639a41224d2fbbf1266e8276d8ed02030c0663a3651ef93433cabcf21d93a4d5d9befc99bb68d43d72fbac5f671fb2c30ae4e0dd5b74391e39cb2537d0ba7f2ba8e2f9de9b3483962e1bd87d1ecd75cbb6abdbc2fddc63407633bbdec07918be0e852041c231b06028a241b534214bc21600310f87eee6d395d1138694cbe099b7786bdb076de32cc4c958d9fe230a95e7fe976a56436a649ec1e50efec8fff2d91160c08f2e044c158785e57b0fadcd9a7d5ed97e784b7e42dbb7f61775904d6d96cd5754feeb9fddef41433bfbd374db3c987fedc2f58f9384263a0c221e3d56de79746e075a5263b2c321d37c6b3e95490ffb7ff8f000000ffff663ce7aa1a030000",
-		"c01f089a7aa72b89451acef20057ea0c": "1f8b08000000000000ff64cfc14e83401485e13d4fd1bd31a1014c59de81cb65ec0c851131b0036aa09d4a648a023ebdb171d7b33af977dfe3df18124f36a9e205e4b8d96379ab96e4144387209924b68ef4225d1f3aa420f8ff33c68cecb99e39832ceba2fa5418ca9fd5395e9d2176acc21e85dec6eb5255e1cff4109aac8dfc6b7b3003f50a9200206f0557d3f6355fe6931cb53697b2ebf55554debe5181e53a1f9fa9b77eeda4bbd870186ae3a9279abcef464ad6be9d7b8e28767e747179ca8e833832782f7583d60d8049788ffa0d0000ffffc84829c9f1000000",
-		"c39273ade175ce73bd1bfa779e713cbd": "1f8b08000000000000ffbccf31aac3300c06e0dda7d0059e1f6fcdf6081dba345d7200d5fe1b04b61c14154a4f5f9c2e3d4146a15ffaf82f2d639ab721109d946f0503b93d1088c622509fe67386badc05d6434423ac8f891d03254e30df7e13c766cb5fc493eb5a1053ab3f7d1357d4fd6ab285555eecd294cbace25f7f29ed5620ba02760cb9021688fe73153d46e44e859ecab0a36ab60f16de010000ffff93db101ce8010000",
-		"c71e7dd6f5874e0a79af0dacece2b19c": "1f8b08000000000000ff6c93c972ab381486f73c45ef535dc1e3b5974713c88e88850558ec626c2b66723c60014fdf1572375df76aa5facea753251dfdff7e2f443d1efc8369a838e318141da82338c7718f319cc6062c4760b83c4dbcf909bf5d5139bf9ce6a23eba85b9e5b046c65c3f8bfc7d2325811c8d85bc5b074b4d62293d6a5771d4532510f56014518cc45a8ed9fd23499f5935fbd28a2602c9a1865a114463d6709a768e4e66f9c72ec8f73d4d05ca7e0e7f0ab99fac6ab19d95478f3d32af2ddfaae0b957f424903b38d08ae4e0b5bda3c7b4f9bb0c4766dd4e10e80489ec3be19d20e88359b7ff617c60ce3714be6e710f2b648218815650c64a84c2d29feb716abf884edaafb45a76fbaafc14a1b60c869aef503b523a999ab05e7deeabe02b1b074f111696d94158d3163db32a6bdfaa78aa9391dd7b51a3c7cb870817d6979a38b19484b6a9bbf7835c6f677f975961a9d5fefa92f23e773148cd7fef89033223d200addfcdc8a5bbb0db097ed835254b17f08a7f4dd56639b96fcae73cf68ecdea6d3a43d45d93d3a14955096c7b75ce8b55df258dbe1c3b51ed02534f45bc3e2d0e5170f3f03a7808ef6e89d1240edd0d48ff15812460bceaf7340ece4aca61be18df3d901143566064cc0d19ca90cc0828d87c3fae1f0a04a705450ac87723818b9f06c4c833c268f6e28e46b2bd050f3f688bd9c42f33f7657e58d948a3659f2bbcad51b90dc306abffff48074cc0812006670aee797769e5586a8b660bfafa5efaf35f74776edbeea55b88a49ed4fe55b619dbe70bccc9b939235d3b73b4a1d3b83e4fd4832b1a5dd6b7515a2c19851bbb1ea6799a159d7686d8d080fc19a5ff020000ffff881eb19267030000",
-		"c9b1378e2f1f223ac11f5655e832d308": "1f8b08000000000000ff64cf414f833018c6f13b9f62776240ca601cdf426961a11bb43429b729c262945167daf0ed8d8b379fd393ffedf7f43b4c68c577e7ae5220c9ee48f4a37a4d4519cc041adc50bc192a9a388399d03cfffb8e304c4377711586b69da7e4846264c63b08157e0adf9bc9a007d79f649a2896ec0b84dcba70b586b05d3be03980d8df9c4c0f373d90733d0552f7a9ad4d92a5efe5d462cfbed63a5a7ae19b6e8927933d977cf313864611851bbaf66f56ae411d60abd8fde523fa1ecbe2620f5f47ef0120bcf88ffa090000ffff1f534af0f1000000",
-		"caef80555adf321928ce83a094f88b58": "1f8b08000000000000ff6c93cf92b23814c5f73cc5ecbba61ab5b13f16b3c8cd1f881a3088d0b013c4208a62ab0478fa29e9a959cc7c59a5cefde5569273cf9faf05d4e1de1f980621671ca3908eaa2138c75b82313a140a690e487189274d8aaacd61776ccde559c129cad93b414b50ea569e2a7f2d2541150c42deb581654222291daa17d176a0a100eaa0c99662104b3965f75d9cb6796d354948630172ac4127bced943d394d7b2389ad6af7e555d9405301f9cfe152c86cb6b888a3752e1cf6c89deebcaabd360b692ee03a32a8136132a54fe3f7202a98367b41502fc856fb84f782c08e6973f8d1f8bf9a215cd1e1012d407911a02444e7281481d0f4e7699cea862471d7a4b5dd67f5b91441a2191a6b2ed593d048e20f155c1665567b4d3ef55a119c34d323b0a41db4799df7ab3afa48e289ce9ced3399da0f1168eda8912106edbc2ad958bf07d949539db8cb6bca87cac44826fc9f3d41322752216accd6c1a75db5ddeaf0553c6ed79509e18c0c625f3bb26eaff362b50a97bf0af3064d4f6e753f6d657c798392b99f1f61c4f785512c3efcd6d9ccd9e7533a31b9d83a826ee73d8473d7e475cdc05c23e9be03920429a71660be5cd82fa41c6d3530be3b486e19688141a96f509481cc090ad1faf5b16e20001d7e510811793512f83436204a1e0113666cde2ff1f1690d70b35b4d3133cdaa4bddaffd84571369b1ef9417d9d59a36c17f2751792e22400d74046517598dfbb83ae8f906d1f9b278e38f667e7d0bdb9afb7abff96e9277623f7da5b982c4cccbfd79161dcada3f0eb131e8b75970acecde43d75dd0d2895faead27fdf627ea2f638c0bf5c8ff23f477000000ffffed6d643e5f030000",
-		"cbaf2edfce5abdc6baaafa9e5c308eda": "1f8b08000000000000ff64cf414f833018c6f13b9f627763d220617a7c5b5e5e8a29b45b476437185a74305c74ebf0d31b176f7b4e4ffeb7dffddf38922c167a252bb0b878c6fa5a0325290387a0b8223e1f69ada227704842fc7f8f1927e61b2f3918e3748661bc45a818e9ba3e1d02a3979789e5bb61dd4b117590da49a9d1b29fe6b55f412100cc7b1aedf70f8f401adbaf190ead4dab3187cec8326c83cf8fa6f02dc4e1c9bec5d370de96c7b34dcabbcd1c7555feb2334e9198fa7ad4df597e61e496c9460f8a05570016c92dea370000ffff15b28291f1000000",
-		"d0069d67da585e5519fa6fc82125d545": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefe7b375431b050961ec0240f64297122d74888d3a394850b94d1d6b33fbd734d9897750c4447e56bc6486e0f04a2290bd4e7e594a02e3781f510d104eb6364c7489123ccd7ffc8039e5c5ac6106bf9ebcba1a16c07b3dd59e5c52e55392f2afef592e2c604a20b60bb6b0db04074484574778cbb127a2ac17e50ae7e9cf00e0000ffff1e34df7fd4010000",
-		"d20cd817653eb72b0d26c22444e1139a": "1f8b08000000000000ffbccf31aac3300c06e0dda7d0059e1fbc31db2374e8d274c90154fb6f10d8725054283d7d71baf40419857ee9e3bfb48c69de86407452be150ce4f640201a8b407d9acf19ea7217580f118db03e26760c9438c17cfb4d1c9b2d7f114fae6b414cadfef44d5c51f7abc9165679b14b532eb38a7ffda5b45b81e80ad831e40a5820facf55f418913b157a2ac38eaad93e5878070000ffff703512f3e8010000",
-		"d3dd55aaf483d751978bf1a0b70a8dc5": "1f8b08000000000000ff64cf4d4f833000c6f13b9f627762781975dbb1a52feb682b7458336f134987314cd6b10e3fbd9178f3393df9df7e0fbf438471b5283537b0268b821ce61a48ceb6d012289164681ad85e661b6809cbf3bfefc916b1d81f3d47b0aa6c64561483a4bb1e45f7fa49e320dd374e5f841e7428c2c2284c37a3e7a6cdd6eca4a1ca21ac5f92f2f1e9a378ae581d2583326eba7defba3015c25d9bc09f071deff4b995a45c2dc7f1fef605a8ed41d4e324a3a1bda5a8131edddbd35a1f961737e1c6f6e01d04338028fc1ff5130000ffff75f2f2bff1000000",
-		"d40ea6ce4137492253ffdfdbc89f40e1": "1f8b08000000000000ff64524d93ab3610bcf32b727f95f217dee7779c418295d7922d2cc4c70d580c86c5761683587e7dead9a9a46a33c7ee9eea99aefef3f720f598f8c3a1be622e7340d1076a71c61c6f721cc8bd120c4328992c8a309a9d5633bb3dfb7db921c96ee60635bc6159fe5535f5fe2025811a6f5c76c672644cb4941e355b1d4c5471a41e2c02ea207f934bb74bc364c8dbf52d5634e4281f1c8e5c044bb76734f9b2e2705da791a8b389061cd973b9e2bb24aaaa2cc22e39aeeb6c391fdd093496422370455a51ed5a6dc7e1c2589917f4f1f2d79da31d11c50c27cc080536af99e1ee35228a3f305eff8b9964a23b0ecdc3ccc28a3b5af3914ee03f1d4ae534a2cabc8f365b6dfb34a4a35b43f0e47245b4a8b2337a79ebf6f1f263b2e2e566241388a7a053d88a2e0d75ff4e68cab17cb88031be0cfd7b1aaee1db6bc60a15282cf37fd265f85fd21cd10807c00f83affb3e5d8c432da2e1103189c75bac4eec7acf0db38a39a777d10aefb2f54f676eb3735adcdc0fbdf3c9d7cef4db9e9ec66daddf9b55f4c518361fd743380b5fcaeb7c6e8e13ecaddfa7bfca0dc2694311b88336180231d1fe5c817c9d213003043e1fba23a75b02e7d2219f84ad7afbe732b2dc5ffb641e27e34114533c9497b2dbc62a19431d6c0a1cd6f9897c2b5029181074e14c61dd7c2eac377dc66cf17a8957dda1bbbdd46e53c576764cfae1f2ae65744f1b3b1f0adb616ebd07033fec899d887f9d25b77ab1bbfee82d2ffd29fba24a2fe3015fa4a9f4860fd6a3e55490ff37ffef000000ffff4e18e95916030000",
-		"d45cbc426a6786484a5240c19df38172": "1f8b08000000000000ff6c93cf92b23814c5f73cc5ecbba61ab5b13f16b3c8cd1f881a3088d0b013c4208a62ab0478fa29e9a959cc7c59a5cefde5569273cf9faf05d4e1de1f980621671ca3908eaa2138c75b82313a140a690e487189274d8aaacd61776ccde559c129cad93b414b50ea569e2a7f2d2541150c42deb581654222291daa17d176a0a100eaa0c99662104b3965f75d9cb6796d354948630172ac4127bced943d394d7b2389ad6af7e555d9405301f9cfe152c86cb6b888a3752e1cf6c89deebcaabd360b692ee03a32a8136132a54fe3f7202a98367b41502fc856fb84f782c08e6973f8d1f8bf9a215cd1e1012d407911a02444e7281481d0f4e7699cea862471d7a4b5dd67f5b91441a2191a6b2ed593d048e20f155c1665567b4d3ef55a119c34d323b0a41db4799df7ab3afa48e289ce9ced3399da0f1168eda8912106edbc2ad958bf07d949539db8cb6bca87cac44826fc9f3d41322752216accd6c1a75db5ddeaf0553c6ed79509e18c0c625f3bb26eaff362b50a97bf0af3064d4f6e753f6d657c798392b99f1f61c4f785512c3efcd6d9ccd9e7533a31b9d83a826ee73d8473d7e475cdc05c23e9be03920429a71660be5cd82fa41c6d3530be3b486e19688141a96f509481cc090ad1faf5b16e20001d7e510811793512f83436204a1e0113666cde2ff1f1690d70b35b4d3133cdaa4bddaffd84571369b1ef9417d9d59a36c17f2751792e22400d74046517598dfbb83ae8f906d1f9b278e38f667e7d0bdb9afb7abff96e9277623f7da5b982c4cccbfd79161dcada3f0eb131e8b75970acecde43d75dd0d2895faead27fdf627ea2f638c0bf5c8ff23f477000000ffffed6d643e5f030000",
-		"d5857b856dbbbe02c6e44e0448d11029": "1f8b08000000000000ff64cf414f833018c6f13b9f627762ec708bebf16dad2f95b542990e8e9841191b2ea351563ebd9178f3393df9df7e77bf6302a55ea446bec34e2c1251ce35501263b0021453c8fc1573b5a2600572fef74711332463354a0659667944e37a7af8a0e7f4186efc32e0ad3950a54b5ebaa37f9d887faef19bec2316f5ad01cd01f26bd63cae5c4d6c7f6e0e4dbe57e9cba620a6259a421f7caa24be4d4be8b148fc69fbc60639d4ee5419d5adbbfb4b5939e1305f6fbfdc25bc752274ada1bb62b0c10c10fae93fea270000ffff6cee10fef1000000",
-		"d60243651092f9b1c9f7e06c4ab643c9": "1f8b08000000000000ff6c934d73b3380c80effc8abd67769ad292b647198c718a7903980f730bd09810c8574b4cfceb7708a79db73e691e3dd68c46d2bfd34398d0e01f1b479cbad4068e1fd46094daa9b66dd8ad24288a40d208fc2f6a1e5feab7fd7b206079b006110f75a56c29e8e7a9a0ba5d625054d52df60d060702cf09460db3d3948d58438464902290dc3e044d49babe7c590fdb0c8f6e0bc99cabb893064db947c4a87a771066a785f93e120ef92c9c38261ff742d3a1c89ba6ccd177115b6d692e474f433d3b8cbbc7e8dba8cca0f1fb5f65e53555c05a50ac8567e6c8fb146713d30f769f98f1802d3259f8adec5038691812acd669a23167083fdab311fb0c4df77b9b15b7aab7ce82e38ca1f09133d0c882c474078a8bbbc8ac769b076da971c150357f6e5858beac8f6c6f755fc4fda9c8d8f97d702b39de31b424c624c1c8b29a8c5a9878f85d4605438ca0fb85c4ecf5032426b63dc70628ecc1920282cba9bf98e6f3e8f0588c1fc3225f8551cd6fb8f413bedc26deeec5faa1f83ccaa6ac446787eb36f0de1ac3b4fe44d7be382eccfaa9be36fa2a2bd97b815a0c718bf6595b727465f03ab5533b0aa327154e3b80ceed3c0dc38b133ccf575e91c42e0a2b07a6855102a130f14061e528e1a4d19243e83d2148144c85349ce702e12ba6329469b761e951036d65e61f164d79f15efbcb1e5011adc52abfe4474db6e37e73b2e4ff36d200459d48818a804a727b769a7848de3741753a7b92d1ac14ab988d9b3fe9f96db434f157c7b7c8fbb4de6d8a7e365f673f3130da2d76225ded9fd6f9767beaf8adf66f5dfc7338f168150d03d1e9ca789c0d0e9cbf4fe9bf000000ffff25e62de567030000",
-		"dbdb49499f1ead0a180879e3934aebd9": "1f8b08000000000000ff6c934d8f823a1486f7fc8abb37372aa38e2e4f4b81aa458bc8d74e70a416fc18500bfdf537eaccea4e57274f9f26ef9bf4fcfb3c8838d4fb07133fa036c51090173518a5782131863d2e40510405f5613985591d54553c8ab6ccb1b5ced65947152e12bab8a454cb01014555aec9d260503a30dc1224180e43d6120d3e2abc104111e0d21399539db28ff97d1791d696b07ddfe581157a223b22c7c84ff63d312b9d98d3d609207e0b978038b32ed5e49ec64264316ad2cd5866e6a0752dd8bd1d1610d313c6f2f4a7a85c917b4c82621286cc2abae71c3d997eb1ee9719914435e38dc23cb142ce1da2e6e156938021f2aa86115b70d36e7651fac84fe36b12908821feaedd32cfd89af69d92b44ba2b1dcc59ecc34593150efc72db393c8bfeda251eb6ad8ff44677669975954ddb933eb8cbfe2470104a8c8bf4529576b4e112a8a9f9921a43c0ce0ef51926c3ebd7e6e1c87bcf8c49b9aedafb7b8fea855a7bea7b1aafb2939ab0b3fb076be9fd0ee56853b91282de5575ad159d99b3f2aeb72f63c6352cff4657a2b92afebe93218a88d86d533aacba7080e53828061340265416285fe2000eef611500516d44fcf70378ccc2d3816185ba6bf6ccf877d63da7c80c15ff7e478fa7d1277b9bf0eeab03fdf413b5a4d7c2e242c7e6b196bce2d283c0a16b2e1486094ae85b4b857d575dcc6713f2d97a27ad0dadcc2b23dbb75fa280ee692d68d5f62ea5e1c8384935bb8ea0e8d5be5e2d12c6028a0a74ff6bd49bb70634d58d6b3cd28ee8cd7cf279ef5ff6df82f0000ffff8142178a2a030000",
-		"e02a3432c9ae22a1bedd91ee03ea27a8": "1f8b08000000000000ff64cf4172823018c5f13da7f0021d530109cb04be8654039aa4b12c511b40da38542ad4d377ea74d7b77af3dffd1e7e4781f17cb691dc100db31594f7ea09ce325203115430fadd33258298d4c092e4ef8f905186c66ae4946cb73512249be2341ed449daa2b11ee2d3eee58cd5eb99e3cf6bd41ef5e5ca515f1c8e4523499e10a2d7b501b9089d0deced529eac524be386a7f0a38a06ec71fd5c75a95cb4ebf7b9d1bef3db3e00344fa7c7afc8eddebae5be5ced9151c8e5375f779b49343ec687d0bb03204fffa37e020000ffffb868e0b9f1000000",
-		"e27ac34014546c822718464a5252429a": "1f8b08000000000000ff64cf416f823018c6f13b9fc2fbb22081293bbec5f2b6c3762d904abd6948ab128d9829ebb75f6676db737af2bffd5e7f47287239533537d0d25945edb3468223034f411081248cd888ec1d3cc5a2f8fb136504e7d36ee204b4f69b361f8fc987ee93d35a3b7b8db4f9929bd5795030fa39979896fb74c8d6e188e150832c00da4b933bb617df4a11618b6db50bae4d192bdd744b6e11f6f64d959d89ab976d7fe8e66e21b3ea6cef79fde8f443c4f19083ff6c626a4cb724a77059de17572459f40450b9fa8ffa090000fffff2db108ef1000000",
-		"e307e2ebd699c0a2667bc1803f495daa": "1f8b08000000000000ff6453cb92aa4810ddf315b33726446c6feb6216590fca520b1a28d07227b417047c74b798c0d74f8813338ba955c5c98c3c8f88f3e7f3112ea4f707e5a196aea4a0f9805a4a4afa51520ac728079404721982d7e7b7fb6d39fb5ea71abef4859951f889487323d7d7bdec4b9b034a3c68beb114540226312785a249a25ade4348722f21906b5a79452aea733a5d35872d6fdd12e2d72cd32cf18af44484959dddc638756f9c79eb32885e0b574d9de4cd6c27988ab831cee2aec20cddc0b0240818c75969a2597d14eeddca445b6fcede23d57074d1ee14834eb1187d263bc5c8c145bb7f61f25f4c89aaa53dac9e641601a3a14eb40a15f21783e4786366dbdef6e745979eeb4285065d18664b8e136db66f79785915e9d9bb5999e33d541820cf878535c7fb20ed3f657ca7483ce404adf28dc39bfdae28d21df9d947b3d24a1d1bb71a34c9b3afa22afd8f401292e7fffc1521e8518088f468323fdadd488f94d5467dafe0d39e24ef960e96a9a8e8c5f58b4724a6df55bdec26e3ddb46a26ac2e61f16ee3c99eb971de293e1f3524bc56fdaf75188fe9f1f7d5b1d13af4e03fc358067302bfe79c80a27030b87ce611da3521837fb9464348102f01a5a0f4474010bb0415b114cc9ffe3e397232c6c055a09e8770fd3ae0132ab9f47f54797c2f1ffb6c67cf2e6171e1f3c9bb771ad123b5b02ebc8bbb4f74fb26c6753b577015947e8948bd2d0635760e8ac75406cc46db54a391d7463f17f3dda4ac6f3fc082c9adec3af44e2c5944a739779b9933429907d347537c6cfceb21e1756ad3a31699ba399bcd61e5df117e71c48d338d036b5c99bfaca116dc63ffafcadf010000ffff779d601347030000",
-		"e4f0018d725423d11cc6b823e581242d": "1f8b08000000000000ff6453cb92aa4810ddf315b33726446c6feb6216590fca520b1a28d07227b417047c74b798c0d74f8813338ba955c5c98c3c8f88f3e7f3112ea4f707e5a196aea4a0f9805a4a4afa51520ac728079404721982d7e7b7fb6d39fb5ea71abef4859951f889487323d7d7bdec4b9b034a3c68beb114540226312785a249a25ade4348722f21906b5a79452aea733a5d35872d6fdd12e2d72cd32cf18af44484959dddc638756f9c79eb32885e0b574d9de4cd6c27988ab831cee2aec20cddc0b0240818c75969a2597d14eeddca445b6fcede23d57074d1ee14834eb1187d263bc5c8c145bb7f61f25f4c89aaa53dac9e641601a3a14eb40a15f21783e4786366dbdef6e745979eeb4285065d18664b8e136db66f79785915e9d9bb5999e33d541820cf878535c7fb20ed3f657ca7483ce404adf28dc39bfdae28d21df9d947b3d24a1d1bb71a34c9b3afa22afd8f401292e7fffc1521e8518088f468323fdadd488f94d5467dafe0d39e24ef960e96a9a8e8c5f58b4724a6df55bdec26e3ddb46a26ac2e61f16ee3c99eb971de293e1f3524bc56fdaf75188fe9f1f7d5b1d13af4e03fc358067302bfe79c80a27030b87ce611da3521837fb9464348102f01a5a0f4474010bb0415b114cc9ffe3e397232c6c055a09e8770fd3ae0132ab9f47f54797c2f1ffb6c67cf2e6171e1f3c9bb771ad123b5b02ebc8bbb4f74fb26c6753b577015947e8948bd2d0635760e8ac75406cc46db54a391d7463f17f3dda4ac6f3fc082c9adec3af44e2c5944a739779b9933429907d347537c6cfceb21e1756ad3a31699ba399bcd61e5df117e71c48d338d036b5c99bfaca116dc63ffafcadf010000ffff779d601347030000",
-		"e4f43a695e7ec5f26e04e3849437f7ab": "1f8b08000000000000ff6c534bb3a23818ddf32b666f4d5d2e235e5d7e810403060d445e3b4004117c7135c0af9f52bbbabbaa3bcbf3a8e49c3af9f77910b6a8fb8f813d41093540e017aa304a0da7360cd81925488aa0a4dce9a6dfb2d99f82a020ce989ecf5f85d6d7e0a0b2bc56c77abde1dc841a8d8c775231786c069c5b58dac176c482216cc1e7161b88395c235d1a268fbcd52fb1c02143fcc5a19eb95b8ddc294e06250ef53a8ddc3a1b71c250fe36578c67ffd927e6eb4d6191efdcea9b55eb3e32817386ce2f0df44cc41abe2b7f174241a43a301306666ee5daa40333514aa43abe31fa135398257b63041b956e802016d00482794ce277348ae5c58cc3fe92b48b216b9b8a79b124f0e296587e0a250ea7a577b2abac752fb9e63e189f4bf36d5e6199d499d534d989f7cb1176ef4b18234d302611edb9b5189464c4f724aaaa2c425de2eb75a6a93214205099ff689ca25fed3384a46b00f857beff9a76855a2a6db3dfedfd906dec79a47a95ea2ea03d3167d98f9fd05a91331c575b74a3b7a23ba61eabf5fae31ca71dee2c5f5fddbbf344e96b3ce92a6f21a25b795655e98fb07e3e75c9e708f6738c8019680ad27ce6f654017cf981804a30e1f6d4294b9f61db84436918a6e6adfad37ed76984ab06789b49adcfaf6d75af7717f5167cd829f4d3f5cce3d56fa3525eab2a5d0a262270c0a037e43153cf517b493710647ec9bf0fb3593ce5681e3c6e69511c67b5d8880375728312df55ee41beb95d347b3153aba24d7aba08a656e84df2c29cef079bc4c4ef7687eb56792d1fbbe69fbfe1ff000000ffff06a3d8d62a030000",
-		"ed2ef847f4e4ae637e5cb833cc1e8fcd": "1f8b08000000000000ff64cfcb72823018c5f13d4fe1dee9988ea2b84ce8671221b10994db0eaae5d25a908964faf69d32dd795667febbdfd3df08502e17af9a2738864500f95c1dc129c335604104253f371a89cd1ed7407dffff5b6084225b5a4eb052353a4df22bfc7e479c8da69bac630ee7ed106b233747752d64e88d6d5d35c573b26d1b8da58f711c0768fa74fb0a86617f5e0af7a34c538f747d979493761a58f7872c3d8eaaca709b5f507ef294f6849f2d77ec62dedce0de996877ef6fd155ae195a852b5ba425383300e4cb23ea370000ffff12ab3c93f1000000",
-		"ee4a1e5bb3ca7a1b519670be19e835ef": "1f8b08000000000000ff6c93cf72a3381087ef3cc5de535b063c7692c31e5a420861378930ffcccde0584436b6d78c23e0e9b74ca6f632a353d7d79f0ebfeeeabf1f8f302ea2bf288b13e10b0a099ba88542d09d4729a8a5022308281143e4c74bae87b0c8bdeeb488db7c7d6f16c250b515ab4b29466d3330c2ec355b5b08470e4eca488334cbb06723c44445190195d063d454fcd456f3f0becb59ef6b48bf7b75e26551537d126ed5ad7fdfbaa771ebbef43c81e25bb8248cbf0ee5c8ee65d1345541ba72b3d0956bf7c108fb6f0713ff1c7756ed46cdbafda36c82a68e5083410d0e7a6a78d4f9838d131b1ecc9aa02615cace50b9f53229393361968e2c41c2a67894e04aba7eb7cbcbafba5d5cb709cb91c8a967911ea3d4f5ef8295c3365fe85d11e96a640724f624408f79cdb353ade15ecdc3336e16a70feeffac797f5ab7d1975525a444829c0cfff20dfe7805c538a5bf6ac302b005104cdb8f6be1a37d665b6ec48ba0f5e6587e326f9d5b45cb6ee46044fe5204b48ed5a5163da99ef6efe170f4363ff51370272c9c5d2d9e2f9fef97d9e7aa9a3766eca2d6d5aaac8da54a693cb5f5b2d87e0719cc08480f54ae7e45d887524e7929ed38c8d427060951ea4614f389ac3d0b34e0632d418c040e2f0c34003e6427dd0b238f48572082f37352641eef5cbb9ef3e45c7f6463f27cb5cf24b654307f8b305cd53c0b7dfe2344aaa6cf4cca06c913f5cfe45416c2fc79d52eb548f12d205cfe1f1e01a4602b409652213d3df74c7e7b0ad3b75b533e93b439b2e13ebbccb569c3d8eadec9e67599c8d957f076544289ebe8cc5c3e5c291fb82396fdf5786b661b573ab0f30ece6259746f2ddc0f22c27face99c58e4fd7e62ff050000ffffa426d89c7f030000",
-		"ef2a4270d032df898d1836ea3aec179d": "1f8b08000000000000ff64cf416f823018c6f13b9fc23b590651b3792ce5a534ac1ded6887bd319412354cac19964fbfccece6737af2bffd9efe9600a17c514aaa51058b02b6f71a304a726401b18491c48fe483ad36c802c1f8ff4f9027249a9a89264808ebcca91d2e74e6ad8b7b757441e471942d87a8cae67de99e77c3ebfa671ee1bb84b1978863842add4d7ec9bda95b3c749ae58d8e6f47e1557675bb3028a43d5f56f13eee5eea548485365bf666da6e937ebea766dd0b07670c37f52533a7d4a99657d21cc2830dee00e0e923ea370000ffffc1aa56aaf1000000",
-		"eff0d9dc52abb51a7ec5a11238ce4231": "1f8b08000000000000ff64cfcb52c23014c6f17d9f8217700ad38bb03c69c3490c09a12d1d588236a980620dd3dbd33b76dcf9adbef9ef7e4fbf2314b99ae98c9750d099a0c7a97a9223034b41128964683097e10a2cc524f9fb1d6504e7dda9e304763b2b2fd70d2b2bf2708b342822eeed4d25c3d815e3f60b047b5faa26974112f9075fd419a804206fef41f5e6e8950e6c383fa3161763b66dd43663589ebc97fa669a78b386a36f736edcc77ebeeec7d4ff8e597f47cb6ab712fa55f767dddf54bc94b8781c22cc3ebd094055fa1ff5130000ffff6d8c3507f1000000",
-		"f179ee367d1aca32235e1643a3e7bffa": "1f8b08000000000000ff64cf4f6f82301cc6f13bafc237b0c4259bcab1fffc51a02d0541e68d5652708e0d1256f1d52f33bbed393df9de3e4fbfc30cb85c6539afd081ad12f6f6a881e01021c790c002f03242215e42e41810f2f73d8b30ac7de339465abb241bcc7dcc00e812d37eae029fb665f17daa3fb4dc0b1eab70fbb9561551e5b1ed72240942da98909b588c6cd1e9c5a9b2d9dfa6f762765af7551d74f27e1eea963a4baf3656a76ebabd6e4c9fb7c7f499ee2ed7ed949cbf36e38c174b1ae56d842dded1e1103c004cd2ffa89f000000ffffcfb7ad4af1000000",
-		"f1ab4b8c5578950f33f858e42882f34f": "1f8b08000000000000ff64cfcd72823014c5f13d4fe13ed3112ab6757913d31081f04d833bad7a750005cb34d4a7efd4e9ceb33af3dffd9efe46b9906a12a7b2849c4f7c5eddab154ae10172086928e84f2fb2d05d0072c1d8ff37dca3c2361b23292409423e0ddef275406427e5bc88acd13927c57e7b8a2f2f68b099f61752b105eef5ad3da6a018409a694d70405e32d66d829dc30ee5918ac6a98bb91d5b9f1fafd9ee90b7b219c1aea3b37f6dbfafbdab8ba5f2d6c3b6a9fcafe71919dbde25a7f71bd3f56a36ac3a1559770057cb47d46f000000ffff0fd048c2f1000000",
-		"f476e643201fbf02f314ab3bfdbd701e": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"f5442f8d371bec39595e39de0f00865a": "1f8b08000000000000ff6c934b93b2381486f7fc8ad9774d69d36d372e6671120e31eae16b305c77821a44116f08f2eba7d46f663393d55b4fde54e5c9e5cfc76028a4fb07475f49477250f8a40649c9678a73c8df34b49281963e50f426e36c74fc1caf3dafcaae75107f61db729dc8599dcabe1c22b4b2cd7b9c1b043b01ef01b282781852873df84cbb2103adf8ce2d32b1afb28f69b38cb0734a085e73b9b243b7c8b64c1879e53489b9ef13d3ea8482f855a8158af13dedb149e3a2c862764917a3323387ddc486e5ab430a4db730e6d5ff16db4991bb54424b25bc93adef8f1c3d58ff64f77f981195ec44dea5e55e62879e27b09d86418f8a183ed538a399673a976594def26a744c1446c4bc977647ae11984e2331bd27d1a85cc66e99f5e8125c5e8b3bc25c84fbbcc40db1e1934147d16fd6641fd383418bd17e2d9c6b2ebafdbc726f9962293112ec7e120bfa1c8346c1f9efdce2048612d8afd5bcb356971e2dcbc04e4f77c7f374fc93e77938e0b7e892be973b2b5cc5d679b4379d8d3fa83ff429dd7e7762bce2563f78532d87d1dcf2c3707331c4cf6cd37c9f76c9645b2ac5e6049f8fadaeec16d9a0f51ef73dd136d0e3e4273e31d858082500f1a7e6ca90adb7233e03297f52bc89d5d8ec93d0f624c3c1d7cfe17e5a8a2fd73c2caeb1b5afed89fe3e3a5c10d4ff6a190c88c3500361c0a56737b73e4e039ffa7071d60b556ca2ed3d8886cbbe399ac976e29abe38d73a88c6552bb553fdfa365609ae396d43bffe38f03ea8409dc09e1eced575301eaedfd775744587f45fc6f3e5a36bfff737fc1d0000ffff0d4d09c82a030000",
-		"f851b30cd7d8d2fa213ef5333e93587f": "1f8b08000000000000ff6c934d93aa381486f7fc8ad95b530d345cedc52c4e4202694dec68e46b27b48208e247d3017efd947aefea7656a79e3ca97adfaa9c7fef07119f897f305929461906451ed4e08ce1b9c2183e71019a2128d80a96bb061fd4eeb85dccf7cb463ab419965f44e32261f33665636512d04ce72359181c8e3e581b824a8ec390f76484152a4488a050f828caccaf9becf5bddb46a4a7156c9e77b9f242516607e41b7943bbc4aec7c49ef5be82f829b48af86f433ab22e8dcb328bd12d5dbb55669b7de0c1f6e970456c511a8be6475107652e78059a576071af18ee737467e3830d7f981155e8cae54d639978a1943ed1efe166248a23f2a886119f4b9bdeb651fa9d37ee395124e2483e6bf75c181b9b768ca44312b9d536165536922507fd7cdc739a44abaf6de4f4c1089fbfa3737aa4c72caa3be9bf0dc64ff123050a15f9a53c56cb0fc9102a8adf3347480b0c2053db140ba866ef46af76b299242f22b9fca28550e0aad7685c45de499a336b6ef57dcaeada3eebc96457fa8e3bb507324e2ec3453881ebcbca984fad5be05f4748ba536b9a7a3dc2f21e35903304fb1941c03172407b9078e1ca54208317044c8307d7bb67046b4ede3d3814d8a3eb975374e8dc115ddebe35c1d434ab3e0de24f8b559674e93565bbac75edf3ca83f99f5ac687941e1422000f1138a028776b14e7b5a3f69351b517ee9bb1d5b757bd76a6e6d7f4d5b9bcfddab4f5d9db4b5690607f30e8c762314dcbf4bc756971ca649df51dcdd3d377638a65f87a6a7731c909f9cf78fc7c22bcbfb7e1ff000000ffff28913a7a2a030000",
-		"fac969692ac257e37c75e9ef5d18d8d2": "1f8b08000000000000ff64534193b23814bcf32bf6beb5a520ce8c87eff04242c860f2190820dc0019908151079d00bf7e4bdddaaaadcd29d52f79dde9bcfeebbe10a14cfce19040319739a0c8033538638e9c1d07aabe06cd10d44c82dadc3ef6caf786fc63e556bb0f4fb27c061fd5f5a5f96c7fefa4c4d0a281cb411b8e4c712c2525fa3d8e66a2382214cc883888fbd272873cc97eca7e7d4e154938928f1a1ab9882cf7c648361969b26ef3bd688b99441cb1e7e5866fb37dd3147b3464e1ba2dace5485b48512d62045c917d30949668b67d6c1b6962ea8246b7d4da5c39b2f75831cd31d34281cd5ba6b97bda63c51f186fffc57481c996c327351e921aeec4311fc90cc193a556cea7680adaf5c5eafd962764745b889eb552e15834c511d1b2776f466a75736abd8d2e86f079e0a41c2bb6ffa32c38690a0faf3019dd65e189360dd75d45ddab51d2b1dbf6e2a75028e38853345d68c8ed0dd4843ace3f7b4d3c583240223771ba19e47bd8af2f9d8f31ec8ccca3d62dfe4a0b3b62f8f4d50cc32de83feace6a2c2b9cc645425e97ab89e3f190ef6d9a86c3eb79771be6a95db91bcf29430389635b28f4cdc1beffc0016b82165a92fb4c9c5b38dc9fe58511797a507fa39ab8489618ea94f93a45069291079a68ac531c074b05d25b208834dc1bcd707e34903661b5acfd9df5622519c3653e7c1fea5db5b55da31cccaaa90e6ff93b9a90797ef17e47ba3fbf69e7ce70cad8dc2eef6a7008a003c91ae0ddf2cf10ae8bb19b2ee2488cfa98f94bb339adcc1f14273ff86ac6c9c044992fa62b1c419a17ec5ba4589cbbeaebb87e71f5e28554668f826011362befd508455199a760edc3af5fc6231e44e0ff47e6ef000000ffff4b4667e44f030000",
-		"fd03ae41ac3b3e5abc9f9cab9d491f54": "1f8b08000000000000ffbccf31aac3300c06e0dda7d0059e1fbc31db2374e8d274c90154fb6f10d8725054283d7d71baf40419857ee9e3bfb48c69de86407452be150ce4f640201a8b407d9acf19ea7217580f118db03e26760c9438c17cfb4d1c9b2d7f114fae6b414cadfef44d5c51f7abc9165679b14b532eb38a7ffda5b45b81e80ad831e40a5820facf55f418913b157a2ac38eaad93e5878070000ffff703512f3e8010000",
-		"fff3f20b73d6c3375509d3724ce1f497": "1f8b08000000000000ff6453cb92aa4810ddf315b33726446c6feb6216590fca520b1a28d07227b417047c74b798c0d74f8813338ba955c5c98c3c8f88f3e7f3112ea4f707e5a196aea4a0f9805a4a4afa51520ac728079404721982d7e7b7fb6d39fb5ea71abef4859951f889487323d7d7bdec4b9b034a3c68beb114540226312785a249a25ade4348722f21906b5a79452aea733a5d35872d6fdd12e2d72cd32cf18af44484959dddc638756f9c79eb32885e0b574d9de4cd6c27988ab831cee2aec20cddc0b0240818c75969a2597d14eeddca445b6fcede23d57074d1ee14834eb1187d263bc5c8c145bb7f61f25f4c89aaa53dac9e641601a3a14eb40a15f21783e4786366dbdef6e745979eeb4285065d18664b8e136db66f79785915e9d9bb5999e33d541820cf878535c7fb20ed3f657ca7483ce404adf28dc39bfdae28d21df9d947b3d24a1d1bb71a34c9b3afa22afd8f401292e7fffc1521e8518088f468323fdadd488f94d5467dafe0d39e24ef960e96a9a8e8c5f58b4724a6df55bdec26e3ddb46a26ac2e61f16ee3c99eb971de293e1f3524bc56fdaf75188fe9f1f7d5b1d13af4e03fc358067302bfe79c80a27030b87ce611da3521837fb9464348102f01a5a0f4474010bb0415b114cc9ffe3e397232c6c055a09e8770fd3ae0132ab9f47f54797c2f1ffb6c67cf2e6171e1f3c9bb771ad123b5b02ebc8bbb4f74fb26c6753b577015947e8948bd2d0635760e8ac75406cc46db54a391d7463f17f3dda4ac6f3fc082c9adec3af44e2c5944a739779b9933429907d347537c6cfceb21e1756ad3a31699ba399bcd61e5df117e71c48d338d036b5c99bfaca116dc63ffafcadf010000ffff779d601347030000",
+		"022913578553f0c8e398f5aff424e8cd": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"046e791738dcea5aebe00b7ce62dccf2": "1f8b08000000000000ff648f414f83301886effc8add0911036e706cf1cb47694a286c2adeba2a4c81116659c77ebd21f1e67b7af39c9ec75b470159be294af642f6b0e150afd07304c394b44004154897092b11c6a4054c92bf6f21a5e85b65192552b6f6f615f1eff381a0abc6b6d2cecfbcec909be82a64b68d247caa665e60282e63722a499e1022f9944dc6182d7d7f10cdf1dabb1dbc1d76375c1e2176b0a741ec1e2bca75cd5e03cc6497d4f7d20d3fe67da79e86f707736e0215ea6d98b2ec74d7c5a51b6ddd3babbf07f9f3ffa8df0100bb2f2895f1000000",
+		"05352be609d2333b35072b0926312e1b": "1f8b08000000000000ff6c93cd6eb33a1086f75cc5d94755535a68bb1c83314e315f00f36376011a1302f96b8989affe886475743eafac671e8d349a779ee68730a1e13f0e8e39f5a8031ccff0c960943a99761cd8da12144520690cc137350fafcdfbee2314b0dc5ba348c6a6568e14f4eb5852dd2d3128aa9a0e0706833d819714a3963959c626ac214632cc1048eeecc3b622fd50bdaec64d8e27af83f451abb99b856db543c4a8076f1466af85f931110ec54338724c3e6fa5a66359b46d55a09f32b1baca5c4ebe86e6e130ee1de21fa336c33618fe2a2bbfad43d681621dbc3057dee67f3e337d67b7991977d82193453fca89849b4511c16a95a51a7386f07d3c07b1afc8f47e367979ad07eb2438ce198aee35034d2c4c4d6fa4b8bc89dcea3645d8551a970cd577c1695954bdae0e6c67f5dfc4fbadc9d4074378ad38de32b424c62cc1c4f2864c5a9878fcbb8c4a861841b73349d8db27484c1ce7f13740611f9614109c8fc3d9345f26972762fa1c17851dc50dbfe22a48f97293fadb57eb97e2d324dbaa16bd13adbad07f6f0dd3fa135f86f2b0309be7e6d2ea8bace5e0876a31261ddae55dc5d185c1db3c4ee32a8c9e553467009dbac7360c3f49f163bff28224f65054bb3007460984a2d4078595ab849bc54b0e91ff8c20553037d2707a3488de309591ccfa35cb0e1a6827f360bf68abb3ff369c7780ca7825ece25c1c34d94cbbf5d192ff49a4018abab102150395e4fae2b6c9987eacc3fa78f225a37925ec844deb3fd9e97db23409ecc37bec7f591f0e45bfebef53901a186d175b91d9bbe755b1d91c7b7e6d826b9ffcee8f3cb6e371243ab38df96a9e70e8feff94fe1d0025e62de567030000",
+		"06d85c18514a60ea12973088713ba618": "1f8b08000000000000ff64924d73a2401884effc8abda752418488c7773e8031ce107400871b580a82060df221bf7e2bbabbd9da9de3f37655f774f5f3d743d465e207a62bc91c8641d22ff8ac71c6b057620cdb750e3d4390b3c05bb822db793b14379e9af2642e92a324f086f2fc5254a5ff1e04044a74e641d36b3850240a0297f68b281ca9e488ba30092946fc2d309c268d936e7bb2ce4ad298a3e07e430317a1e1b48c26374dc556996e44998d34e488dd05b8e0cb645314d90635c9da2a33431f9c1122948b080197e4248ae52932553ce9b5cc0d5b65ccaf1c991b2259cf09eb85049397ace74ebd2192df192fffb03e2de992437537d350c17114f1818eb07a38e41257a2c8dce3299b2eda34a6835342f8b86d258944911d90bb3d39ad328ea3a60c7ba004fc87a091c898df1237ba251b367804d2dfb1e9376ffffe9e96197a1f4b9028dffe6a98a1efb63942bdc000ab715f079d4b76e1a43afa646dd555a5bf462fb9a9edda6ed6ced6b7cb7b867179a8697ed965294e293b54177f2ccecbf0d4ea2e0b942566ceab1f4c9ea6b9ad96f3ebdcae75bdd7d6e323be17d808f63645c03132a127a048b4d22504de0b02d60381cfbb6ecde982c021c7e493b069ab993363e3ccfd4457c9f02e76a3eaf28fbc5928990c7114da3bd459dbfdbf23ca0503821c3850d0668ed366ac3ab6e6617179722cff12bd24d70f6ccf33cf38ea8d3a6fc935355feb8e62e636d7baae5769df1dbb192b6e7ba83594596438dbcdf4540bbc2cd568fa599287b5f635f4672ac8ffebff3900663ce7aa1a030000",
+		"09d1e68aaa4e6ad15bd33843db71e552": "1f8b08000000000000ff6453cb92aa4810ddf315b3373a446c6feb6216590fca520b1a28d07227b417047c742b26f2f513e8c4cce2d6aae264469e47c479eb1fe1427a7f511e6ae94a0a9af7e09ba5a4a49f25a5b08f724049209721785d7eb95de6939f65aae15b9f9819845f8834377279decaaeb439a0c49de62b4b412560147352289a24aae51d8424f71202b9a69557a4a23ea6e345b35bf3d62d217ecd32cd12af480f4458d9d16d8c5377c699b62e83e8b570d6d449decd7a84a9881be3cc6e2accd00d0c4b8280719c94269ad47be1deac4cb4f5eae8dd530d7b17ed8762f0502c469fc9876264e7a2ddbd30f91fa644d5d20e163d9945c068a813ad4285fcc520395e9859b797ed71f6488f75a142832e3c67738e236dd6ef79785a14e9d1bb5899e3dd551820cf9f0b4b8eb7a7b4ff95f18d22f1332768956f1cde6c3745916ec8751b4d4a2b756c5c6bd024cfbe8baaf43f0349489efffb5784a0470122d2a1c9fc6873211d52561bf5b3802f7b947c583a98a7a2a227d72fee9118ff54f5fc311a6ec65533627509b30f1b0ff6c48df387e2d34143c273d5fd5a86f190ee7f9f1d1bad5d077e1fc63c9812f83de50414859dc1799f4768d7843cfdcb251a4282780e2805a5570141ec1254c45230edfd7d71e4648881ab40f58770f93ae0132ab9f4afaadc7f94f76db6b127a7b038f1e9e8c33b0ce89e5a5817dec9dd26ba7d17c3ba9d2a380b4abf45a4de674f35760e8ac75406cc46db548381d746d793f96952d6b59f60c1e8523e1ee81d58328b0e53ee36136780320fc6f7a6f85cf9e75dc2ebd4a67b2d32757156abddc2bf21fce2882b671c07d6b0327f5b7d2bdeb8c7feacca3f0300779d601347030000",
+		"0c46924bfecf51a5b246cc8319e277c4": "1f8b08000000000000ff648fbb6e8330004577be223b423c829b64b4f1230eb60b0e75956e29450e55454a0831f4eb2ba46ebdd3d599ce099621c2b85a159a1b5891554e4e0b0c3cc9d91e5a0225920ccd3d3bca74072d6159f6f71dd92316b9b3e30896a50dcd866210b7f7b368dfbe68e425c77ad037a17bed0b3f370ad3dde8b869d22dbb68a83208abd7b8787afecc5f4a568571afcc303f7e0ead9f0831dc6bcf5d7b1d1df4b591a4d8acc7717aff06d47620ec709c52df3e12d40a87a6e6b2d5a7f56d98716d3bf001bcc53f200aff8ffa1d0075f2f2bff1000000",
+		"0e37a93aee3521e0dec21dda478d334d": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefc62ed862a0616cad20398e451594a9cca3512e2f4289d384147cbcffef46e2d639ab721105d941f0503b9bd1088c622509fe66b86ba3c05d6434423ac8f891d03254e30dffe13c766cb29e2cd752d88a9d5bfbe892bea7e35d9c22a1f7669ca6556f19fbf94762b10dd013b865c010b44e75c458f11b953a1a732eca89acd320c16be030093db101ce8010000",
+		"0eab4e323b1df946f7bedbfeb9c50788": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"0ef4e11e63f019d618a466f71ab9fdf6": "1f8b08000000000000ff6c934993aa3a1886f7fc8abbefea6a1c8f2ebf4c10edd0060318762d6a5a26470cf0eb6fe9399b5bf764957abea75295bc79df9f0b518f07ff601a2ace3806459ff0dd119ce3b8c7180e43039623305c1e46def4803f2fa89c9e0e5351efddc25c735822632e3f45feb59292408e8642deac83a526b1941eb58b38eaa912887a30882846622987ecf69da48fac9a9cb5a28940f23543ad08a2216b384d3b472793fc7b13e4db9ea602652f01ff08b91d2d6ab19e947b8fdd33af2d3fabe0b155f42090fb72a015c9ce6b7b470f69f37719f6ccba9d20d00912d92fc23b41d037b36eff9bf117739e50f8bac53d2c900962045a41192b110a4b7f5f8f537b263a69cf6935efb655f923426d19bc66be43ed40e9646cc27af1b3ad8273360c1e222c2cb32f61495bf4c8aaacfdace2b14e0676eb458d1eceef229c595f6ae2c45212daa6eed60f72bd9efc5d6685a556fbcb53cafbdcc52035ffb3270ec88c4803b4fe3203976ec26e23f86ed3942c9dc107fe3556abf9e8b62a1fd3d8db378bcff1045177490ebb265525b0f5c539ce167d9734fab4ef44b5094c3d16f1f230db45c1d5c3cbe02ebc9b25469338745720fd0f049280f1aa3f69ec9c8594af7c31be79202386acc0c8982b329421991150b07a3eae1f0a048719450ac8f320818b679c3b87187944184ddedcc140b6d7e0ee076d3119f965e6be4d770b1b6934ef7385d7352ad761d860f5df1fe980093810c4e048c13d6e4ead1c4a6dd164463fbe4a7ffa8b6e8e6ddbbd753391d4a3dabfc83663db7c8639393647a46b678a56741cd7c791ba7345a3d3f23a488b39a3706597dd384fb3a2d3ceb335ef3420ffafd2bf0300881eb19267030000",
+		"0f9c2570b6f026715f5c48cbe305e0da": "1f8b08000000000000ff6c934d73ba3a18c5f77c8abbef748ab4b475f9e40d83060d0668dc29da2088c55209f0e9efe8ffce5ddcdbac32e7f9cd992427e7f1b6100d78f417a6b1e28c6350f4263e3a82739c288ce1f360c0720486cbb85c62a3d3f8388f1630179b37337b3d2a9823632e45552e57521228d128646b1d2c3549a50ca80dd364a44a201ac024a11889b9f458bbcd365d5efb8d56341348de67a81751e2b12ba79bc1d1995f6e3fa27237d28d40f91dc08590bbe7f02cd6fee910b09f3ce84f8b3aea768ae6027ddd19e885d21ebd3abf837060d61d04814190c42e091f04415b66ddf18fc6ffd51c31133d1e2144264a116805a754895858fae76a9cda86e8ac6f36f574d8d5a742c4da32b8cf66d44e94a3b317139fc36257474dee459d882bcbec1d98d31e75799df78b3a7dd1d9c4ee82e4aabde98f88ad0dcc9d210eeda352affddf4156596af56cfeb5e163e962909affb3272073220d500774f5fef6d590ee994dce84adf8d8d9bd3a94110cad7f6ddbe9a57dc65394d6e3badbe34563d75d1ffacca448c86450be73c80a33e4cbc93eacb2c590e7303dd4d93cfa11416bc9ed98b1bb02397b42200998a016c8bda5b00fa5bcc7ea60dc06201386acc0c8986f6428433227a060757bd8592c107cbe53a480dc8c04aeee06c4c823c298385ebce8cf9ffbd663d2c510af1e4afffd5217d772dfb8dfe953b885fe65f91acba2fccf4f341107829803470adfab95370b1f3e9bd07f42af5503cfeed8ea966dfbcb05fb43b09e147994ac6d5019cca15bb2ee0d271fea7bef5ece0efb1187233eed72b6635e5b051f1fb57d785a149d5a4f9d5b5b1e6944fe5fa1bf070017359eef5f030000",
+		"10836b1c338002a2e9ec2a6dad3a1d74": "1f8b08000000000000ff6c934d93aa381486f7fc8ad95b5d0d345cedc52c4e420251133b1af9da09ad2082f8d174805f3fa57def6a6e56a79ef32cdeb72ae7e5f110f199f80793b562946150e4015f0cce185e288ce11317a0198282ad61b56ff051ed4fbbe5e2b06aa4439b61f545342e12b6685336562601cd743e92a5c1e1e483b525a8e4380c794f4658a34284080a854fa2ccfcbac9dee6dd2e223dad60fbb3cb95178a323b22dfc81bda25763d26f6acf715c43f42ab88ff3ea423ebd2b82cb318ddd38d5b65b6d9071eec7e1cae882d4a63d9fc55d441990b5e81e61558dc2b86c71c3dd8f864c31f664415ba7179d758265e28a54ff43cdc8e4471449ed530e20b69d3fb2e4abff3c6bd248a441cc9e70ef55c185b9b768ca44312b9d52e165536921507fd1470cf6912adbf7691d307237cfe8ecee9899eb2a8eea4ff3e187f8b1f2950a8c8afe5a95a7d48865051fc9e39425a600099daa65842359b1bbddacb6692bc8ae4fa8b164281abdea2711d796769ceac85d5f729ab6bfba227937de93beed41ec838b90e57e104ae2f2b6331b5ee817f1b21e9cead69eacd08ab47d440ce101c660401c7c801ed41e2856b53810c5e11300d1edc1e9e116c38997b702cb04737afe7e8d8b923babe7f6b82a969567d1ac49f16ab2ce9d25bcaf659ebda97b5078b3fb58c0f293d2844001e22704451eed628ce6b471d26a36aafdc3763ab6f6f7ae34ccdafe99b737dffb56deb8b7790ac20c1e168d08fe5729a96e965e7d2e29cc93aeb3b9aa7e7efc614abf0eddcee639213f2aff1f8f82f4478ffbf86ff060028913a7a2a030000",
+		"13d0c391ff7fbef610f991e81fd3eda3": "1f8b08000000000000ff648fcd7282301446f73c85fb0c23546cebf226a62102e19f06775af5ea000a9629d4a7ef30d39ddfea9bb33ac79c46b9906a1625b2808ccf3c5e4ed03402295c400e010d04fded441a382b402e18fbff0377a9b086dd2029c4314236f7dfb3ad4f642be5320f8dd1bec6f9717f896eaf38603def6ea4642b3cea47734e40318024d59a608fbc60acddf9079b9d8a3315b55de54b2b32be3edfd2c3296b643d82558557efdefcdc3b47e76be56efb7d5d7adf2f0b32369d432e1f0fa6abcda2dfb42a34267f93abf573d4df000fd048c2f1000000",
+		"160f46e22ed790aa2a94b86fa9cbcb46": "1f8b08000000000000ff6c934d73ba3a18c5f77c8abbef748ab4b475f9e40d83060d0668dc29da2088c55209f0e9efe8ffce5ddcdbac32e7f9cd992427e7f1b6100d78f417a6b1e28c6350f4263e3a82739c288ce1f360c0720486cbb85c62a3d3f8388f1630179b37337b3d2a9823632e45552e57521228d128646b1d2c3549a50ca80dd364a44a201ac024a11889b9f458bbcd365d5efb8d56341348de67a81751e2b12ba79bc1d1995f6e3fa27237d28d40f91dc08590bbe7f02cd6fee910b09f3ce84f8b3aea768ae6027ddd19e885d21ebd3abf837060d61d04814190c42e091f04415b66ddf18fc6ffd51c31133d1e2144264a116805a754895858fae76a9cda86e8ac6f36f574d8d5a742c4da32b8cf66d44e94a3b317139fc36257474dee459d882bcbec1d98d31e75799df78b3a7dd1d9c4ee82e4aabde98f88ad0dcc9d210eeda352affddf4156596af56cfeb5e163e962909affb3272073220d500774f5fef6d590ee994dce84adf8d8d9bd3a94110cad7f6ddbe9a57dc65394d6e3badbe34563d75d1ffacca448c86450be73c80a33e4cbc93eacb2c590e7303dd4d93cfa11416bc9ed98b1bb02397b42200998a016c8bda5b00fa5bcc7ea60dc06201386acc0c8986f6428433227a060757bd8592c107cbe53a480dc8c04aeee06c4c823c298385ebce8cf9ffbd663d2c510af1e4afffd5217d772dfb8dfe953b885fe65f91acba2fccf4f341107829803470adfab95370b1f3e9bd07f42af5503cfeed8ea966dfbcb05fb43b09e147994ac6d5019cca15bb2ee0d271fea7bef5ece0efb1187233eed72b6635e5b051f1fb57d785a149d5a4f9d5b5b1e6944fe5fa1bf070017359eef5f030000",
+		"1c443db0478276cd15bed0e8190433cb": "1f8b08000000000000ff6c93cd92aa381886f75cc5ecad2e11b58f2ecee24b08216a52a2e1772728d0119a561b035cfd14f6cc6ce664f5d5f33e8bbcf9791b17229489bf303948e6300c928cf0cde08ce1ad8d31649302344350b0036c1687d9a6353fcd8f617167c13cda2ea39daf7111b36d93b041990434d3d9407606872b85994f50c97110f08e0c704085081014125f4599d2aa4ee79bf61492ce51e0ff6499b40351a61f881a59edb4b1550db1b5eaa884e8476824a1eb3e19489b44659946e8911c972ab5ccceb5e1f4e370492c511abbfa8fa276cb4c70059a2b9871bbe8c7391cd9f062fdbfcc0815ba71efa1b117db81e751a237813f10c9117955c3886f3dcb799cc2e499d5cbaf58929023ef95a18e0bc3b79c9691a48fc3a53a4542a503111c1e2f01779c6434a83245728ecc17838e873f0cda74bef934f871595da8f39dd1aedad5e2994a9470c429ea6ff4c8176b2808c5f89f5913174c06683b51e7a5a58f9e6446be793ee2d54dac57efd37a72df974153d360726f1e3e5213c4ca499f482f72f97ef26c725a9ce04207b359eba9f398527f6edcb54ec3597e4de8454989761c16e356cfb62668aabdf1beddc2063e9ebc7be008f2150105c0f1abe6d960dabb72bc05c6f60979d2f3da1ae2c0f61822d3f7fd677f3bd177617d1ebfa355d5d86ef1ebcbc19443f35f2d0301c76066c0898799edf2ea7c9b6a9dab0aeb55719ac7dfd3dad9ad23629f6d1379f7f6b29a1d64abcef001712eacc868f3596f5f59f67dc944be5a6c90a285f74b4cfc180953ef5de9cd0605bf7f1be3c37f23c2feff6ff87b00849769a12a030000",
+		"1c5555fe52283fb771382cb2f83e46fc": "1f8b08000000000000ff6453cb92aa4810ddf315b3373a446c6feb6216590fca520b1a28d07227b417047c742b26f2f513e8c4cce2d6aae264469e47c479eb1fe1427a7f511e6ae94a0a9af7e09ba5a4a49f25a5b08f724049209721785d7eb95de6939f65aae15b9f9819845f8834377279decaaeb439a0c49de62b4b412560147352289a24aae51d8424f71202b9a69557a4a23ea6e345b35bf3d62d217ecd32cd12af480f4458d9d16d8c5377c699b62e83e8b570d6d449decd7a84a9881be3cc6e2accd00d0c4b8280719c94269ad47be1deac4cb4f5eae8dd530d7b17ed8762f0502c469fc9876264e7a2ddbd30f91fa644d5d20e163d9945c068a813ad4285fcc520395e9859b797ed71f6488f75a142832e3c67738e236dd6ef79785a14e9d1bb5899e3dd551820cf9f0b4b8eb7a7b4ff95f18d22f1332768956f1cde6c3745916ec8751b4d4a2b756c5c6bd024cfbe8baaf43f0349489efffb5784a0470122d2a1c9fc6873211d52561bf5b3802f7b947c583a98a7a2a227d72fee9118ff54f5fc311a6ec65533627509b30f1b0ff6c48df387e2d34143c273d5fd5a86f190ee7f9f1d1bad5d077e1fc63c9812f83de50414859dc1799f4768d7843cfdcb251a4282780e2805a5570141ec1254c45230edfd7d71e4648881ab40f58770f93ae0132ab9f4afaadc7f94f76db6b127a7b038f1e9e8c33b0ce89e5a5817dec9dd26ba7d17c3ba9d2a380b4abf45a4de674f35760e8ac75406cc46db548381d746d793f96952d6b59f60c1e8523e1ee81d58328b0e53ee36136780320fc6f7a6f85cf9e75dc2ebd4a67b2d32757156abddc2bf21fce2882b671c07d6b0327f5b7d2bdeb8c7feacca3f0300779d601347030000",
+		"1fe472990557375c41a807cbe3dcf656": "1f8b08000000000000ff648fcd72b2301846f75c857b8731df288acb84ef358990d804cadf0eaae5a7b52013c9f4ee3bcc74d767f5cc599de32e2340b95cbd689ee2045621140b741dc129c30d60410425df0f1a8bdd11374083e0f75b6084225b594eb0520dbaccf233fa7a439c4da69fad634ed7fd9868237767752f65e44f5d53b7e5bf74dfb51acb00e32409d1fce10d358ce3f1ba16de7b95653ee9873ead66edb4b01d4e79769e549de3aeb8a1e2e22bed8b205f1fd8cdbc7ae1b337f1e1393ce2bbdc32b48936b6cc2a70167f17e4ffbf513f030012ab3c93f1000000",
+		"20e406ca2148962754bf5df1ded55055": "1f8b08000000000000ff7c56416fe3b80ebefb57100ef03005326933336ff0a6400eef9dde020b6c0fbd154540cbb4a58d2c7928daa9e7d72f28396da683dd5b424be4c78ffc483d75d8b033cfd59488030e0407a8ffdb0e2ed49531aed5bf0dc713715d198b21903f16ebb0acffeb2a72af96c8fda7ba1237509ce468c9f556e0009fefaa0d8c1c630706991db5d02cf0dbff1e1fd23dd4232e3e625bc30763c99ccac719bd6b515ce877674cc30d44869ac24c3e8e54571bf8803e45685c685de8412c81308684465c0c20119c24687c3427b0842df1cdae440464523033050d2496aacdcf772d0a24894cadfa750c03a5843ddd431719e2241743dafe123876eacd1234537281520263d105135bda820b70b6ced87220730a67764210bb6c5bfdc230258101c5d8d59b22dfbd270562f00b64d2d23588042eccf174e1a5146f57e5021cbbc8036a515e79af36c064c88d024c69f2a24549c293918949eb82e9c247b35cb92c45f1d4a359b47a711810d2e89ddc54abc7ab70571eb51b4a70b0982ca5fb2b37a34717c0c4605028a026b4054cd03879b1530318da2b0cd94121696d92795fc3879938b918a8dd82a7d08bfd383275ee855ae81cf9366d732d993c2e096662d72dcad7bcbfd9559bb551da08214a6ed992f6ea750b89049c5ca3a297918ca45db52676545c7078cd4ba5e3c22a9ccf75553d3d25e2d9194acfcfd53b3dfd2b17b353c55d04f966f9e79b2d0a1ed319c7f1faf6cfd60d9c6849998092e880acb2a3607819a534f5c86e46213d0a9df3040d2941ca98b8995461625daa36d03a262391975c9a9188619c1aef8cde4d60e905280ba0854466fcf4efafa77dfe546de0698de96278d6ae28418f1af400f5dbc7dd891605fe747561a7a194830de0385ef39b7feff57c0172f177f7b2dbedb299e34062694a3090b033652a6845a88518e0f662cec27409c6c8b2853b685dc2c6afd21a14d17a5261e82138c0b7bb6f3af06e2da117fb23d372cb84edf2e35d982bd765300cb84043eb2cc87ecbe76af34bec1d3c10bbd816e82e28b931b449fbf7a984fe155312f4741cf3c5cb604ed330202f6fd6af6af5b1074f337970096228238ad1d0165a6aa65ea75917b770460e3943628e9c65a582177a1195e39f2986eac9c7feb92ade0e50ebc5ba7a9b0c7a56ab92460c251b7a51d83a7f4b97c11f8fbf3fdcfefff1f1014cf43eb7db1642040aed185d906b6e188d0bbdb2b0fe541a5e0f1ea0b622e3fdedad8f06bd8d49eebf7cdeff2703288a3c5e64333ae28fc63b0af2b16cc97c0a87d1d3917534c101f63ba56b255f019fd10934d445266012ce8305a143e775fc643fc0f47da2248a329f518c2e08f18ccad1a7cb16c983564b306ade2dd04cbcbc9dbc84552da3c0109340a37be398dc0f8240e76af3baadb48484c65ef284111d6fa18f0267271618434ff07d227697d596774656c139f28958f5ae8d606958074247622cb5800208baf9352505bb12ff2ea92b7007d8df2975ab6738c097356b5d6333e90ee85cafc95f097335febd2e9dece0b1bc40d2f6ada86b42af6dbd851564b1e722a8c999e575c5141515d1409147b5c90dcaa4db8b5af03a0bcf96562de749a9b3383f0ea258625d16fa8e49104879d2252bc892758afacefa59a6fbeaaf0100b80621c292090000",
+		"2170db6a57c3f758b84755546e1e8c0e": "1f8b08000000000000ff6c934f73b2481087ef7c8abda75202be9ae4b0879e6118066d9241fec94d300e19455d79cd009f7e4b7d6b2f9b39753dfdcce1d75dfd7c7b847111fd45599c085f5048d80d3e5b2804dd7894829a2b3082801231447e3ce77a088bdceb0eb3b8cd97d766260c556bb1389562d4360323cc56b3a585b0e7e0a48c3448b30c7b36424c5494115009dd474dc50f6d350daf9b9cf5be86f4d1ab132f8b9aea8b70ab6efdebda3d8c6bf7b5e709140fe19430fe369423bb9645d35405e9cad54c57aedd07236c1f0e26fe31eeacda8d9a65fba36c82a68e5083410d0e7a6ab8d5f98d8d7736dc9875879a54283b43e5dacba4e4cc84593ab20409bbc7a30417d2f5bb4d5e7ed7edecbc4e588e44de7b16e9314a5dff2a5839acf399de1491ae46b64362df05e831af7976a8355cab6978c4d5ecf0c9fddf35ef0fcb36fab6aa8494489093e11fbec25f6fa018a7f44f6d5800b6008269fb792e7cb48f6ccd8d7815b45eedcb2fe62d73ab68d985ec8cc85f8b80d6b13ad5a227d5d3f6231cf6deeab77e02ee8485b3a9c5cbe9ebe334f95a54d3c68c5dd4ba5a95b5b154298da7d65e16db1f20830901e981cad59f08db50ca7b5e4a3b0e32f589414294ba10c57c226bcf020d785b4b102381dd2b030d8037d949b7c2c83dd20588e0f8921499c73bd7aea73c39d69fd998bc9ced23892d154cdf230c1735cf429fff0a91aafb67266583e489fa4772280b617e5eb54b2d523c0484d37fc32380146c05c8522aa4a7a79ec92f4f61fa7e69ca1792367b365c27a7a9366d185bdd0759bdcd1339f90edef74a28711e9d89cb8733e50377c4bc3fef2fcd64e54a0736dece99cd8beebd85eb4e44f8b775bba6671679ff3fb17f0700a426d89c7f030000",
+		"21f6680c988741b46c1df82abdd65859": "1f8b08000000000000ff6c934d97a2381486f7fc8ad9d7a9a305658fb598c54d0831c2a54423083bc01208a06d2b06f8f573b07a663393d53dcffb2cf2e6e3755a8471e1ff41d9560a4750906c82af060a415d4929e42f056841a0105b10e4d37a4b9374975c58bc1b4c5877e53ad7b488857b49c4a8e60cb4d0f9c83c03a1e6f0b667a4441a86d8b311b6a4f0430285a4b55f66bc69336bdda511eb1d05fbef2c9776e8975945b891b74e179bcd189bcb9e4b387c0b17c9f8c7908ca24b0e65991dc82dd92d5466cefb950de9b78392997e6978edff8a7a55e63e2ad0a8e00ded6298e66862e3930dff302352e48ac14dd320b6c320e04cafc3fdc82412f6ac4609ba81e9dcd22879e4ede2672c5984247866a447dfd89b4e275832c4d142a5075f6523f3116e4f81f6c8721e36b9622724f327831ea3dfaccbacf5d9c06ad17c71e79ef3bef15aff91499220414e862bdfe1fb07148c53fa7bd66c057301845bea363a1b99f1d638425c98a46f57286df7146cbf7c6dfdfcb8dfd17db3e65767d10497c5e811bedca5d6a3faa4def9a154f103669b97c13deb8751ddc44cb1902da5a9a4241ec2fbb4d5a3ad1999e960baef5561034e27bfda2281d392810240faac7934840e6aa42e080cbd9763eafe7946fecb9c41200e72b771eae3d1da579bd0aea37b5d2e3a754e1b8670f9b796410029cc0b40b6a722b031fe0caaf80bab20917db3add5cc9a29153fb43ed2fcb080a873da5f69b5aabb42144e7d98193fba4aeeec79332e372f5a37dec97ccf0590ab992643b5bcbe176a1e9f34fc654c0fff95f9f67f7fc3df03002377342c2a030000",
+		"23f17e4f7adf9df2557357959253ee13": "1f8b08000000000000ffec5add721bb792be9fa7e812b7caf62e3912e96437e15eecca721cabecc45a534e2e92540a9c69721061800980a1cc6c9d773fd50d809c212959727ccaa9946e6c71f0d3ff5f770358a175d2e8298cf393fc24cb0a2551fb6996010ce0c74a161518bb145afe21bc341a4a830e7c251d88a651b2085fa5765ee802618ecae82578f33f705921ac846a11ead6799823f80a418b1ac12c4068da97a994b8901a4b687589168ebae4dc51063dfa53fa3521ee94592ea55e4e330000852b5453907a61321a1cc05b633cd1219adfcd2ea094160b6fac4407d7d25770856b07429750a0f52ecf000abb6ebc298c5ec8b86d237c35857ffbffb337dfbf38fff6d78bd3cb97ff380ed346615e20363335c2ecf92b07ae6d1a633d34aa5d2ec55c21bcfa019c3716dd90e56fac69d07a6223ca5b582c517b29d48ce691c403101641d68dc21ab567c9c13558c8852c98d3de92c0ec007e7ad3d04ca17ec9e19dc312e66b681ddac0400edf1b0f1ab1c412e40284529d8d1c93c47a8e258f6b0802b696a9470aa42fd4d628457c85350dda85b1359680ca5d576831ef28efe8d8d7cdb1f3c2e388d938ca0e703b8bb28137aca533d6f1ac957e4f0d6d14ecdb37a4f11c6666e1af85c5d15cd0487fb68bb42cfede4a2bf51204193e29e4e2d5d96c301ec3c1a5c1d5b5f1419c60f68ebe49865dbed9a46a4d845ee1fa07f27e5e01be121ee6a2b8a2e0c1b8598795b863d019abac764dd2d4b3b3b3d945b4072c8c0d5b70a06e0d1df59141981e7cc261d15ae9d78963d4e493e514bc6d317e2b71215ae5d31480c69a952cd14ee168f6e351fc5c09579daaa5b1d257358dbc3c9da4316716fe07b472b1ee6d1c8372f2e57f92205eb9338ab4e8ac3bbe0a6eed3cd61c8ca450e1111a63145c57c8aea8b1f0a4566fa041b46e08c69668d13a784c2ad1b8345e0a9e73f97af6049e47b16021940bb2061ac4c585312af2bacfce196b968d93f081b57ef97a0695d0a5abc4150610615618451237bc5d0251fa1b68a38e723f0829c7b4e99b2e081e13e6e5f85e906fe785a98f29aaddf169594bfdbf7b835eb96387768536bfc275a44c527c2e2e0aebb36c90f543fe59eba136ce531e71706d5a554225569425a40347e6361a1cc595f05054426b5460e6bf61e11d14425346298c76deb685c7321bc430363ac487d19ecc384765ae7338e794239a06a423d80b9e12b70dd8ac417a50f20ad51aaea552a0235e324bd920319567832c2d0c89f2a7b78c2f043f0bf83506142f8fb9edbf2149de9b11477fc969934d6a64eec3fe1940bd8eb4a6090e94749cda92cb41894e2eb5f001087c85bddcec0d2501f6606f85768235eb8086482ef287412298c325e5762611752cc0a22379286b17053ac7bab546c1e3a317cfce8fd81846ab751a3e8a9c9d1e3da1308d1428ef89a884120b49658723e07415a5116544e960614ddde5de81a8b9a0a87013f0396c6b0bc7c545a43047382225baa3a09ea5bbb5b6386ea0653c2426887c8258c269d4e5943fb29b44e8a5683f8ac44a6c2c16a4f4295bda6261ea1a7589e59036342bb456960842af13ebfd9c0ad263ed864099dcafa116bea8084e5cc5c13047b25b99477a001b05a47c4fffc2287dce0be9e51fa81d855ff495c38e4900e3eee09e615e9cf34bbee37e61940dd608eb65219b1054a4789ecb3312e8d08f71be0b135b48425d1aeba45e5e20da5e1e01724ea90b53e2ffb568fb49866abf7289f6d008ae50fb99696d81bd9101a790931b9919dccacee0768606b7b03438c8d420eb287557935b770519021634fa6b63afb241d61b27551abb1cd3ff00b56b643985377639fe6e7691a08383dbd8e523c745319741f058cc9d512d655ce1a9dc078b4a7849586c529dd12d8e9f740aa20bce6770305b8c6fcb16fdc14db5d3f199d1d64cbdc99dc1f1fe6094b497d03b45c569eb2b632517e0d2b916bb25876394945c122f127e36ad6d8ca3e91a04bc10f39067229d688c1c2ed78d2c8452ebfe865c4c11d451644807a5d1187672d808cb758e358caaa6f58e0023a480b8bf6db5973526a3e770764a194c847640a82e351051384a02c2a7428e66bffd667609a717e72c61dcba5c6b51cba2b7432db45872110c8f43a13f048b2b7385f4ff287c7a42590261619432d72457cad692b28a5a77689c9d4228455c828f0eb58e2db690368242e48bb9dc83b341ea9f165251f945ed8bd15e480d4d3b57b2e0f6cfd6ec7f20e6a6f53b01647c85543c0b0a2404b6848f2d2b6522629162041d7267a391ec22ecbab7b337505301d849a6a0e4028b75a1a8b934f66a085217aa2d49371467e7cfb9d5cc12f4c4165424c6dfbd7d4da1e65097bd6da959344e281715be119cb07b23bc952be10962063d3e2db2e64bb206d4d4d95997ca8bae5e86e0daa202e140506cf6eade68a8216f5e5025b094ce5b61631708e7cf79aec3c2a21f02fa2227ac2164ddc5a1c967c3a1c96d3834b90f0e4d6ec3a1fe2017bcaf23aca7cc7dd0caa4c0587e85ca148fdba62464a0b6159d67df8417b1f12338a06217c9bb38dcc8912305c286d0e273c95bc7de530767df24f3215c87a31d8db044bf69aa7d85d980fa489a7bc3f9430e6746734188a2a868f22382b5a2dd4e258f93de71b7a498cdad0aa87a4e7f937ee3df5dddd16780d6aa292c6d53b8e9f171659ccf4b535ca1cda5f668b550d3ff3af9f2247994df442d9d4495c296dda39628762a8f976f2fce40c9b91576bd5d1fabfe39f99f2385104cbb9124f58725c107b75d4784396232a49be83100cea9911776897e4415e9285583d343f26eaa83003d94166af4fd52d0a1a7b45118634ba9839a1944489e2bc446284ad88d51b258a71e8801c00e37fb0b4a6285a9a9bca0bad7a1a73a23b453b1ca2722cab868b5d47953d79376f9915aa53215b111511e110f236662441efa883c8898f6064e289615a5b8c42cf0c418d35d8109764be9a82a2f23c19d8da770e20e8f98d64f617268b4415bcbde5100c04248355a08b7f37940e762e67a24359f9d44bc9d2378718574d0e90ddb9f0e486215bf00519696c4ab0495d5e478542aa96db4c905977aa429cd3e4d1a41e5907f248efb94135f3c48a727a79df313b2c3d916a0419958adf43034bb73b71fbdb20f9d1d17a576be10e1dfaeeb8e289de70deee05d835db05b092b4debba70d6cd90a1d466a419c2ef545067f1a491ea77ee3750a3dd249fd4836f20fa7099984a0aae9929c34a17702eb1d5a1cbe498f486c9bb63d038db341befee32ffe9fd41e3b0840fb8f1801b9f1937eed0f775916377b0031f9d1382cf10c65fdd338cbffae8303ed0b23e84f14318ffbdc2f8a6b3b57f75187f7dcf30fefacf65e37ec7f710c60f61fc170ce3c96d613cb9358cc79f298cc727f78ce3f1c94707f281a39b87407e08e4bf4f200fb27810dfbb36b892f4686171f06e649d6e0ef84cf0e5ba411b6eb4f8f6239c1c72cfe1b29d13fdeefd813764a7920e1e57b2730391c369e776ba166b282a631c5f36d145b5d89ce065bb6add32480fce5004094ee930211e6816c2edbc20082f0be8c09cc296a45fc870ab3ad8e53e6d1fef23e836e240b19386229055de37844b8766c6667f00fb3e136f6f494053d762348bb74165e72ad057ae3333be0daa4c8dc7ae12e63789c7a10bfb7535be8bff8c6ff39ff161ffe9317ac3bb98d9ecf5eebb18f293b3d30ef3db1731e9cbe65dccf6c32797311cf5bf7368c7fb578ef44c265e2a8467325b46d26399bf006bfc762671c2611c9f18bacdfd1d49d97995b7176d70648df147d435db2188fc2aef5ce40c416e9d6c10ef0e2814e3050f595a6aba00048dd7bc075fe5058636db74b515569e97d370a1b43732e3cba238da5c6fc6bb97b674a40526feeabd8c393bdd922fc4f78252d001178ecf40bfe1b715dfa5a715e1fd1bb876eebcf46dca98f17215a8b6205af17e8e5e2dcaa2ff6823282b3d4bea5c31c4d732b5681aba7c798b4b7c9f9e297185b4e0fb1be9d285326ff4ceaa5987996fde37690dd68d5f4754ab51e87008e6448d7bec6e9222d1f9c0b64bb9421db6112e72cb7510a1d97023d8ce405afd2102fc806b6994d04b72317cbf7dd5420fab609c5366541b434da64f19421ae13d5acd5bf344e2e864ef1a783a9e3cfd2221bdd12b02d31ba6a6878227f98b67e791eade564ccf3975c987ae6f625d7840b670d54d6966630425353a10c11ff6e793ade9c61655e960d9d85494e63755a354f03554dbad50adb3019c6b7e39c4092d58e3a571fe918beed8b339db2d9a8c9c82cc15331ebb111f24623f14a8b463730efa03d36cf777e805e8ff5132d4141eff7cfdef4f368d702ff4a63c964afd1dd5dcf738fddecb431b7007a3de7aaabed5f88dd3b21b1432fea40ad93b98bcf7f27b2a647c37851c98769b834c3e953ef64e78eebdfc631c64f2417d1c98769b7f7c327decb7caf75fff311ef2618d1c98c658996ec03725435f49fff164b3847f6d92fd41ddc4bdfa5982df056c96dd49ae43fb6c297745bb69e687a499dc451cce5364e78f9161b3f8d332fef40e8cdf8fd34fcbdf1781bfe9e39fcb0fa9778b5793e9d3fbf1dc5dfb31fc1f6833d7d31b45dbad66ee65822e5387ded2459ef6d37c94e050a64fc2251d14a6fe13a0159dfb8e9a3f407b0f700ecdf9e700187c9dd1db360000",
+		"23f42436d18566ff1b997e4c0f9cd6b7": "1f8b08000000000000ff6c934993aa3a1886f7fc8abbefea6a1c8f2ebf4c10edd0060318762d6a5a26470cf0eb6fe9399b5bf764957abea75295bc79df9f0b518f07ff601a2ace3806459ff0dd119ce3b8c7180e43039623305c1e46def4803f2fa89c9e0e5351efddc25c735822632e3f45feb59292408e8642deac83a526b1941eb58b38eaa912887a30882846622987ecf69da48fac9a9cb5a28940f23543ad08a2216b384d3b472793fc7b13e4db9ea602652f01ff08b91d2d6ab19e947b8fdd33af2d3fabe0b155f42090fb72a015c9ce6b7b470f69f37719f6ccba9d20d00912d92fc23b41d037b36eff9bf117739e50f8bac53d2c900962045a41192b110a4b7f5f8f537b263a69cf6935efb655f923426d19bc66be43ed40e9646cc27af1b3ad8273360c1e222c2cb32f61495bf4c8aaacfdace2b14e0676eb458d1eceef229c595f6ae2c45212daa6eed60f72bd9efc5d6685a556fbcb53cafbdcc52035ffb3270ec88c4803b4fe3203976ec26e23f86ed3942c9dc107fe3556abf9e8b62a1fd3d8db378bcff1045177490ebb265525b0f5c539ce167d9734fab4ef44b5094c3d16f1f230db45c1d5c3cbe02ebc9b25469338745720fd0f049280f1aa3f69ec9c8594af7c31be79202386acc0c8982b329421991150b07a3eae1f0a048719450ac8f320818b679c3b87187944184ddedcc140b6d7e0ee076d3119f965e6be4d770b1b6934ef7385d7352ad761d860f5df1fe980093810c4e048c13d6e4ead1c4a6dd164463fbe4a7ffa8b6e8e6ddbbd753391d4a3dabfc83663db7c8639393647a46b678a56741cd7c791ba7345a3d3f23a488b39a3706597dd384fb3a2d3ceb335ef3420ffafd2bf0300881eb19267030000",
+		"24b8a83088960c57dabc75bdfe6cf9d9": "1f8b08000000000000ffbccf31cac3300c05e0dda7d0057effd0315b091dba345d7200d57e0d025b0e8a0aa5a72fced41364147ad2c7bbb58c69de864074517e140ce4f642201a8b407d9aaf19eaf214580f118db03e26760c9438c17cfb4f1c9b2da78837d7b520a656fffa26aea8fbd5640bab7cd8a5299759c57ffe52daad407407ec1872052c109d73153d46e44e859ecab0a36a36cb3058f80e00703512f3e8010000",
+		"24c2648bf899dddbeb2cf0a5549b0294": "1f8b08000000000000ff6c934993aa3a1886f7fc8abbefea6a1c8f2ebf4c10edd0060318762d6a5a26470cf0eb6fe9399b5bf764957abea75295bc79df9f0b518f07ff601a2ace3806459ff0dd119ce3b8c7180e43039623305c1e46def4803f2fa89c9e0e5351efddc25c735822632e3f45feb59292408e8642deac83a526b1941eb58b38eaa912887a30882846622987ecf69da48fac9a9cb5a28940f23543ad08a2216b384d3b472793fc7b13e4db9ea602652f01ff08b91d2d6ab19e947b8fdd33af2d3fabe0b155f42090fb72a015c9ce6b7b470f69f37719f6ccba9d20d00912d92fc23b41d037b36eff9bf117739e50f8bac53d2c900962045a41192b110a4b7f5f8f537b263a69cf6935efb655f923426d19bc66be43ed40e9646cc27af1b3ad8273360c1e222c2cb32f61495bf4c8aaacfdace2b14e0676eb458d1eceef229c595f6ae2c45212daa6eed60f72bd9efc5d6685a556fbcb53cafbdcc52035ffb3270ec88c4803b4fe3203976ec26e23f86ed3942c9dc107fe3556abf9e8b62a1fd3d8db378bcff1045177490ebb265525b0f5c539ce167d9734fab4ef44b5094c3d16f1f230db45c1d5c3cbe02ebc9b25469338745720fd0f049280f1aa3f69ec9c8594af7c31be79202386acc0c8982b329421991150b07a3eae1f0a048719450ac8f320818b679c3b87187944184ddedcc140b6d7e0ee076d3119f965e6be4d770b1b6934ef7385d7352ad761d860f5df1fe980093810c4e048c13d6e4ead1c4a6dd164463fbe4a7ffa8b6e8e6ddbbd753391d4a3dabfc83663db7c8639393647a46b678a56741cd7c791ba7345a3d3f23a488b39a3706597dd384fb3a2d3ceb335ef3420ffafd2bf0300881eb19267030000",
+		"2573df96a5380fd9684d4c6613048543": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"271dc7047f9fa59ab2e5b03e279c6c07": "1f8b08000000000000ff6c93cd6eb33a1086f75cc5d94755535a68bb1c83314e315f00f36376011a1302f96b8989affe886475743eafac671e8d349a779ee68730a1e13f0e8e39f5a8031ccff0c960943a99761cd8da12144520690cc137350fafcdfbee2314b0dc5ba348c6a6568e14f4eb5852dd2d3128aa9a0e0706833d819714a3963959c626ac214632cc1048eeecc3b622fd50bdaec64d8e27af83f451abb99b856db543c4a8076f1466af85f931110ec54338724c3e6fa5a66359b46d55a09f32b1baca5c4ebe86e6e130ee1de21fa336c33618fe2a2bbfad43d681621dbc3057dee67f3e337d67b7991977d82193453fca89849b4511c16a95a51a7386f07d3c07b1afc8f47e367979ad07eb2438ce198aee35034d2c4c4d6fa4b8bc89dcea3645d8551a970cd577c1695954bdae0e6c67f5dfc4fbadc9d4074378ad38de32b424c62cc1c4f2864c5a9878fcbb8c4a861841b73349d8db27484c1ce7f13740611f9614109c8fc3d9345f26972762fa1c17851dc50dbfe22a48f97293fadb57eb97e2d324dbaa16bd13adbad07f6f0dd3fa135f86f2b0309be7e6d2ea8bace5e0876a31261ddae55dc5d185c1db3c4ee32a8c9e553467009dbac7360c3f49f163bff28224f65054bb3007460984a2d4078595ab849bc54b0e91ff8c20553037d2707a3488de309591ccfa35cb0e1a6827f360bf68abb3ff369c7780ca7825ece25c1c34d94cbbf5d192ff49a4018abab102150395e4fae2b6c9987eacc3fa78f225a37925ec844deb3fd9e97db23409ecc37bec7f591f0e45bfebef53901a186d175b91d9bbe755b1d91c7b7e6d826b9ffcee8f3cb6e371243ab38df96a9e70e8feff94fe1d0025e62de567030000",
+		"29362eb79da634a1ff216797079831da": "1f8b08000000000000ff648fc17282301445f77c85fb0e83464459be90f01230a941c1e2ce5285d68a32b606ffbec34c77ded59db33ac71d46394a3d5a65b2800d1fa5bc1ca0eb2889026a0e8a2aa48f0ed7ca0fa1e61845ffdf7241716cf7565230a6eec6be6247bc2cb2f73216f8e3e096c437713e974938372c8aa729a1d2237dab7f9b0c7404b0ae02f666b20f9154b6991c82bc682d5b2cef8df2a607ebbc6cbd6bbe0bfcd3fdebc437e6a24332fb7ecda148bba56d57c97ef6d9cce5635791e858f7f9d5eb2786dc4ae90cfe2ed7ec39ea6f00f7547835f1000000",
+		"2b33e20395f2cf2cb26a1b5171992c2c": "1f8b08000000000000ff6c93cf92aa3818c5f73cc5ecbbba1ab5b12f8b59e4cb1f881a3088d0b013c4208a62b712e0e9a7e44ecd626e67953adfaf4e253939afcf05d4e1de5f980621671ca3903ec55743708eb70463742814d21c90e2124f9a14559bc3eed89acbb3825394b3378296a0d4ad3c55fe5a4a822a1884fcd606960989a474a85e44db818602a883265b8a412ce5947defe2b4cd6bab49421a0b90e30c3ae16da7ecc169da1b496c55bb4fafca069a0ac84700974266b3c5451cad73e1b07bee74e755edb559487301d791419d0893297d183f83a860daec0541bd205bed13de0b023ba6cde1b7c6ffd30ce18a0e0f6801ca8b0025213a47a10884a6bfafc6a96e4812774d5adb7d569f4b11249aa171e6523d098d247e57c1655166b5d7e453af15c149333d024bda419bd779bfaaa3f7249ee8ccd93e92a97d1781d68e1a1962d0ceab928df533c84e9aeac45d5e533e54264632e1ffee099239910a5163b60e3eecaaed5687cfe27ebbae4c08676410fbda91757b9d17ab55b8fc559837687a72abfb692be3cb0b94ccfd780f23be2f8c62f1eeb7ce66ce3e1ed289c9c5d611743bef2e9c6f4d9ec70ccc3592ee1b204990726a01e63385fd42ca315603e36f07c92d032d3028f5058a32903941215a3f1fd60d04a0c32f0a21224f23814fa30151f208983063f376898f0f6b809bdd6a8a9969565dea7eee27bc9a488b7da5bcc8aed6b409feff1395e72202d44047507691d5b88fab839e6f109d2f8b177e6fe6d797b0adb9aff79baf267923f6c3579a2b48ccbcdc9f67d1a1acfde3101b837e9905c7caee3d74dd052d9df8e5da7ad02f7fa2fe369e6d79a51ef9b342ff0c00ed6d643e5f030000",
+		"2bcf1e57961a861b99b5abe2b5b15789": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefc62ed862a0616cad20398e451594a9cca3512e2f4289d384147cbcffef46e2d639ab721105d941f0503b9bd1088c622509fe66b86ba3c05d6434423ac8f891d03254e30dffe13c766cb29e2cd752d88a9d5bfbe892bea7e35d9c22a1f7669ca6556f19fbf94762b10dd013b865c010b44e75c458f11b953a1a732eca89acd320c16be030093db101ce8010000",
+		"2dade2a9a3c48c773e99b21905f4160d": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"2f6d5fe18660de64cbba2fa4ae25cd69": "1f8b08000000000000ff6c934993aa3a1886f7fc8abbefea6a1c8f2ebf4c10edd0060318762d6a5a26470cf0eb6fe9399b5bf764957abea75295bc79df9f0b518f07ff601a2ace3806459ff0dd119ce3b8c7180e43039623305c1e46def4803f2fa89c9e0e5351efddc25c735822632e3f45feb59292408e8642deac83a526b1941eb58b38eaa912887a30882846622987ecf69da48fac9a9cb5a28940f23543ad08a2216b384d3b472793fc7b13e4db9ea602652f01ff08b91d2d6ab19e947b8fdd33af2d3fabe0b155f42090fb72a015c9ce6b7b470f69f37719f6ccba9d20d00912d92fc23b41d037b36eff9bf117739e50f8bac53d2c900962045a41192b110a4b7f5f8f537b263a69cf6935efb655f923426d19bc66be43ed40e9646cc27af1b3ad8273360c1e222c2cb32f61495bf4c8aaacfdace2b14e0676eb458d1eceef229c595f6ae2c45212daa6eed60f72bd9efc5d6685a556fbcb53cafbdcc52035ffb3270ec88c4803b4fe3203976ec26e23f86ed3942c9dc107fe3556abf9e8b62a1fd3d8db378bcff1045177490ebb265525b0f5c539ce167d9734fab4ef44b5094c3d16f1f230db45c1d5c3cbe02ebc9b25469338745720fd0f049280f1aa3f69ec9c8594af7c31be79202386acc0c8982b329421991150b07a3eae1f0a048719450ac8f320818b679c3b87187944184ddedcc140b6d7e0ee076d3119f965e6be4d770b1b6934ef7385d7352ad761d860f5df1fe980093810c4e048c13d6e4ead1c4a6dd164463fbe4a7ffa8b6e8e6ddbbd753391d4a3dabfc83663db7c8639393647a46b678a56741cd7c791ba7345a3d3f23a488b39a3706597dd384fb3a2d3ceb335ef3420ffafd2bf0300881eb19267030000",
+		"3824b8f4c15f351a71b0785c0f3b8289": "1f8b08000000000000ff6c93cd6eb33a1086f75cc5d94755535a68bb1c83314e315f00f36376011a1302f96b8989affe886475743eafac671e8d349a779ee68730a1e13f0e8e39f5a8031ccff0c960943a99761cd8da12144520690cc137350fafcdfbee2314b0dc5ba348c6a6568e14f4eb5852dd2d3128aa9a0e0706833d819714a3963959c626ac214632cc1048eeecc3b622fd50bdaec64d8e27af83f451abb99b856db543c4a8076f1466af85f931110ec54338724c3e6fa5a66359b46d55a09f32b1baca5c4ebe86e6e130ee1de21fa336c33618fe2a2bbfad43d681621dbc3057dee67f3e337d67b7991977d82193453fca89849b4511c16a95a51a7386f07d3c07b1afc8f47e367979ad07eb2438ce198aee35034d2c4c4d6fa4b8bc89dcea3645d8551a970cd577c1695954bdae0e6c67f5dfc4fbadc9d4074378ad38de32b424c62cc1c4f2864c5a9878fcbb8c4a861841b73349d8db27484c1ce7f13740611f9614109c8fc3d9345f26972762fa1c17851dc50dbfe22a48f97293fadb57eb97e2d324dbaa16bd13adbad07f6f0dd3fa135f86f2b0309be7e6d2ea8bace5e0876a31261ddae55dc5d185c1db3c4ee32a8c9e553467009dbac7360c3f49f163bff28224f65054bb3007460984a2d4078595ab849bc54b0e91ff8c20553037d2707a3488de309591ccfa35cb0e1a6827f360bf68abb3ff369c7780ca7825ece25c1c34d94cbbf5d192ff49a4018abab102150395e4fae2b6c9987eacc3fa78f225a37925ec844deb3fd9e97db23409ecc37bec7f591f0e45bfebef53901a186d175b91d9bbe755b1d91c7b7e6d826b9ffcee8f3cb6e371243ab38df96a9e70e8feff94fe1d0025e62de567030000",
+		"3b506c5963bcc83c923e88bba5bae97b": "1f8b08000000000000ffbccf3d0ec2300c05e03da77817a0ecdd50c5c042587a00933c50a4fc54ae9110a747e9c405ca68cb7e9fdeb545fa791d1d70ae72cf1c61faa203a69c58cdcf97c86ae991a8fd0898a87d0c621c1124506d3d0619f896b2640ea195435f0e0bcbf6e0f529357dc452ab92e79aec271261631c702375776d21d501a75852dd1d93ae38c06ba4fea15cd348a5baef001e34df7fd4010000",
+		"3fa40af2da6e6d89f11916a9aa7a91c5": "1f8b08000000000000ff6453cb92aa4810ddf315b3373a446c6feb6216590fca520b1a28d07227b417047c742b26f2f513e8c4cce2d6aae264469e47c479eb1fe1427a7f511e6ae94a0a9af7e09ba5a4a49f25a5b08f724049209721785d7eb95de6939f65aae15b9f9819845f8834377279decaaeb439a0c49de62b4b412560147352289a24aae51d8424f71202b9a69557a4a23ea6e345b35bf3d62d217ecd32cd12af480f4458d9d16d8c5377c699b62e83e8b570d6d449decd7a84a9881be3cc6e2accd00d0c4b8280719c94269ad47be1deac4cb4f5eae8dd530d7b17ed8762f0502c469fc9876264e7a2ddbd30f91fa644d5d20e163d9945c068a813ad4285fcc520395e9859b797ed71f6488f75a142832e3c67738e236dd6ef79785a14e9d1bb5899e3dd551820cf9f0b4b8eb7a7b4ff95f18d22f1332768956f1cde6c3745916ec8751b4d4a2b756c5c6bd024cfbe8baaf43f0349489efffb5784a0470122d2a1c9fc6873211d52561bf5b3802f7b947c583a98a7a2a227d72fee9118ff54f5fc311a6ec65533627509b30f1b0ff6c48df387e2d34143c273d5fd5a86f190ee7f9f1d1bad5d077e1fc63c9812f83de50414859dc1799f4768d7843cfdcb251a4282780e2805a5570141ec1254c45230edfd7d71e4648881ab40f58770f93ae0132ab9f4afaadc7f94f76db6b127a7b038f1e9e8c33b0ce89e5a5817dec9dd26ba7d17c3ba9d2a380b4abf45a4de674f35760e8ac75406cc46db548381d746d793f96952d6b59f60c1e8523e1ee81d58328b0e53ee36136780320fc6f7a6f85cf9e75dc2ebd4a67b2d32757156abddc2bf21fce2882b671c07d6b0327f5b7d2bdeb8c7feacca3f0300779d601347030000",
+		"41b2046ee208572f31c574b0f1727dfe": "1f8b08000000000000ff6c93cd72ab381085f73cc5ec53a96062dfd88bbb680901321646843fb13338c160887fe3063dfd949d5bb399ab95eaf4398bafbbcef3fd11e6f2e01fcaa2983b9c42cceee2b32138a785a6146aab06e4046a2ef3c9db7136d9e884368b284ccf97a674b5069fd4f569b76fd7a19436b4c412f2820695ca4ea574192ed344b35810e6c2246194085f5ace659315b7aa9f1d55cc3241e4634606112496f3cd59311a2a9bb59b3c684bcd0a41aa8781ee842c5f975fa299751fae73addca15bf5c1ad8cd9a720e6c30383c8b6eea00d65b1efbf9be1c3417314368cc24e706df351d864e3a0a97f34fed08cbb289ccb40352c491da404540c5d1a8b4820fbc1e30c8fb6ca8663d12fc6b2ef762252e8c063e6190c27b1caa675f4b5dc957d70acace026a2397a3f619b0d0516593a0a3abb557d35aefa74aab209966ef2adacc5d510ce1e192acf3f145cb72605a9f89fbf0db2b2650d6c92cfc555577dfa0ac7d7494cac6e5b154c74a42c3de3b3d92b7ff314e4d7fc698266d8745966532bf75ee4a4adad0f95ac882f772275cda8749c656a96517f78394cfd35e934254667c3faceeec93981cf39232028d9c00f426476843c78b98f8a10997880cca5f4e2824c1c82821a02f07e962d43465e505201803e3ec22da112f901eab40b45faa581b775b6da3fedca9337ed4f0d90225a1aea577ecabfb4bb199af030abd18747382294a137e559e5a65dd5c2df4fadb863fc3120adff5b1e03e4f63b004692ef40041774b7fb66b5f83667618efe3924303d87275d3aef4762fc72de8318dfdafcb03f4343d27d9a6ca66f8bd1dda5976e9c47673de8681b0e1aaeb7352c7462138565a2ccfaf76fe3dea66716d8ffafd8bf0300417f75ba7f030000",
+		"46686019a8608b6ce36473c851987565": "1f8b08000000000000ff648fcb4e83401846f73c45f74d43452c6139973fc33f3ad3292308dd79a180a6d45a60689fde90b8f35b7d39ab7356f32808d40b93624e9e61f108e50c579e4291901a88a24ad0eb595815c6a406c1d8df779050b176af0e29d9edea682caa8e6470bed9e0e77372de3e04bb1ea0ff1a64ee57f6f41e73d4b5192f7cdba44433422c93fbb8c93fbac8f136292f7e6a20c8362d3532e2bd57bcf8e3f714a084f4eed8de97aa7bd2c5db717868f1b08d7b947d336d866558e9ab3c18d69cb094d9d2debcd97f059aff8ffa1d004436763af1000000",
+		"481cb6351f62aa369c7700f40c943d30": "1f8b08000000000000ff648f416f82301886effc0aef840c10448e5fb1fb5a58ab052681db92b18a5122ba0de8af5f4876f33dbd794ecfe32c2314b95c1d727e8492ae325a2fd0b10447069a822002c93c6021821834c524f9ff236504ddf163e40494d2d5fad8169b34fc9c98f97e8f5d2b32cdedd1099df69efcda77bffb3089aff7e2504177ca412600aaf15df906e76d3a95adbadaf58bac87cdab962584e5daaa4c5eed7ae56ebdcc9ba6865f2efe6db4edf6844118f93335f6300f326021aa7316790f867703f54f6f2dfe0e95bbe7a8bf01006f07ae2df1000000",
+		"4ae86b066ccbd75f4eb60f636a59778a": "1f8b08000000000000ff6c934d73ba3a18c5f77c8abbef748ab4b475f9e40d83060d0668dc29da2088c55209f0e9efe8ffce5ddcdbac32e7f9cd992427e7f1b6100d78f417a6b1e28c6350f4263e3a82739c288ce1f360c0720486cbb85c62a3d3f8388f1630179b37337b3d2a9823632e45552e57521228d128646b1d2c3549a50ca80dd364a44a201ac024a11889b9f458bbcd365d5efb8d56341348de67a81751e2b12ba79bc1d1995f6e3fa27237d28d40f91dc08590bbe7f02cd6fee910b09f3ce84f8b3aea768ae6027ddd19e885d21ebd3abf837060d61d04814190c42e091f04415b66ddf18fc6ffd51c31133d1e2144264a116805a754895858fae76a9cda86e8ac6f36f574d8d5a742c4da32b8cf66d44e94a3b317139fc36257474dee459d882bcbec1d98d31e75799df78b3a7dd1d9c4ee82e4aabde98f88ad0dcc9d210eeda352affddf4156596af56cfeb5e163e962909affb3272073220d500774f5fef6d590ee994dce84adf8d8d9bd3a94110cad7f6ddbe9a57dc65394d6e3badbe34563d75d1ffacca448c86450be73c80a33e4cbc93eacb2c590e7303dd4d93cfa11416bc9ed98b1bb02397b42200998a016c8bda5b00fa5bcc7ea60dc06201386acc0c8986f6428433227a060757bd8592c107cbe53a480dc8c04aeee06c4c823c298385ebce8cf9ffbd663d2c510af1e4afffd5217d772dfb8dfe953b885fe65f91acba2fccf4f341107829803470adfab95370b1f3e9bd07f42af5503cfeed8ea966dfbcb05fb43b09e147994ac6d5019cca15bb2ee0d271fea7bef5ece0efb1187233eed72b6635e5b051f1fb57d785a149d5a4f9d5b5b1e6944fe5fa1bf070017359eef5f030000",
+		"4b5a32638c016d89f4e7cbe32d5cfe80": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"4c71af6703646ff1cf807a4c011d9547": "1f8b08000000000000ff6c93cd6eb33a1086f75cc5d94755535a68bb1c83314e315f00f36376011a1302f96b8989affe886475743eafac671e8d349a779ee68730a1e13f0e8e39f5a8031ccff0c960943a99761cd8da12144520690cc137350fafcdfbee2314b0dc5ba348c6a6568e14f4eb5852dd2d3128aa9a0e0706833d819714a3963959c626ac214632cc1048eeecc3b622fd50bdaec64d8e27af83f451abb99b856db543c4a8076f1466af85f931110ec54338724c3e6fa5a66359b46d55a09f32b1baca5c4ebe86e6e130ee1de21fa336c33618fe2a2bbfad43d681621dbc3057dee67f3e337d67b7991977d82193453fca89849b4511c16a95a51a7386f07d3c07b1afc8f47e367979ad07eb2438ce198aee35034d2c4c4d6fa4b8bc89dcea3645d8551a970cd577c1695954bdae0e6c67f5dfc4fbadc9d4074378ad38de32b424c62cc1c4f2864c5a9878fcbb8c4a861841b73349d8db27484c1ce7f13740611f9614109c8fc3d9345f26972762fa1c17851dc50dbfe22a48f97293fadb57eb97e2d324dbaa16bd13adbad07f6f0dd3fa135f86f2b0309be7e6d2ea8bace5e0876a31261ddae55dc5d185c1db3c4ee32a8c9e553467009dbac7360c3f49f163bff28224f65054bb3007460984a2d4078595ab849bc54b0e91ff8c20553037d2707a3488de309591ccfa35cb0e1a6827f360bf68abb3ff369c7780ca7825ece25c1c34d94cbbf5d192ff49a4018abab102150395e4fae2b6c9987eacc3fa78f225a37925ec844deb3fd9e97db23409ecc37bec7f591f0e45bfebef53901a186d175b91d9bbe755b1d91c7b7e6d826b9ffcee8f3cb6e371243ab38df96a9e70e8feff94fe1d0025e62de567030000",
+		"4c9571cd2f799ac49bdf576d49688f35": "1f8b08000000000000ff648f416f82301886effc0aef8604c754387ead1fa5483bd0ba8ddd58266ddc1c3aab5df9f50bc96ebea737cfe979c271041997936ac39f41e1648dcd08c34070968346104430e2cf6c2b1e53d0c828fdff0e73c222d73a4ea0ae75bb4259451f4de7cec77d9aac036fe9003aee5e5f32a6e2d2ce86dc7c1fcb2a8527b3014901d4d56f55a9aac25a6c486992a19fd1824ee7fa41645970d937c98de4270e7ef729a771fd762976cbc5d7f574e85b6d128b04ccbceff0275dbc5b562cdb5bf4eb0e2618fd4394abfba8bf01001c9d9553f1000000",
+		"4eca2ed1bb681f62169d279fa2c977f9": "1f8b08000000000000ff648fb16eab301846779e223b42b9f8b6a932dae6c798c6c6764292764346d86d68118d88439fbe42ead66ffa74a67392650418972b65f8111f60f50c2f0b4c22c159811d60410423f3c8f6e2618b1d304a7f7f8082b07fa1099c60ad9da370ba84479e36577afecedb8887699ed6d5ffbc56232f4b7e2e6ff5e590be76bef2064b8ab119c4a0eeba026f6b327d7459b646c7d4f6c5f48eb48ec84d20a5befa41c6d73d92b5ddf9d656eddcbca5cd67eccdbd6cb7a738eef311b927b3d96d5897cd9da5d1e29f80ccfe46fd0c00feae92aef1000000",
+		"51c35eb9bec35d85f3e626bfd3649d50": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"52b712364bd982f9589a035ff0848d48": "1f8b08000000000000ff6453cd6ef24810bcfb29f61e45718cf902873df4fcd80ca69d6fc03f636eb609e30c06078c33c0d3af20abd5aeb66f5dd55295aabb9fef457828e23f285f26221014127e079f1d14822e18a5b089345841400b49c7564f7e6146727ddd6f4eddccddee3e0c4444eb63b333efbfa56460488fb2b70e9505cba40cb99d67e98d27487808af29a70423e9057d99afbfebfdf8ab48788e443e3872c138f58241f0f5d529f2b129556caa1b4f9188c7006d70b1564d5329d2af57635379ee253450101d670430e16ad9d75edc2cf699ef14f9abadc27428bce91989af58222c3261e3047c34c262d02996e00343f30f6633c31708bbd0b92b92066996e185df60f9a3a213ba8b9b2a6cf7d5683e9439bf0406d21fae4e581637d52709eb7d303885d7de0a6ff26f8bc8d36057e5ed20c3ccff8fc36067b92d6651b71637e352908570fe6e18c89a490dbc64119ba4ac112a80eaeb60edcbb4dda8f9f2ad9bee3854b7d8dc7e8d4bb63df1b3c966d4779462877adf9df3af972ead576a9b7d7f7f2dcb73f7ee7e7c3e35073b398ce018f7187696e982654bf737c8d90b01c940e7da41e2de93dfcca57cac89d23e049906c422215a9f88e6019135030378cf60b64402db09070380b47f84b81156ee904620a8990ee776bf8251788a46051b7d3e11b58855a70edbf053d67a537e34b716e7c72d4217527a0c9d15fa530248c1d5803ca542527f59511ec80b3b1ebba01475d5bccebbc67f218a1e593d9fb769db12bd7abb4a2bb413b74372edadeb7945c98695afaa8bc254dbc8eb9a287dcf82a77effa6c3931ddc3f9dfbf13ff398fdff23fe1a00816c511f2e030000",
+		"52dc72bd3412c4f05a26fb3c1d058982": "1f8b08000000000000ff6c934993aa3a1886f7fc8abbefea6a1c8f2ebf4c10edd0060318762d6a5a26470cf0eb6fe9399b5bf764957abea75295bc79df9f0b518f07ff601a2ace3806459ff0dd119ce3b8c7180e43039623305c1e46def4803f2fa89c9e0e5351efddc25c735822632e3f45feb59292408e8642deac83a526b1941eb58b38eaa912887a30882846622987ecf69da48fac9a9cb5a28940f23543ad08a2216b384d3b472793fc7b13e4db9ea602652f01ff08b91d2d6ab19e947b8fdd33af2d3fabe0b155f42090fb72a015c9ce6b7b470f69f37719f6ccba9d20d00912d92fc23b41d037b36eff9bf117739e50f8bac53d2c900962045a41192b110a4b7f5f8f537b263a69cf6935efb655f923426d19bc66be43ed40e9646cc27af1b3ad8273360c1e222c2cb32f61495bf4c8aaacfdace2b14e0676eb458d1eceef229c595f6ae2c45212daa6eed60f72bd9efc5d6685a556fbcb53cafbdcc52035ffb3270ec88c4803b4fe3203976ec26e23f86ed3942c9dc107fe3556abf9e8b62a1fd3d8db378bcff1045177490ebb265525b0f5c539ce167d9734fab4ef44b5094c3d16f1f230db45c1d5c3cbe02ebc9b25469338745720fd0f049280f1aa3f69ec9c8594af7c31be79202386acc0c8982b329421991150b07a3eae1f0a048719450ac8f320818b679c3b87187944184ddedcc140b6d7e0ee076d3119f965e6be4d770b1b6934ef7385d7352ad761d860f5df1fe980093810c4e048c13d6e4ead1c4a6dd164463fbe4a7ffa8b6e8e6ddbbd753391d4a3dabfc83663db7c8639393647a46b678a56741cd7c791ba7345a3d3f23a488b39a3706597dd384fb3a2d3ceb335ef3420ffafd2bf0300881eb19267030000",
+		"557efd9e2924d1a8bb309485c532e1d7": "1f8b08000000000000ff6453cb92aa4810ddf315b3373a446c6feb6216590fca520b1a28d07227b417047c742b26f2f513e8c4cce2d6aae264469e47c479eb1fe1427a7f511e6ae94a0a9af7e09ba5a4a49f25a5b08f724049209721785d7eb95de6939f65aae15b9f9819845f8834377279decaaeb439a0c49de62b4b412560147352289a24aae51d8424f71202b9a69557a4a23ea6e345b35bf3d62d217ecd32cd12af480f4458d9d16d8c5377c699b62e83e8b570d6d449decd7a84a9881be3cc6e2accd00d0c4b8280719c94269ad47be1deac4cb4f5eae8dd530d7b17ed8762f0502c469fc9876264e7a2ddbd30f91fa644d5d20e163d9945c068a813ad4285fcc520395e9859b797ed71f6488f75a142832e3c67738e236dd6ef79785a14e9d1bb5899e3dd551820cf9f0b4b8eb7a7b4ff95f18d22f1332768956f1cde6c3745916ec8751b4d4a2b756c5c6bd024cfbe8baaf43f0349489efffb5784a0470122d2a1c9fc6873211d52561bf5b3802f7b947c583a98a7a2a227d72fee9118ff54f5fc311a6ec65533627509b30f1b0ff6c48df387e2d34143c273d5fd5a86f190ee7f9f1d1bad5d077e1fc63c9812f83de50414859dc1799f4768d7843cfdcb251a4282780e2805a5570141ec1254c45230edfd7d71e4648881ab40f58770f93ae0132ab9f4afaadc7f94f76db6b127a7b038f1e9e8c33b0ce89e5a5817dec9dd26ba7d17c3ba9d2a380b4abf45a4de674f35760e8ac75406cc46db548381d746d793f96952d6b59f60c1e8523e1ee81d58328b0e53ee36136780320fc6f7a6f85cf9e75dc2ebd4a67b2d32757156abddc2bf21fce2882b671c07d6b0327f5b7d2bdeb8c7feacca3f0300779d601347030000",
+		"568ed3dc3feb03fc22f893db661522bb": "1f8b08000000000000ff74934b73ab381085f7fc8ad9df4a0513dbd75eb68490152cb0300f8b1de01887474cfc6ae0d74fd9b9359b99d14a75fa9cc5d75de7e5f108e3c2fb8bb220148ea010b287f8624821685a510aa555020a02a550dc29dbe03ab5bd7979f62c7fe7f9f3d326049794e5f7b1aefc8d523654c492ea820655da8e95e20cdfe36864a1248cc3246294485759ce254bd27bd1ce3a1db24412f59c915e7a91e5dc044b074327b32adb79553eb25492e269a047a9f2b7f72fb99d351fdcb916bc6fd6ad77cf437690c47c7aa097c99ef7a3a12d76fb6f337c38680ed28641da11fab618a44d3207cdf147134fcd7888d2b9f4748477527a31011d4213873290c87ef004c3ced649dfa5ed72c8dbe628038d0e3c672b83e124d4c9b40cbede8f79eb7585e5dd65b0c0d54fd8667d8a69120f723bbb176dd1afdb78aa9309e63cba696b7935a4532343bd724fa9182b9382d2e2cfdf0655d8aa04660ed7f5cccf78ef6687dda1f27756d3dc41cfc524391ba60ebc3989279f99c92728dad595db77c60e3634afb7feed1cfbdbf3e97774fbfdedd65db5ef979be175c1e3f6e8faa41929311a1bfc07fb4a2d081c168c80a424831f84c06c0879f20a1735212a5a01324ee985838a1c82921a12f071963d43465e515109802e3ec315a10ac509ca8fc3d5db47321bf3602c527fdfcf76dee6f6b52d1c0ec6a1989a5877984675949735baf00c0784325c4d4552f0b829aaff39b5168ef1c780b4fc67790c50d801020620ca6ca8735725eef597dc4672ece866fdda4ed7373e1fc3a3ec2e46d6d5c2ff3cd7c16e41c56afa1df0e5db655bf2385ceb7d922de9e297bb2b37fa633ce5f273dba861bd0cc7edc47834e98579f6bfebf5f7009fb6aaec7b030000",
+		"56965960caf2c6f1ccb0bd474f917ca1": "1f8b08000000000000ff648fc15283301445f77c45f719a662d31697c9e391004d0a29202eb14e11b1da8023c8d73bccb8f3aeee9cd539ee328e22d2abd44425cb7195e0d3025d474542b20699e24af01f2b4e8a3eb00605c0df1f51727137d663c459963560e2aadb9d277298a9b2c1d949ace9bea8b61a1eeb8298e925aefbc2cf63bcbfbe1aa681b12cacde6e879dca61d0946f82ceef3717d9b67b422db44ecaed65f62a9979de783d3ddfcc9a1461ba25e59e1f07e5afb72196df76e8e3776660faf8249d49241c7367f1775107ffa37e0700f036f832f1000000",
+		"577685d4868918703141b3e6be008c8b": "1f8b08000000000000ff6c93cf92aa3818c5f73cc5ecbbba1ab5b12f8b59e4cb1f881a3088d0b013c4208a62b712e0e9a7e44ecd626e67953adfaf4e253939afcf05d4e1de5f980621671ca3903ec55743708eb70463742814d21c90e2124f9a14559bc3eed89acbb3825394b3378296a0d4ad3c55fe5a4a822a1884fcd606960989a474a85e44db818602a883265b8a412ce5947defe2b4cd6bab49421a0b90e30c3ae16da7ecc169da1b496c55bb4fafca069a0ac84700974266b3c5451cad73e1b07bee74e755edb559487301d791419d0893297d183f83a860daec0541bd205bed13de0b023ba6cde1b7c6ffd30ce18a0e0f6801ca8b0025213a47a10884a6bfafc6a96e4812774d5adb7d569f4b11249aa171e6523d098d247e57c1655166b5d7e453af15c149333d024bda419bd779bfaaa3f7249ee8ccd93e92a97d1781d68e1a1962d0ceab928df533c84e9aeac45d5e533e54264632e1ffee099239910a5163b60e3eecaaed5687cfe27ebbae4c08676410fbda91757b9d17ab55b8fc559837687a72abfb692be3cb0b94ccfd780f23be2f8c62f1eeb7ce66ce3e1ed289c9c5d611743bef2e9c6f4d9ec70ccc3592ee1b204990726a01e63385fd42ca315603e36f07c92d032d3028f5058a32903941215a3f1fd60d04a0c32f0a21224f23814fa30151f208983063f376898f0f6b809bdd6a8a9969565dea7eee27bc9a488b7da5bcc8aed6b409feff1395e72202d44047507691d5b88fab839e6f109d2f8b177e6fe6d797b0adb9aff79baf267923f6c3579a2b48ccbcdc9f67d1a1acfde3101b837e9905c7caee3d74dd052d9df8e5da7ad02f7fa2fe369e6d79a51ef9b342ff0c00ed6d643e5f030000",
+		"57c29c31c27cde95cfeac356519f796c": "1f8b08000000000000ff6c934d73ba3a18c5f77c8abbef748ab4b475f9e40d83060d0668dc29da2088c55209f0e9efe8ffce5ddcdbac32e7f9cd992427e7f1b6100d78f417a6b1e28c6350f4263e3a82739c288ce1f360c0720486cbb85c62a3d3f8388f1630179b37337b3d2a9823632e45552e57521228d128646b1d2c3549a50ca80dd364a44a201ac024a11889b9f458bbcd365d5efb8d56341348de67a81751e2b12ba79bc1d1995f6e3fa27237d28d40f91dc08590bbe7f02cd6fee910b09f3ce84f8b3aea768ae6027ddd19e885d21ebd3abf837060d61d04814190c42e091f04415b66ddf18fc6ffd51c31133d1e2144264a116805a754895858fae76a9cda86e8ac6f36f574d8d5a742c4da32b8cf66d44e94a3b317139fc36257474dee459d882bcbec1d98d31e75799df78b3a7dd1d9c4ee82e4aabde98f88ad0dcc9d210eeda352affddf4156596af56cfeb5e163e962909affb3272073220d500774f5fef6d590ee994dce84adf8d8d9bd3a94110cad7f6ddbe9a57dc65394d6e3badbe34563d75d1ffacca448c86450be73c80a33e4cbc93eacb2c590e7303dd4d93cfa11416bc9ed98b1bb02397b42200998a016c8bda5b00fa5bcc7ea60dc06201386acc0c8986f6428433227a060757bd8592c107cbe53a480dc8c04aeee06c4c823c298385ebce8cf9ffbd663d2c510af1e4afffd5217d772dfb8dfe953b885fe65f91acba2fccf4f341107829803470adfab95370b1f3e9bd07f42af5503cfeed8ea966dfbcb05fb43b09e147994ac6d5019cca15bb2ee0d271fea7bef5ece0efb1187233eed72b6635e5b051f1fb57d785a149d5a4f9d5b5b1e6944fe5fa1bf070017359eef5f030000",
+		"58a5bdd90ca1914b9c4a16fd7bec6e76": "1f8b08000000000000ff648f416f82301886effc0aef86b01083e9f1fbd897dad456a404566e43a133e85ce7b4c15fbf90ecb6f7f4e6393d4f3c0f890bbd284a5143450b49768671a404df802350a8384e9e1bb562e088e7f9df0fb441fe12de8340d8ef5d385b1bac184ca6c43df553b4f5ba7d1c0e27196c65b0eedf963d5cdc2db4967f94a07300337c7956f6767db9b1e45975eb5dd688e7b219b947fa8c80898e85a42db27a840464537fb3e5f98ed34e3eae473cadd2f4aa9bc9fd1cbb7cd4724bc660416e8866ff98f4ebffa8df0100a608e084f1000000",
+		"5d5b789df1a51823c1f7eb522fbd43b0": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"5de1af416d2c8ffdaa6efe614ee8a7e6": "1f8b08000000000000ff6c93cd6eb33a1086f75cc5d94755535a68bb1c83314e315f00f36376011a1302f96b8989affe886475743eafac671e8d349a779ee68730a1e13f0e8e39f5a8031ccff0c960943a99761cd8da12144520690cc137350fafcdfbee2314b0dc5ba348c6a6568e14f4eb5852dd2d3128aa9a0e0706833d819714a3963959c626ac214632cc1048eeecc3b622fd50bdaec64d8e27af83f451abb99b856db543c4a8076f1466af85f931110ec54338724c3e6fa5a66359b46d55a09f32b1baca5c4ebe86e6e130ee1de21fa336c33618fe2a2bbfad43d681621dbc3057dee67f3e337d67b7991977d82193453fca89849b4511c16a95a51a7386f07d3c07b1afc8f47e367979ad07eb2438ce198aee35034d2c4c4d6fa4b8bc89dcea3645d8551a970cd577c1695954bdae0e6c67f5dfc4fbadc9d4074378ad38de32b424c62cc1c4f2864c5a9878fcbb8c4a861841b73349d8db27484c1ce7f13740611f9614109c8fc3d9345f26972762fa1c17851dc50dbfe22a48f97293fadb57eb97e2d324dbaa16bd13adbad07f6f0dd3fa135f86f2b0309be7e6d2ea8bace5e0876a31261ddae55dc5d185c1db3c4ee32a8c9e553467009dbac7360c3f49f163bff28224f65054bb3007460984a2d4078595ab849bc54b0e91ff8c20553037d2707a3488de309591ccfa35cb0e1a6827f360bf68abb3ff369c7780ca7825ece25c1c34d94cbbf5d192ff49a4018abab102150395e4fae2b6c9987eacc3fa78f225a37925ec844deb3fd9e97db23409ecc37bec7f591f0e45bfebef53901a186d175b91d9bbe755b1d91c7b7e6d826b9ffcee8f3cb6e371243ab38df96a9e70e8feff94fe1d0025e62de567030000",
+		"620dc97512a78f7a684fa3683f468813": "1f8b08000000000000ff6c93cd72b24a1086f75cc5d9a752228ad1c5b7e81e0618753043f81176827170040df16790ab3fa5f9ea6c4e66d5f5f4338bb7bbfaf5f1907a2cf887d030622e2310d1077c35386324ef09013991a019826421acdac69dea210e3e52df1e85f11de37838d644666c71ca59af4c0a9ae9ada24b83c3c183614cb1e2244978477b08510609828cc821a80aaf6e8ad1fcba4969e72a887f7a65e4244155ecd133cac6bd6656dd67d6b4f32258ff08a7887ab37bdeb36bbeaeaa628de7fcc3568565767e0fdb1f8747ee313c1ba51554cbe65759fb551970059a2b187247de1f75fa60fd93dd1fcc7842850517674d44e624427854cf93b8a71147fa8c47902f84e59e37697e2b1bfb2b8b68ca513c7b06763c882df7ca687ecf525b6dd6812a7abae3683e05e8785a7a495d2a7a2d46f323dfdbf5a7e75e4aafab974d70338a08738edcc37beb7df0f10c24f508f95b6bea83c9007d655bf74df56d05efd68bae63d39fed2f97e87da08e86aff8d76c56ac672316072df50f831341155b5817c977214711e77471d9bee95d0545768c766f9b90d8f7f1a16f94cc4b6dc85c6847664e129aef20fc01827040a6f26f84ed5c88675e42ce1e88d845cd11a5fc46495d14a5638002fe588b1f7284dd948202e00f79186f9916074e16c0dc3ceadd7caa091bbb7bfbfd944eaa97797b9290c6c647baaa27b53ded9a73a626ab8c13f9fc4c85a838be10f78875be66ddefabb68881eb1f81c3e9bfe121700266099c0ac220d97bfbc9c1b3fd732894292287acfce1a58ddfcce5dc6a8de438b5d03665e5b41bd843788bc6cd27590fd6cbdbd7b4deb5052cbe866d6c2ece0bec3f6f97d538f0f8e7554bf9e78ff1b8a6571a38ff3fb17f07006600dd847f030000",
+		"63d88d6d905f18d7e487ab3f096c184c": "1f8b08000000000000ff6c93cd6eb33a1086f75cc5d94755535a68bb1c83314e315f00f36376011a1302f96b8989affe886475743eafac671e8d349a779ee68730a1e13f0e8e39f5a8031ccff0c960943a99761cd8da12144520690cc137350fafcdfbee2314b0dc5ba348c6a6568e14f4eb5852dd2d3128aa9a0e0706833d819714a3963959c626ac214632cc1048eeecc3b622fd50bdaec64d8e27af83f451abb99b856db543c4a8076f1466af85f931110ec54338724c3e6fa5a66359b46d55a09f32b1baca5c4ebe86e6e130ee1de21fa336c33618fe2a2bbfad43d681621dbc3057dee67f3e337d67b7991977d82193453fca89849b4511c16a95a51a7386f07d3c07b1afc8f47e367979ad07eb2438ce198aee35034d2c4c4d6fa4b8bc89dcea3645d8551a970cd577c1695954bdae0e6c67f5dfc4fbadc9d4074378ad38de32b424c62cc1c4f2864c5a9878fcbb8c4a861841b73349d8db27484c1ce7f13740611f9614109c8fc3d9345f26972762fa1c17851dc50dbfe22a48f97293fadb57eb97e2d324dbaa16bd13adbad07f6f0dd3fa135f86f2b0309be7e6d2ea8bace5e0876a31261ddae55dc5d185c1db3c4ee32a8c9e553467009dbac7360c3f49f163bff28224f65054bb3007460984a2d4078595ab849bc54b0e91ff8c20553037d2707a3488de309591ccfa35cb0e1a6827f360bf68abb3ff369c7780ca7825ece25c1c34d94cbbf5d192ff49a4018abab102150395e4fae2b6c9987eacc3fa78f225a37925ec844deb3fd9e97db23409ecc37bec7f591f0e45bfebef53901a186d175b91d9bbe755b1d91c7b7e6d826b9ffcee8f3cb6e371243ab38df96a9e70e8feff94fe1d0025e62de567030000",
+		"63de57e5de6f44fbec2aa95476295990": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"6c4697db1ab3832deb27062333ad19db": "1f8b08000000000000ff648fcd6e82401446f73c857b436aa8b6757907a797012f223f0aec2050d05241e83091a76f48baf35b7d39ab73f4798ca370179e2f4e10f285c39319ea1a09b4a0e2408c903dee18d07a0b1547d3fcff8a5b0c572a5382c1f15835326f4e22e0a9fd33a43732b462b2de36c6bd38b75723776a50cb2e1ea6cfccdd3bb50fae09e08f3d961fd914da6d875178f8cd8534ba002ff5d72b455a3b34e374e9bb24f3af6910bdc79e24a0baf04c75b09d97f3568eb0e913bb5c97321e56e2fbb12f6fbb7ca9cdfe3a7777cf517f030022ba4dc1f1000000",
+		"7078d94fd03bd3e2d483e8f19ea876bd": "1f8b08000000000000ff648fb16e83301806779e223b42504c208cbfc1188870023696cc96964254b50437952ddebe42ead66ffa74d39db70f135ab1c3b5ab2408723813b543cf692a5ac24ca0c10dc59ba6bc89529809cdb2bf6f498969606fb6c2d0b6f3145f5084f4f8042e832fee3a3319d460fb8b486259c6c71c21bb2e4cae016cf70e5806c08f0f2b92d3430de45a4fbe507d626a1da7c94731b5d8316fb50a979ebbba5ba249a72f05dbdcb844230f830dddfb772356bff6b191e5f3f533fc198bfc664edf6767f7f708cbff47fd0e001f534af0f1000000",
+		"708aa20865bde5c0b2b33776ba82fed5": "1f8b08000000000000ff6c934993aa3a1886f7fc8abbefea6a1c8f2ebf4c10edd0060318762d6a5a26470cf0eb6fe9399b5bf764957abea75295bc79df9f0b518f07ff601a2ace3806459ff0dd119ce3b8c7180e43039623305c1e46def4803f2fa89c9e0e5351efddc25c735822632e3f45feb59292408e8642deac83a526b1941eb58b38eaa912887a30882846622987ecf69da48fac9a9cb5a28940f23543ad08a2216b384d3b472793fc7b13e4db9ea602652f01ff08b91d2d6ab19e947b8fdd33af2d3fabe0b155f42090fb72a015c9ce6b7b470f69f37719f6ccba9d20d00912d92fc23b41d037b36eff9bf117739e50f8bac53d2c900962045a41192b110a4b7f5f8f537b263a69cf6935efb655f923426d19bc66be43ed40e9646cc27af1b3ad8273360c1e222c2cb32f61495bf4c8aaacfdace2b14e0676eb458d1eceef229c595f6ae2c45212daa6eed60f72bd9efc5d6685a556fbcb53cafbdcc52035ffb3270ec88c4803b4fe3203976ec26e23f86ed3942c9dc107fe3556abf9e8b62a1fd3d8db378bcff1045177490ebb265525b0f5c539ce167d9734fab4ef44b5094c3d16f1f230db45c1d5c3cbe02ebc9b25469338745720fd0f049280f1aa3f69ec9c8594af7c31be79202386acc0c8982b329421991150b07a3eae1f0a048719450ac8f320818b679c3b87187944184ddedcc140b6d7e0ee076d3119f965e6be4d770b1b6934ef7385d7352ad761d860f5df1fe980093810c4e048c13d6e4ead1c4a6dd164463fbe4a7ffa8b6e8e6ddbbd753391d4a3dabfc83663db7c8639393647a46b678a56741cd7c791ba7345a3d3f23a488b39a3706597dd384fb3a2d3ceb335ef3420ffafd2bf0300881eb19267030000",
+		"71ce146a2db6f0f7cbe9e3e2c7f9a983": "1f8b08000000000000ff648f416f82301886effc0aef86302753397e852fb56a3b81c5586e46b4569940c728f0eb1792dd7c4f6f9ed3f3b8e308522626fb841de00b275b9423741dcee81a1402279c92bea629f7035048c3f0ff5b5c13fa664f961188633565bf15bb623774ef2ad9fada99eddac7872840d7decffc1cedfd4c0fda0fe88e7cde12102140921329d3a5f0ce7a16ab65981a9e57cdd1cc8ded6dedac8ed678193e6d195f79b7c917ac6f8ac3e926ed70bf5fb282058fe9a62da2f229c4c20443b96a94bc54dfcee8efa2885ea3fe0600d4b34516f1000000",
+		"7201712e76bbae88ed11a2e9d6e0c4fa": "1f8b08000000000000ff6c93cf92aa3818c5f73cc5ecbbba1ab5b12f8b59e4cb1f881a3088d0b013c4208a62b712e0e9a7e44ecd626e67953adfaf4e253939afcf05d4e1de5f980621671ca3903ec55743708eb70463742814d21c90e2124f9a14559bc3eed89acbb3825394b3378296a0d4ad3c55fe5a4a822a1884fcd606960989a474a85e44db818602a883265b8a412ce5947defe2b4cd6bab49421a0b90e30c3ae16da7ecc169da1b496c55bb4fafca069a0ac84700974266b3c5451cad73e1b07bee74e755edb559487301d791419d0893297d183f83a860daec0541bd205bed13de0b023ba6cde1b7c6ffd30ce18a0e0f6801ca8b0025213a47a10884a6bfafc6a96e4812774d5adb7d569f4b11249aa171e6523d098d247e57c1655166b5d7e453af15c149333d024bda419bd779bfaaa3f7249ee8ccd93e92a97d1781d68e1a1962d0ceab928df533c84e9aeac45d5e533e54264632e1ffee099239910a5163b60e3eecaaed5687cfe27ebbae4c08676410fbda91757b9d17ab55b8fc559837687a72abfb692be3cb0b94ccfd780f23be2f8c62f1eeb7ce66ce3e1ed289c9c5d611743bef2e9c6f4d9ec70ccc3592ee1b204990726a01e63385fd42ca315603e36f07c92d032d3028f5058a32903941215a3f1fd60d04a0c32f0a21224f23814fa30151f208983063f376898f0f6b809bdd6a8a9969565dea7eee27bc9a488b7da5bcc8aed6b409feff1395e72202d44047507691d5b88fab839e6f109d2f8b177e6fe6d797b0adb9aff79baf267923f6c3579a2b48ccbcdc9f67d1a1acfde3101b837e9905c7caee3d74dd052d9df8e5da7ad02f7fa2fe369e6d79a51ef9b342ff0c00ed6d643e5f030000",
+		"74059d200f1ddfe118cfe2c57f021bf7": "1f8b08000000000000ff64924f73a33814c4ef7c8abd4fa5fc0f673cc7f79020722cd9c242fcb901c16008b6371844f8f45b71b676ab6674eceeaad6ebfa3d7d3da41e137f39d457cc650e28fa253e599c31c79b1c0772af04c3104a268b228c66a7d5cc6ecf7e5f6e48b29bb9410daf58967f574dbd3f4849a0c61b979db11c19132da547cd560713551ca9078b803ac85fe5d2edd23019f2767d8b150d39ca87872317c1d2ed194d3ead385cd76924ea6ca20147f6083815df255155651176c9715d67cbf9e84ea0b1141a812bd28a6ad76a3b0e17c6cabca08f97bfee1ced88286638614628b079cd0c77af1151fca1f1fa3fcd2413dd71681e651656dcd19a8f7402ffbba1544e23aacc7b6fb3d5b64f433aba3504df5eae88165576462f6fdd3e5ebe4f56bcdc8c6402f11de814b6a24b43ddbf119a722c1f2d608c2f43ff9e866bf8ed3463850a1496f9bfeb32fc7f698e688403e087c1e77d9f2ec6a116d1708898c4e32d562776bde78659c59cd3bb688577d9faa733b7d9392d6eeebbdef9e47367fa6d4f4fe3b6d66fcd2afa640c9bf7eb219c85cfe5753e37c709f6d6d7d75fe406e1b4a108dc411b0c8198687fae40becc109801021f8fdc91d32d8173e9900fc256bdfd731959eeaf7d328f93f1208a291eca4bd96d63958ca10e36050eebfc447e03a8140c08ba70a6b06e3e16d6ab3e63b678b9c4abeed0dd9e6bb7a9623b3b26fd7079d332baa78d9d0f85ed30b7de83811ff6c44ec4bfce925bbdd85d7ff49697fe947d51a597f180cfd2547ac307eb0bf2272ac89fe4ff33004e18e95916030000",
+		"76375adb098616b98d50eeffe4f56e3d": "1f8b08000000000000ff648f3d6e83300046774e910b20a5529b84d17f31066c63088466c30e32a4292d48d4714e5f2175eb377d7ad37be13a4828139bbc603538914d4ade5718069cd118580238e414fa8996fc3502965084febe2331a45bd73a06815236cd47fd9c724ab14ff0b0d481cbbaaafcb9349f4a1c394b64b4ffdaca1ac9eadcf505100800a575c474c227e25576b3b26a8f8ff9a35cac5243dd04bd785ec7a6c3d6e0bb49e4a59f1f6f3b3d14dd397bc187db7d3fa7d7efddb4406f502b9d89a181073c9e82d53f2402ff8ffa1d00cfb7ad4af1000000",
+		"7717b1439212f8479d8414fb7bb187bb": "1f8b08000000000000ff6c93cf92aa3818c5f73cc5ecbbba1ab5b12f8b59e4cb1f881a3088d0b013c4208a62b712e0e9a7e44ecd626e67953adfaf4e253939afcf05d4e1de5f980621671ca3903ec55743708eb70463742814d21c90e2124f9a14559bc3eed89acbb3825394b3378296a0d4ad3c55fe5a4a822a1884fcd606960989a474a85e44db818602a883265b8a412ce5947defe2b4cd6bab49421a0b90e30c3ae16da7ecc169da1b496c55bb4fafca069a0ac84700974266b3c5451cad73e1b07bee74e755edb559487301d791419d0893297d183f83a860daec0541bd205bed13de0b023ba6cde1b7c6ffd30ce18a0e0f6801ca8b0025213a47a10884a6bfafc6a96e4812774d5adb7d569f4b11249aa171e6523d098d247e57c1655166b5d7e453af15c149333d024bda419bd779bfaaa3f7249ee8ccd93e92a97d1781d68e1a1962d0ceab928df533c84e9aeac45d5e533e54264632e1ffee099239910a5163b60e3eecaaed5687cfe27ebbae4c08676410fbda91757b9d17ab55b8fc559837687a72abfb692be3cb0b94ccfd780f23be2f8c62f1eeb7ce66ce3e1ed289c9c5d611743bef2e9c6f4d9ec70ccc3592ee1b204990726a01e63385fd42ca315603e36f07c92d032d3028f5058a32903941215a3f1fd60d04a0c32f0a21224f23814fa30151f208983063f376898f0f6b809bdd6a8a9969565dea7eee27bc9a488b7da5bcc8aed6b409feff1395e72202d44047507691d5b88fab839e6f109d2f8b177e6fe6d797b0adb9aff79baf267923f6c3579a2b48ccbcdc9f67d1a1acfde3101b837e9905c7caee3d74dd052d9df8e5da7ad02f7fa2fe369e6d79a51ef9b342ff0c00ed6d643e5f030000",
+		"78d76dfa7a3ea9a8d7e911e76ebd8b3f": "1f8b08000000000000ff6c934b93b2381486f7fc8ad94f7589285e16dfe2e402464cecd020e20ee81ed0062fa01ce0d74f694f7d9bf9b24a3def53a97a53e7bc3d0fe1ae507f51ee07c2111402fe846f8614827a8c52c86639a020900bad02db9e278d507d35b3caafd908268f3a008fe4f9adf83e6ddfb566702283d40d1a54c76ca7b5cb71bd0b071e48c25d18879c12e969cb6992e8d066957d8d031e49a25f19e9a40a2de721f8a137e2c83e257b754a077e90247b09b4903a9daccff268975fae73cfdcaedc54aa4d039e49727939d0c920b6f8c3f8b3085f0e9abd64d04b16e296895e329238680e3f4cfc6686742f1d1d604d72b523100750ee02e94be43fd504c72b8ba3ee7aa8967d5a9585f46374e095ad388e03238ea6b97f5e1769a5ae99a55aa94da4f812361c7d3c44bb5efa0b5cfd3cc87877f861d46eb32aeb8d4db59bc6d11853377cc4d6f22e9d6fe418afbccb410c2793828ec57f77063a633a079eceba73eb36669f78be51f2a18efacdfd161697cb7e3938de669d2ea6b759e47ec756586ec7c3fc044cec036cd8f1a31db7698ddbc4a2fbc7aa2ee7caa06b799c5cd11add9492bc4196c76ce79befa05723029a41fe0512f0f9f39f1c3919a1a61200bd674ddf3c19846a1417c8bbb0b5c797fa9161d2a60b02c5a7128bc81f4f3e3fc432dcfa89f5b1aa66dbcf5ba891e6bf6b710350b00f00f4b52840e1a23957e73949ecbf936b7b0f87cbfdaeffc1da9b941aa693641e4e27bbb2817d014708a7fb7e6ebc43048e6dce8f581fc65157cf14b775df4bb3b0bbdbf2e42d70a418fefa653c07ff8d2bf6ff6df87700979595cf2a030000",
+		"78f125254e50c1626c8e59449b9d1dd0": "1f8b08000000000000ff6c934f93aa3814c5f77c8ad95b5d4d3362ebf226040c183410f9b7034410c17fb406f8f453ead4ccab7a2fcb737fa9e49c3af7e37910b1a8fb17269ea026c520c853fc5018a5d8a931861d2e41520425e54e37fd91cdfe140485e98ce9f9fc5d687d0d0e2acb6b75acd71bce0da8d1c8782715cc6323e0dc22d20eb623110c110bbeb60423e670cdecd23079e4ad7e89050919e2af19ea99bbd5cc3b25c9a0c4a15ea7915b67234918ca5f00ae18cffeb64fccd79bc2327f72ab6f56adfbc804c9193abf18e89988357257fe0c42614a7560060cccd8cab5410766a0d494eaf8d6e87f9ac22cd9e3116c54ba018258401308e63149ded6289117230efb4bd22e86ac6d2ae6c5d284d76c49e49750e2705a7a27bbca5af7926bee83f1b934de975744267566354d76e2fd7284ddfb11c6cc26189388f6dc5a0c4a32927b12555516a12ef1f53ad354190a10a8ccff4d9ca2ffd36708491703f857beff9e76855a2a6db3dfedfd906dec79a47a95ea2ea03d3167d98f5fd05a91331c575b74a3b7a23ba61eabf5faf31ca71de92c5f5fddbbf344e96b32e92a6f21a25b795655e98fb07e7e75c9e708f6738280613405693c7d7baa00befc44402518707b72cad267c436e050626c68deaa3fed779d66721583b799d4fafcda56f77a77516fc1a79d423f5dcf3c5efd522ae5d5aad2a56020130e04f4c67cccd473d45ed20d04995ff29fc36c164f399a078f5b5a14c7592d36e2409d1c53d377957b906f6e17cd5eccd4aa68939e2e82a9157a93bc30e6fbc13663d3ef7687eb567916ff83b8c6efdbf0cf0006a3d8d62a030000",
+		"7ad240c605d011c812eccffbc42ea66f": "1f8b08000000000000ff6c93cd6eb33a1086f75cc5d94755535a68bb1c83314e315f00f36376011a1302f96b8989affe886475743eafac671e8d349a779ee68730a1e13f0e8e39f5a8031ccff0c960943a99761cd8da12144520690cc137350fafcdfbee2314b0dc5ba348c6a6568e14f4eb5852dd2d3128aa9a0e0706833d819714a3963959c626ac214632cc1048eeecc3b622fd50bdaec64d8e27af83f451abb99b856db543c4a8076f1466af85f931110ec54338724c3e6fa5a66359b46d55a09f32b1baca5c4ebe86e6e130ee1de21fa336c33618fe2a2bbfad43d681621dbc3057dee67f3e337d67b7991977d82193453fca89849b4511c16a95a51a7386f07d3c07b1afc8f47e367979ad07eb2438ce198aee35034d2c4c4d6fa4b8bc89dcea3645d8551a970cd577c1695954bdae0e6c67f5dfc4fbadc9d4074378ad38de32b424c62cc1c4f2864c5a9878fcbb8c4a861841b73349d8db27484c1ce7f13740611f9614109c8fc3d9345f26972762fa1c17851dc50dbfe22a48f97293fadb57eb97e2d324dbaa16bd13adbad07f6f0dd3fa135f86f2b0309be7e6d2ea8bace5e0876a31261ddae55dc5d185c1db3c4ee32a8c9e553467009dbac7360c3f49f163bff28224f65054bb3007460984a2d4078595ab849bc54b0e91ff8c20553037d2707a3488de309591ccfa35cb0e1a6827f360bf68abb3ff369c7780ca7825ece25c1c34d94cbbf5d192ff49a4018abab102150395e4fae2b6c9987eacc3fa78f225a37925ec844deb3fd9e97db23409ecc37bec7f591f0e45bfebef53901a186d175b91d9bbe755b1d91c7b7e6d826b9ffcee8f3cb6e371243ab38df96a9e70e8feff94fe1d0025e62de567030000",
+		"80648fa26023e719f269ccb85fbc30ff": "1f8b08000000000000ff648f416f82301886effc0aefc640b12c72fc3ea8a560ab45a7c31b0e286296653887f4d72f24bbed3dbd794ecfb398868c0b35dbe5e2080736cb5831c18523054fc0309028398e5f7c2f690886f128fafb034b907b43390804adcdf2d6c555f08d9bd0ad0da9afce59376df75c3724b32bb1f5c71e8cdd6c2fe33eb9b639a80820a7f3eafef15986dd21fcb9a747856ed15f5ead4b8327b54ed63427e256e33a2e5b0c762f600aa2ccf9444a9f2acfc618f56ff52aa19afba9b79cfb37fd787fa4327026ff0553f1ffa8df01003288240bf1000000",
+		"815566ad247289ac91709667630a03c0": "1f8b08000000000000ff64934d73b23a14c7f77c8abbbfd3117c79d4c55d9cbc926ab044c4e20ed182086a0b72904f7f87dae9e6c92af33bff99f34b4ef2d22fc2a5f2fea1dc044a280a01efe18ba595a28a510a499c022a02a932a00e7fcaaf198723fae2729eafcad11b99da48d3482dae3bd5e536075418077c6969384b70369c649a86a16e790786a45e48200de8d9cbf6b228f7a3d77bbce5adc861f3ac25010bbd6c7f22d24a4a718f8645170d67ad60b07e06ae011d86e368ebe05e6eeed1705e6b93a0f02316fa3ee338c9a3f5a4384a515b896c8b65e935fb008e02ed8766f0d06c832ba61e9a9158a0dd3d99fa655a562dede0b56f6611880228c2401b8dfcd94171bcb168dbde76e5fcb12f8b4c9b08057cd75c8e4e106dc7a9b9bc66fbd2bb59c9d06bb40164e97760c9f1d024a52992327c6863a3c41fedd6fc728f3ef57b7beba94f769a68491e9f72adc77348b9a4f4678fdc055b01f1b0cae428346f2e558fcff5fa1e9ea85ca3555fceb79a2ff3637c1e5c1653ec72d7873f87cfa670aff1a89c5788a28d573cf5fda6b3b7ef693a13c3a4b639999c4cb5cead20204b0de37e9407869c0cd0ef67eca60c747f49aed1043e661c72004dabef9c42fface902d4aad2567e9ce6cd2e79b72717935df8cc997aa77fe99162917917b10b83762c07453bd370fd3d16014dc14e41f30db594cf56dd17b6b736cae69d59d4830fa59cabecaaa499d78b5478a783ae47b11c8df11d551aaf367aea0c9dbb68fc93b375adc1d171e4d74255c99409b1b4fd266c5707b85561f29fd53ff617eeb1bf7fc0ff03000f58b6131e030000",
+		"830b80786c5d974bb7b7c26eeea2474f": "1f8b08000000000000ffbccf3d0ec2300c05e03da77817a0ecdd50c5c042587a00933c50a4fc54ae9110a747e9c405ca68cb7e9fdeb545fa791d1d70ae72cf1c61faa203a69c58cdcf97c86ae991a8fd0898a87d0c621c1124506d3d0619f896b2640ea195435f0e0bcbf6e0f529357dc452ab92e79aec271261631c702375776d21d501a75852dd1d93ae38c06ba4fea15cd348a5baef001e34df7fd4010000",
+		"86351cc6f91e7658f97d2e8d70913904": "1f8b08000000000000ff6453cb92aa4810ddf315b3373a446c6feb6216590fca520b1a28d07227b417047c742b26f2f513e8c4cce2d6aae264469e47c479eb1fe1427a7f511e6ae94a0a9af7e09ba5a4a49f25a5b08f724049209721785d7eb95de6939f65aae15b9f9819845f8834377279decaaeb439a0c49de62b4b412560147352289a24aae51d8424f71202b9a69557a4a23ea6e345b35bf3d62d217ecd32cd12af480f4458d9d16d8c5377c699b62e83e8b570d6d449decd7a84a9881be3cc6e2accd00d0c4b8280719c94269ad47be1deac4cb4f5eae8dd530d7b17ed8762f0502c469fc9876264e7a2ddbd30f91fa644d5d20e163d9945c068a813ad4285fcc520395e9859b797ed71f6488f75a142832e3c67738e236dd6ef79785a14e9d1bb5899e3dd551820cf9f0b4b8eb7a7b4ff95f18d22f1332768956f1cde6c3745916ec8751b4d4a2b756c5c6bd024cfbe8baaf43f0349489efffb5784a0470122d2a1c9fc6873211d52561bf5b3802f7b947c583a98a7a2a227d72fee9118ff54f5fc311a6ec65533627509b30f1b0ff6c48df387e2d34143c273d5fd5a86f190ee7f9f1d1bad5d077e1fc63c9812f83de50414859dc1799f4768d7843cfdcb251a4282780e2805a5570141ec1254c45230edfd7d71e4648881ab40f58770f93ae0132ab9f4afaadc7f94f76db6b127a7b038f1e9e8c33b0ce89e5a5817dec9dd26ba7d17c3ba9d2a380b4abf45a4de674f35760e8ac75406cc46db548381d746d793f96952d6b59f60c1e8523e1ee81d58328b0e53ee36136780320fc6f7a6f85cf9e75dc2ebd4a67b2d32757156abddc2bf21fce2882b671c07d6b0327f5b7d2bdeb8c7feacca3f0300779d601347030000",
+		"8772f198e8a1bc480cad22c715a3dce7": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"88e610cbee09e709b5570cd9314076ed": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"88f4b7fbda82d4ab14eb03cc7815e5cb": "1f8b08000000000000ff64934f77b23814c6f77c8ad9bfa7a782daeae25ddc1b428c183436fc093b410b22169532513efd1cda9939d333d9e5b9c9797ec9bdcfd3b090321efc41e856718f135074109f2cc139897b42a0f00b301ca1e012e3e3fbf8d775c4456cbc6b58a873b6f9e8c1c7a2b896a76abd91d2850a5b215b6311a9dd484a46cd320a7baa0452067648090a5f3a5ebb8bd33ff3f3f4a2158d05caaf1ade45103a5ec769fab0743cad764950653d0d05f2af03a414ab3429cb2cc1367d9b569933bab30a3416418420144db66dee04e5ea1c4d2c1ddb266361a79df9a7c049e22a6e84cb4da060222a6e84d724ae125f9aa8fed54cace84ac08959832396824491b8d31eb6df2e8522a7a0cc587dcec6cb6e17d3bb5741f85dcb951b05657644969fbdced24edd6b67765f28c8fe41f4d8fc91b2e89126bc1f307f507a27438d5ef84dcafb6a442c909affbd7141e6ae2c8086b5fddcac347ab2b8c6c7f15e7ed85a6e2ffbd45befddb1d78d3e92d1f4b8cc65b074adcbe33abdbdbc749e0dfdd6c9e2694c6ec1e7739b5ebb4d53e6a69e92fdebdb4ea9eb1acf2ec15ac17a205dc819c2fb8c5a0882e00ecc6268e77654236ae381e6bed188325c80a18c9096810c3d3482083043a7f6d4507c36925802c0f8e6eb7285441ade40e16f9c17274eb99befdadbbed81c56132f6fed4379d8cf764b7ca07d7959ac4373becc8ce57f3b6f9170c3390fb3f1f29426cb3aaf44f773161c42fef3b9021a46c895596f62321f9e01a31c049584932d17f52fd634b5e3b09bf3ba39ecf529feacd2f97879ca9bc7bb1da2784dfae36a02477cb5d6137fa2299b6f3b799bd1c3ce6663a3ceeca35907773bcab3e2a28bcb054f7ef1fbb735a4e78906eeff23f5d70052ecd0c06f030000",
+		"8df79364160e508f741e65f4c7ff6d95": "1f8b08000000000000ff6453cb92aa4810ddf315b3373a446c6feb6216590fca520b1a28d07227b417047c742b26f2f513e8c4cce2d6aae264469e47c479eb1fe1427a7f511e6ae94a0a9af7e09ba5a4a49f25a5b08f724049209721785d7eb95de6939f65aae15b9f9819845f8834377279decaaeb439a0c49de62b4b412560147352289a24aae51d8424f71202b9a69557a4a23ea6e345b35bf3d62d217ecd32cd12af480f4458d9d16d8c5377c699b62e83e8b570d6d449decd7a84a9881be3cc6e2accd00d0c4b8280719c94269ad47be1deac4cb4f5eae8dd530d7b17ed8762f0502c469fc9876264e7a2ddbd30f91fa644d5d20e163d9945c068a813ad4285fcc520395e9859b797ed71f6488f75a142832e3c67738e236dd6ef79785a14e9d1bb5899e3dd551820cf9f0b4b8eb7a7b4ff95f18d22f1332768956f1cde6c3745916ec8751b4d4a2b756c5c6bd024cfbe8baaf43f0349489efffb5784a0470122d2a1c9fc6873211d52561bf5b3802f7b947c583a98a7a2a227d72fee9118ff54f5fc311a6ec65533627509b30f1b0ff6c48df387e2d34143c273d5fd5a86f190ee7f9f1d1bad5d077e1fc63c9812f83de50414859dc1799f4768d7843cfdcb251a4282780e2805a5570141ec1254c45230edfd7d71e4648881ab40f58770f93ae0132ab9f4afaadc7f94f76db6b127a7b038f1e9e8c33b0ce89e5a5817dec9dd26ba7d17c3ba9d2a380b4abf45a4de674f35760e8ac75406cc46db548381d746d793f96952d6b59f60c1e8523e1ee81d58328b0e53ee36136780320fc6f7a6f85cf9e75dc2ebd4a67b2d32757156abddc2bf21fce2882b671c07d6b0327f5b7d2bdeb8c7feacca3f0300779d601347030000",
+		"8ee350f23dadaf5a59318e312d79e6e7": "1f8b08000000000000ff648f416f82301886effc0aef8b01025376fc8ae5a3c3762d904abd6948ab128d9829ebbf5f4876db7b7af39c9e67398f506462216ba6a1a58b8a9a192e03ceb0044781138ec48fd8f0f4031cc53cfffb132d0946d36162049472bb361bcff1a7eae3cb5659730f94fe16bbcd759030ba88094c8a6332a45b7f467faa41e400edadc96c79e43f52126ef27d75f0b64dcab2b0d3237e04d89b7759743aacdef6fda98bec4aa4d5d53cb3fad5a9170fc32103f7d58454eb6e4d2efeb67eaeee48d260f65f52b1f91ff53b00f2db108ef1000000",
+		"8f705345cbb04227ec1e7244ddce696a": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefc62ed862a0616cad20398e451594a9cca3512e2f4289d384147cbcffef46e2d639ab721105d941f0503b9bd1088c622509fe66b86ba3c05d6434423ac8f891d03254e30dffe13c766cb29e2cd752d88a9d5bfbe892bea7e35d9c22a1f7669ca6556f19fbf94762b10dd013b865c010b44e75c458f11b953a1a732eca89acd320c16be030093db101ce8010000",
+		"93184f40f0c2f8544b36c903894e4a4a": "1f8b08000000000000ff6c934993aa3a1886f7fc8abbefea6a1c8f2ebf4c10edd0060318762d6a5a26470cf0eb6fe9399b5bf764957abea75295bc79df9f0b518f07ff601a2ace3806459ff0dd119ce3b8c7180e43039623305c1e46def4803f2fa89c9e0e5351efddc25c735822632e3f45feb59292408e8642deac83a526b1941eb58b38eaa912887a30882846622987ecf69da48fac9a9cb5a28940f23543ad08a2216b384d3b472793fc7b13e4db9ea602652f01ff08b91d2d6ab19e947b8fdd33af2d3fabe0b155f42090fb72a015c9ce6b7b470f69f37719f6ccba9d20d00912d92fc23b41d037b36eff9bf117739e50f8bac53d2c900962045a41192b110a4b7f5f8f537b263a69cf6935efb655f923426d19bc66be43ed40e9646cc27af1b3ad8273360c1e222c2cb32f61495bf4c8aaacfdace2b14e0676eb458d1eceef229c595f6ae2c45212daa6eed60f72bd9efc5d6685a556fbcb53cafbdcc52035ffb3270ec88c4803b4fe3203976ec26e23f86ed3942c9dc107fe3556abf9e8b62a1fd3d8db378bcff1045177490ebb265525b0f5c539ce167d9734fab4ef44b5094c3d16f1f230db45c1d5c3cbe02ebc9b25469338745720fd0f049280f1aa3f69ec9c8594af7c31be79202386acc0c8982b329421991150b07a3eae1f0a048719450ac8f320818b679c3b87187944184ddedcc140b6d7e0ee076d3119f965e6be4d770b1b6934ef7385d7352ad761d860f5df1fe980093810c4e048c13d6e4ead1c4a6dd164463fbe4a7ffa8b6e8e6ddbbd753391d4a3dabfc83663db7c8639393647a46b678a56741cd7c791ba7345a3d3f23a488b39a3706597dd384fb3a2d3ceb335ef3420ffafd2bf0300881eb19267030000",
+		"93e6f5fc5b2c90b8b0f0a2c662fc4fa0": "1f8b08000000000000ff648fbb6e83301846779e223b42344048187fd31fdb2ac65c42046c1484515b4168132e6f5f2175eb377d3ad339c63e8294478738e537b8e2e10dcb1d1a9ae0948142104450b24d34138e070aa9effffd0519a12f4bbd700249a224ffb26cf39ceb47330b8767a1dd59bc4ad55eca360d6ea3ab17a4d5edcf31989aad4f21f201ae8dc7664b9af8f05ad79ad49c0471138bd52a2bbfd6b58fbbf3f310dfeb391be9c0e5f41ed61ea93b8762decd7d94f7c7933328f7528563f13c4155cace26390bb4dddfc0e8f57fd4ef00ffa00deff1000000",
+		"96f6c28ec6d4cb2f26c765b13f9b4839": "1f8b08000000000000ff6c93cd6eb33a1086f75cc5d94755535a68bb1c83314e315f00f36376011a1302f96b8989affe886475743eafac671e8d349a779ee68730a1e13f0e8e39f5a8031ccff0c960943a99761cd8da12144520690cc137350fafcdfbee2314b0dc5ba348c6a6568e14f4eb5852dd2d3128aa9a0e0706833d819714a3963959c626ac214632cc1048eeecc3b622fd50bdaec64d8e27af83f451abb99b856db543c4a8076f1466af85f931110ec54338724c3e6fa5a66359b46d55a09f32b1baca5c4ebe86e6e130ee1de21fa336c33618fe2a2bbfad43d681621dbc3057dee67f3e337d67b7991977d82193453fca89849b4511c16a95a51a7386f07d3c07b1afc8f47e367979ad07eb2438ce198aee35034d2c4c4d6fa4b8bc89dcea3645d8551a970cd577c1695954bdae0e6c67f5dfc4fbadc9d4074378ad38de32b424c62cc1c4f2864c5a9878fcbb8c4a861841b73349d8db27484c1ce7f13740611f9614109c8fc3d9345f26972762fa1c17851dc50dbfe22a48f97293fadb57eb97e2d324dbaa16bd13adbad07f6f0dd3fa135f86f2b0309be7e6d2ea8bace5e0876a31261ddae55dc5d185c1db3c4ee32a8c9e553467009dbac7360c3f49f163bff28224f65054bb3007460984a2d4078595ab849bc54b0e91ff8c20553037d2707a3488de309591ccfa35cb0e1a6827f360bf68abb3ff369c7780ca7825ece25c1c34d94cbbf5d192ff49a4018abab102150395e4fae2b6c9987eacc3fa78f225a37925ec844deb3fd9e97db23409ecc37bec7f591f0e45bfebef53901a186d175b91d9bbe755b1d91c7b7e6d826b9ffcee8f3cb6e371243ab38df96a9e70e8feff94fe1d0025e62de567030000",
+		"9da360bee5fb8602a0dfe5f61f715524": "1f8b08000000000000ff6c934d73ba3a18c5f77c8abbef748ab4b475f9e40d83060d0668dc29da2088c55209f0e9efe8ffce5ddcdbac32e7f9cd992427e7f1b6100d78f417a6b1e28c6350f4263e3a82739c288ce1f360c0720486cbb85c62a3d3f8388f1630179b37337b3d2a9823632e45552e57521228d128646b1d2c3549a50ca80dd364a44a201ac024a11889b9f458bbcd365d5efb8d56341348de67a81751e2b12ba79bc1d1995f6e3fa27237d28d40f91dc08590bbe7f02cd6fee910b09f3ce84f8b3aea768ae6027ddd19e885d21ebd3abf837060d61d04814190c42e091f04415b66ddf18fc6ffd51c31133d1e2144264a116805a754895858fae76a9cda86e8ac6f36f574d8d5a742c4da32b8cf66d44e94a3b317139fc36257474dee459d882bcbec1d98d31e75799df78b3a7dd1d9c4ee82e4aabde98f88ad0dcc9d210eeda352affddf4156596af56cfeb5e163e962909affb3272073220d500774f5fef6d590ee994dce84adf8d8d9bd3a94110cad7f6ddbe9a57dc65394d6e3badbe34563d75d1ffacca448c86450be73c80a33e4cbc93eacb2c590e7303dd4d93cfa11416bc9ed98b1bb02397b42200998a016c8bda5b00fa5bcc7ea60dc06201386acc0c8986f6428433227a060757bd8592c107cbe53a480dc8c04aeee06c4c823c298385ebce8cf9ffbd663d2c510af1e4afffd5217d772dfb8dfe953b885fe65f91acba2fccf4f341107829803470adfab95370b1f3e9bd07f42af5503cfeed8ea966dfbcb05fb43b09e147994ac6d5019cca15bb2ee0d271fea7bef5ece0efb1187233eed72b6635e5b051f1fb57d785a149d5a4f9d5b5b1e6944fe5fa1bf070017359eef5f030000",
+		"9f23f9fb705a05f254a06edad3890281": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"a3362bbed02066e2b1c8cfe4a704bf2b": "1f8b08000000000000ff6c93cf92aa3818c5f73cc5ecbbba1ab5b12f8b59e4cb1f881a3088d0b013c4208a62b712e0e9a7e44ecd626e67953adfaf4e253939afcf05d4e1de5f980621671ca3903ec55743708eb70463742814d21c90e2124f9a14559bc3eed89acbb3825394b3378296a0d4ad3c55fe5a4a822a1884fcd606960989a474a85e44db818602a883265b8a412ce5947defe2b4cd6bab49421a0b90e30c3ae16da7ecc169da1b496c55bb4fafca069a0ac84700974266b3c5451cad73e1b07bee74e755edb559487301d791419d0893297d183f83a860daec0541bd205bed13de0b023ba6cde1b7c6ffd30ce18a0e0f6801ca8b0025213a47a10884a6bfafc6a96e4812774d5adb7d569f4b11249aa171e6523d098d247e57c1655166b5d7e453af15c149333d024bda419bd779bfaaa3f7249ee8ccd93e92a97d1781d68e1a1962d0ceab928df533c84e9aeac45d5e533e54264632e1ffee099239910a5163b60e3eecaaed5687cfe27ebbae4c08676410fbda91757b9d17ab55b8fc559837687a72abfb692be3cb0b94ccfd780f23be2f8c62f1eeb7ce66ce3e1ed289c9c5d611743bef2e9c6f4d9ec70ccc3592ee1b204990726a01e63385fd42ca315603e36f07c92d032d3028f5058a32903941215a3f1fd60d04a0c32f0a21224f23814fa30151f208983063f376898f0f6b809bdd6a8a9969565dea7eee27bc9a488b7da5bcc8aed6b409feff1395e72202d44047507691d5b88fab839e6f109d2f8b177e6fe6d797b0adb9aff79baf267923f6c3579a2b48ccbcdc9f67d1a1acfde3101b837e9905c7caee3d74dd052d9df8e5da7ad02f7fa2fe369e6d79a51ef9b342ff0c00ed6d643e5f030000",
+		"a4b56cf2fc558ba44aa8050847a8f917": "1f8b08000000000000ff6c93cb92ba381487f73cc5ecbbfe055ebb5dcce224841034e90e721176821a44d0f682019e7e0a9ddd4c56a7bef3a52ac9c9efcfb010a14cfc85891f30876108c800ff189c31fcdd630cfba502cd1028e6c38ff4fa56b4878836c7afa0f0d9deb470aeb14ad8f292b2beb40868a6772559191c4e1446214105c751c45bd2838f948810a8009f4491d1aace265eb38d49eb9410be7b796047a2c88e881a79ed34c9b8ea93f1574b03d8bc854b40e8a24b7bd2a49ba2c836e89eae676536b65ab787dddbe18173f6ef463e16c5aafe5f59bb452e78099a9730e2b6ea863a1e58ff62ddc08c172c51c6e55d6399d8919494682f0a7b1270445ed7c3882fe5d8b96fe3f499d7b3df24203147f2d53350cb4538761a46d22e8967e57623caac27078eac97005ac732f61fdb7806d9c43bf3f5acda53e791d3b65ad5e26964014a39e2147557bae6d305284231feb7d6c4058b011259685ee6fcd787f4c78c2fa3b85f9abc5e7d26f7c6e8463d3efebab2a2c5544bf3b99f7f3acdf914e3c3f923ffd9a8cd1c9b09654f709db9db3ae5bee999e8f0a19ad46566a3abc1613a1c75676b824c2d87f93a17fbfdd2ee3a24efd1a91b52c44132b741266ca9138464e80e7fc1b021b123df0a40ba2602a6c186db7b33279e0d4785d1ecc31a8d647b130f57b4a7d9c4ad72eb63bef37498a085d197015e9f51b5f6fd0607b0444a5d8b53f9fd23a50d4ab860230247947fce3fa6def1be9dccb2ab2c764de57de5cfd3313260bee8be97cbc7c144e6e371a31c9812d9ee12edad51f2dbacf799b75ae5bb824df175b2a05cc9137e4ceae4306a2e071afe6dbc124184fddf94fc3300a963854942030000",
+		"a4e258b5c4031f8464e1d5d5c18749f7": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefc62ed862a0616cad20398e451594a9cca3512e2f4289d384147cbcffef46e2d639ab721105d941f0503b9bd1088c622509fe66b86ba3c05d6434423ac8f891d03254e30dffe13c766cb29e2cd752d88a9d5bfbe892bea7e35d9c22a1f7669ca6556f19fbf94762b10dd013b865c010b44e75c458f11b953a1a732eca89acd320c16be030093db101ce8010000",
+		"a6898454b6650cbe4a19d8ffbf1acf67": "1f8b08000000000000ff648fcd4e83401446f73c45f78694461d7079e7f67219f991191c6cddd1f2535a6d6ca2a5f0f486c49ddfeacb599de3ce93c42a5be44695f04a8b98b633749d5471041d412a5396e3858bf4e1093a62c4bf3f5024d91baa4149d0ba7bae9b4ab6555fc8ef461c92bd93882f75262c8e7b79ea03bf790cc9346b8072250e063204306fd6ae325f7bd8b65b73fb49c4f4115e97ed91279968478dddfdeec582886e931f8798d71af17d3ce98de500eecacf7809a6b7fd39c7727709228d81c7d77ae3ccfe2e65ebff51bf03005e627844f1000000",
+		"a896dfc9ffa02993b5f833919eaa4cc2": "1f8b08000000000000ffbccf3d0ec2300c05e03da77817a0ecdd50c5c042587a00933c50a4fc54ae9110a747e9c405ca68cb7e9fdeb545fa791d1d70ae72cf1c61faa203a69c58cdcf97c86ae991a8fd0898a87d0c621c1124506d3d0619f896b2640ea195435f0e0bcbf6e0f529357dc452ab92e79aec271261631c702375776d21d501a75852dd1d93ae38c06ba4fea15cd348a5baef001e34df7fd4010000",
+		"a90300fbb47af825910cb4c76a575a38": "1f8b08000000000000ff648fb16e8330144577be227b85440454617c8687edc626e0522ad88054903845c5a105faf51552b7dce9ea4ce7d8db08529eec52c50bc87177c47283b6253965d0214822295947fa2abd003aa461f8ff6764843a733d730259d6c5f5a530347f5157b6ba0373adc21985deb375a9aae8777a8a4cd6c6c1bd3d9981f60a9210206f0557d3fe2d5fe68b1cb536b7b2ebf55d54feb151a1e5b99f5fa9bf7e1fa4b738701a6ae3ab673af93f8d94a47dbff61c511c82f8e6f1949c077126f051ea06adcddfc6247a8cfa1b00c84829c9f1000000",
+		"aa717a7a1501c3360e2febe75b3a0fda": "1f8b08000000000000ffbccf31cec2300c05e03da7f005fefc62ed862a0616cad20398e451594a9cca3512e2f4289d384147cbcffef46e2d639ab721105d941f0503b9bd1088c622509fe66b86ba3c05d6434423ac8f891d03254e30dffe13c766cb29e2cd752d88a9d5bfbe892bea7e35d9c22a1f7669ca6556f19fbf94762b10dd013b865c010b44e75c458f11b953a1a732eca89acd320c16be030093db101ce8010000",
+		"ac552dae4d7e0d15e4662fe73f919024": "1f8b08000000000000ffbccf31cac3300c05e0dda7d0057effd0315b091dba345d7200d57e0d025b0e8a0aa5a72fced41364147ad2c7bbb58c69de864074517e140ce4f642201a8b407d9aaf19eaf214580f118db03e26760c9438c17cfb4f1c9b2da78837d7b520a656fffa26aea8fbd5640bab7cd8a5299759c57ffe52daad407407ec1872052c109d73153d46e44e859ecab0a36a36cb3058f80e00703512f3e8010000",
+		"ac8f8d4d664d4d76cd03d057ce82aee7": "1f8b08000000000000ff6c93cf92aa3818c5f73cc5ecbbba1ab5b12f8b59e4cb1f881a3088d0b013c4208a62b712e0e9a7e44ecd626e67953adfaf4e253939afcf05d4e1de5f980621671ca3903ec55743708eb70463742814d21c90e2124f9a14559bc3eed89acbb3825394b3378296a0d4ad3c55fe5a4a822a1884fcd606960989a474a85e44db818602a883265b8a412ce5947defe2b4cd6bab49421a0b90e30c3ae16da7ecc169da1b496c55bb4fafca069a0ac84700974266b3c5451cad73e1b07bee74e755edb559487301d791419d0893297d183f83a860daec0541bd205bed13de0b023ba6cde1b7c6ffd30ce18a0e0f6801ca8b0025213a47a10884a6bfafc6a96e4812774d5adb7d569f4b11249aa171e6523d098d247e57c1655166b5d7e453af15c149333d024bda419bd779bfaaa3f7249ee8ccd93e92a97d1781d68e1a1962d0ceab928df533c84e9aeac45d5e533e54264632e1ffee099239910a5163b60e3eecaaed5687cfe27ebbae4c08676410fbda91757b9d17ab55b8fc559837687a72abfb692be3cb0b94ccfd780f23be2f8c62f1eeb7ce66ce3e1ed289c9c5d611743bef2e9c6f4d9ec70ccc3592ee1b204990726a01e63385fd42ca315603e36f07c92d032d3028f5058a32903941215a3f1fd60d04a0c32f0a21224f23814fa30151f208983063f376898f0f6b809bdd6a8a9969565dea7eee27bc9a488b7da5bcc8aed6b409feff1395e72202d44047507691d5b88fab839e6f109d2f8b177e6fe6d797b0adb9aff79baf267923f6c3579a2b48ccbcdc9f67d1a1acfde3101b837e9905c7caee3d74dd052d9df8e5da7ad02f7fa2fe369e6d79a51ef9b342ff0c00ed6d643e5f030000",
+		"adc72468d6e09d166f5fd7bf882c5e13": "1f8b08000000000000ff648fcd5283301446f73c455f80691dd1ca32a197f4421321ad4097d89648c0fe8c90004fef30e3ce6ff5cd599de3cea3c0502c12891939c02286e30c5d8723db12058453cee8f8607beef944010b82bf6f614bd9ca9616294953359cc2b69cd68d9962fd739e94b334b5b9bee8cfe0adf6c31ebc983f68a4c3f5e4b32f49444088cc3fc6eebd7c1a8c1685490a4ce9fe7e3c5478eb4e169dcb8a4327be05bb46b2aab9877579b9876db6939b7167fba8876a8874766e9e8b119136ed2dc997f9ab72667f17c4e67fd4ef0052ee3e52f1000000",
+		"ae252de36ca60b64db4dc72509a77434": "1f8b08000000000000ff648f416f82301c47ef7c0aef8648d4d570fcb7d4824d1b6bd5696f58baa26c1a3303944fbf90ece6eff4cb3bbd178fc3941572b2dd1547d8d309a7e711c69128580e9e82c082e1f0645a2c53f09411f2ff3b9a63967465576050ca1b4f507f6544e6f85e72778b36ade3d6ced3c5653a040de1a9510b8b3418a3eb1d4802a09d50ab4dc5e7d930bdcd5686bc12d49cda1e95cdf9f32bea9dcc0efb07107b970f7b2d8c1cfc6b9d2cad536ac63f424b8e8156a76fbbbdfc1ceab5464d5da15faba3d13fa6327b8ffa1b00ddb40f5df1000000",
+		"b3530a182d508f92772c331e979209ce": "1f8b08000000000000ff648f416f82301886effc0aef0b813974e3f8b57e944a8aa00ce96e0e6299914ea650faef1792ddf69ede3ca7e771e711643c5d647b5e42818b04e50c5d4770168342104430627b761041080a19a57fdf604c986f4e8613c873256cb76b9a9a9c97a310df8372a6a45a7d9e7be93dbdfbf6bec4112fd5e9da8d2fcdd0ee21a50045b4d57d3915e97662e44e330a1a6f4cb6609fafabd6494cf546a3319a8c4699c454e4474f17b6be81ff233d451e87323c6aba5bf3cb3a0bbeba30a8c5ebe6e3e1ccfe2ea69bff51bf0300b472366df1000000",
+		"b658fc077e985984fc2e4ee71a71b74d": "1f8b08000000000000ff64924973a3381886effc8ab9bb52c63424f8a80d504032c262bd19ba23cc122fb45b985f3f65673a97d149f57c6fd5f748f5be3c0e243ee5ff209248ea51042479c01783518a820e21d0e40a680a81a222ff9ccecece94fad34dca75dabb9f7a7ceb400895bab47db78b85c0a0836726266d2051e24c089fe8f72c5d886490f86093120459282c6f3ae4d59f6674cea5243983e2398333e3a9e5dd28a9ee46993bdda1e05dbd909441fa0ca0964555d1b67501a76aef74b565cede0232a87806019378e46d346676996fb451fbe9adb4b6bf19b40b2ca966986a2e81cd3aaa99772ab0644fc6ba6fa60f0b8918e89fcb0cd83294656c260b48be3628897aded6fe30d63fde6f879ccc5e07d2af592371c6dbfa08fd66f46ea5352c4669b933c160f7159824b4b6f7cacfee5541e74082faafb6e77ff3cd5ffd87bdf1d4f77a4d741984a78a2e9d898028e97f770c44838502e4f3f5f0272dc69d738d3f5cd35a2db7e1c8d6c3d9801795bceb78efaf9bd892aba102b146c370587f9c6bd574c7eb6b20ca1bff7dc1d9748a8a5c9ff1fe38935ec99906af9c1b8c4c1aab126789190311ac211018a85f8001fdf8a49f4413b8d602310074a89fb90e22a1e909a8577de7c6db6db71de60f39fef4fd462ec56445f6bb1da19c584d366471a0ea5511688dd4f7b308d014271ae8045065447e4837d45eadde7e1165fd3085b9bfecd2d98d26a6ddb8818ceec9d60e45196b44911f5ecb6b78c87beef6cd0638f7b3115e78a2cd4d28a472a0ed707e748f9bade7188fa2bf108effdffe7f07009099f8d71a030000",
+		"b83cca584863fce0a64fb8579990eb59": "1f8b08000000000000ff6453cb92aa4810ddf315b3373a446c6feb6216590fca520b1a28d07227b417047c742b26f2f513e8c4cce2d6aae264469e47c479eb1fe1427a7f511e6ae94a0a9af7e09ba5a4a49f25a5b08f724049209721785d7eb95de6939f65aae15b9f9819845f8834377279decaaeb439a0c49de62b4b412560147352289a24aae51d8424f71202b9a69557a4a23ea6e345b35bf3d62d217ecd32cd12af480f4458d9d16d8c5377c699b62e83e8b570d6d449decd7a84a9881be3cc6e2accd00d0c4b8280719c94269ad47be1deac4cb4f5eae8dd530d7b17ed8762f0502c469fc9876264e7a2ddbd30f91fa644d5d20e163d9945c068a813ad4285fcc520395e9859b797ed71f6488f75a142832e3c67738e236dd6ef79785a14e9d1bb5899e3dd551820cf9f0b4b8eb7a7b4ff95f18d22f1332768956f1cde6c3745916ec8751b4d4a2b756c5c6bd024cfbe8baaf43f0349489efffb5784a0470122d2a1c9fc6873211d52561bf5b3802f7b947c583a98a7a2a227d72fee9118ff54f5fc311a6ec65533627509b30f1b0ff6c48df387e2d34143c273d5fd5a86f190ee7f9f1d1bad5d077e1fc63c9812f83de50414859dc1799f4768d7843cfdcb251a4282780e2805a5570141ec1254c45230edfd7d71e4648881ab40f58770f93ae0132ab9f4afaadc7f94f76db6b127a7b038f1e9e8c33b0ce89e5a5817dec9dd26ba7d17c3ba9d2a380b4abf45a4de674f35760e8ac75406cc46db548381d746d793f96952d6b59f60c1e8523e1ee81d58328b0e53ee36136780320fc6f7a6f85cf9e75dc2ebd4a67b2d32757156abddc2bf21fce2882b671c07d6b0327f5b7d2bdeb8c7feacca3f0300779d601347030000",
+		"baf6b0f8ed0a43ee1269b90ebc08e24f": "1f8b08000000000000ff8c92c16eea381486d7d74f61f96e7bc18e9dc41989051450d576aa96160d144523c73e06b726714d82a04f3f8232ea66a4b93b1ffbff3ec9bf4eeb5a0f7880c9a38348105a8526b625dab46dc0032c442a040a2136f66b4a538456be5997c8c31efc093450756b828c8ba7c937eb1d41d679a8d5f62c0e0e62cf376b82229ce47f6be53d9cc256f91d20b4da36064ad41ec3391fc1ab23c13ff1f9809b888d8ba0dbaf5cef7c5b2265cc494136cdaeed9946bf43ecb9ba85582bff47462963047d744decb6788013b457de19d536718707788530c698d003a5d432952b2ab5d642426a38b592ca946acbb44d24578aabca90ab7f0928785524961945a52d92b432004240c120cf33c925552039a7f9375131a9659aa7c01329202faa226194d224559cf15c5aa824a3d464df844cb349322a86e9742893e9988d286763369d6632e33213e3d1f03a2f8a744cae507929e5aba1120580cb0f7ffc247d1744ffbffae9b73af405a5493f24a1ffb4b5c2d6d9ee851fef37bcfbb8ad6f45ca8fc3cd67d0baba7ecad385ad47f3d9cb983f343372857efcbf995fcccfb95cce1f16d5e14dddb9fdf3717d1cbf1fde5e17609647f132cdf676783b7bfc73b634aff2f7cce2627e0d70ad474f23d5452b9fe793fde16db664f5fd72787333fffcf8eb7eb230a26b5c3729ee082a115aa910f446b9ba44c1776b579fb6c7aa2a3afd4b7b0775fb8bf50441baa9ad5b7f3f12f4cf00bb739e0123030000",
+		"bb5dd56adf647a75ac3f4322a013ff29": "1f8b08000000000000ff6c93cb76aa481486e73c45cfb3b234242638e8c1ae625396ba39018beb4c504b51c41b823c7d2fcce99ef4a9d15edfff0deaafcb6bbf180ae9fec5d157d2911c14f6f0d52029f94c710ef98b864632d0d2078a5e649c8d4e1fe3b5e795d9ad0ae24f6c1aae1339ab52d9154384463679877383602fe02d40b6251e86d462073ed36ec8402bbe77b7993894d9fbb45e46d83a05043f59aeecd0dd663b268cbc74eac43c748969b54241fc23540ac5f8917658a7f1769bc5ec9a2e4645660edb890dcb1f87149aeed698977f149bc93677a980860a78235b3ffa39ea59f7648f7f991115ec4cdeb5e15e62879e27b0998641878a183eab714633cf74aecb28bde7e5e894288c8879cf8cb5e41a81e9d412d347128d8a65ec1659872ec1f529f0963017e1212f70436cf864d052f49bd5d9fbf468d06274580be7968bf6302fdd7ba6584a8c047b9cc5823ec6a05170fe7b6e70024309ecd76ade5aab6b87966560aba7fbd3653afecef33c1cf07b744ddf8abd15ae62eb323a98cec61f54effa9ceebe5a315e71ab1bbca886c3686ef961b8b91ae27bb6a9bfcefb64b22b946273828f7eab2bbb413668bcfebe27da06ea4f7ee213838d855000107fd65c19b2f1f6c46720e5778a77b11a9b5d12da9e6438f8fc3e3ece4bf1e99ac7c52db60e953dd15f27870b82eabf5a0603e230d4401870e9d9f5bd8bd3c0a72e5c5cf4426d37d1ee1144c365579fcc6437714d5f5c2a1d44e3b291da297f7d19ab04d79c76a15fbd1f791794a0ce604f8f97f236180fd76feb2abaa143fa6fa37ff8afe8daffff0dff0c000d4d09c82a030000",
+		"bd39c2b5b3aac900d7788f6b78db99a7": "1f8b08000000000000ff64934f73b23a1487f77c8abb773a82951616777112428c1278e59fe20e50410c584b25c0a7bf43edf42edeac32cff9cd9c2739c9cbb410a1ccfd07133f6436c3109209be289c31cc2c8c214f0b900c41c17c705cd338df8246e6ded19975ba7a1e3e0b297191b0cdedc0c64a2520994c43e2281cae14b488a092e338e63d19c147851b2328427c75cb8c8a3a7b5d3fd21de9ed0aa2672d0fadd82db30ba24a5edb8f6421c66461f4b605c133700bf1225e263b4d66347a240bf38bfbb9b4b789156fb716917a9504ba3851fb4bc9692f9cdaedb2104eb654076ec1c0ad487a161bb885525baae393b15fc669dbe311d65333054112828843ee73499e1d18911f56b2eb3f0eb53964b528b99f481bbe6b2b22b530d92d0bbf599759ed7e28f9c2edb80fd22abe030e91c72eaf7d91d7f1c07d5552f9a3ddfbfff3cb537fb2579efae8c011a768b8d3802f4d2808c5f8672fc90a5406886331b3f56a16ac577f9cb386679a2bc683ada8edc26c8fada78e457473f4376da8fa3e6d6c6a9a772b5705e9df1efce60664b82cfbd6def9dde96eacef81398e95d76c2a250c91c361398df2684982e6723bcd785558c0a74b5af91cc1d920500170dc7ee798dc5e39de00f35aae54a7f7aa3be47b556ffcb22186f6ee5e66f884a528ddc63ec461bfa473d11b1c6ebfc742c031a805701261856db1ce53e3d8e974e541d19aaf4134ab0281f0feecd1d4dbafd33777dc63a33d1b9f92153b195ee77f1c35abd7a6788f4245e07964981278ae2e6646596c1a6df9dab52093e45f657aec2fc4b5fefe01ff0d003ef2fddc1e030000",
+		"bdcc7a3bbbbb34823ac941b7c621d34f": "1f8b08000000000000ff648fc15283301445f77c457fa023681059bec4679ac1a421c58e6507a881aad8562490af779871e75ddd39ab73d6cb2872a156da883d14b8caf0b0c0752005df80459054723a9ff94e92142c72c6febec30de5a1ab9ca090e776afe31dabcbf79fae3e3455fc1578c8a38f9ba8ada6731a66621e21f2acf71718596b4031003390444e45783d9dfa9c0b659bb2f5e8e63ec4e76313a8dbb7ba2ed8f09266ed6bf9946d9bd999113f87841c6d17278490add5feeabb3bdd3d920bd3647a301ad360f15fa3baff1ff53b0037231178f1000000",
+		"c1032e466c703e8b766bfeb891099210": "1f8b08000000000000ff6c934d97a2381486f7fc8ad9d7a9a305658fb598c54d0831c2a54423083bc01208a06d2b06f8f573b07a663393d53dcffb2cf2e6e3755a8471e1ff41d9560a4750906c82af060a415d4929e42f056841a0105b10e4d37a4b9374975c58bc1b4c5877e53ad7b488857b49c4a8e60cb4d0f9c83c03a1e6f0b667a4441a86d8b311b6a4f0430285a4b55f66bc69336bdda511eb1d05fbef2c9776e8975945b891b74e179bcd189bcb9e4b387c0b17c9f8c7908ca24b0e65991dc82dd92d5466cefb950de9b78392997e6978edff8a7a55e63e2ad0a8e00ded6298e66862e3930dff302352e48ac14dd320b6c320e04cafc3fdc82412f6ac4609ba81e9dcd22879e4ede2672c5984247866a447dfd89b4e275832c4d142a5075f6523f3116e4f81f6c8721e36b9622724f327831ea3dfaccbacf5d9c06ad17c71e79ef3bef15aff91499220414e862bdfe1fb07148c53fa7bd66c057301845bea363a1b99f1d638425c98a46f57286df7146cbf7c6dfdfcb8dfd17db3e65767d10497c5e811bedca5d6a3faa4def9a154f103669b97c13deb8751ddc44cb1902da5a9a4241ec2fbb4d5a3ad1999e960baef5561034e27bfda2281d392810240faac7934840e6aa42e080cbd9763eafe7946fecb9c41200e72b771eae3d1da579bd0aea37b5d2e3a754e1b8670f9b796410029cc0b40b6a722b031fe0caaf80bab20917db3add5cc9a29153fb43ed2fcb080a873da5f69b5aabb42144e7d98193fba4aeeec79332e372f5a37dec97ccf0590ab992643b5bcbe176a1e9f34fc654c0fff95f9f67f7fc3df03002377342c2a030000",
+		"c13dc7dd001d222d906fcef4fa7beced": "1f8b08000000000000ff648f416f82301c47ef7c0aef840ca266f358ca9f42583bdad10e7b6328256a9858332c9f7e21d9cddfe997777a2f581603c9d9aa14b94215ac0ad82f30f0684e326400d19892d88de4936e76c800c1f8ff4f90c5249c9a298f11e7c6ea4b3bdcf299b536eae5d97aa1c361ba1ec22a9d8fa57d390c6fdbdf79849f12c65e208611aa5437b93573ba6ef1d0299a352a7a9cb993e9dd1e7caf10e67adb44c7a87bad13ee174aefe9bb6ebb5df2f591e86dcf2d5c313ce4b748ad94975adc4973f24fc65bfc0360c973d4df00c1aa56aaf1000000",
+		"c15921594bdc0a1f753dbd6250e31bd5": "1f8b08000000000000ff648fc14e83401445f77c45f78630ad4aedf23d780ec33853405aa43b4a94028504633bc0d71b1277ded5cd599d632f43e242afa2441c21a595a47c81b6a5040fa02250a8384e037f574f3ba8887bdedf37142067a63002218e2bee40dc7e0de9e7e641aa8ece963e75d75edf1b987ea62af7b641d4d377dd3c5ecae29280f60062acd3f956b77ba7fcc84a757869f60eaeb5ab982fe7ce72a77933cce51b7bcdce5aee98b8f5281deadb0c0f34621a46d7e782fba1d91e5997e4f77034feda3de5d6e26f93f6ff47fd0e00239266d9f1000000",
+		"c18ab86936469d471a46ace12db12e58": "1f8b08000000000000ff6c934d93a23a1486f7fc8abbefea1a047bb4177771f201063d68e8001d760dda716850d49120bffe96f6ecee64957ace9345de53eff3fd101e8af81fca1325024141f13b7c765008ba1e29855d68c00a024648ad969b4d947594055fbee44f73f728de152c8931a7fd57bdde48c9a0261eca8b75a8d42c9332e436cad2912b243c8449ca29c1a5f482cb475ef455fbd269c57324f2312303c6a9175c052f6e8ece5fea8ff7b82e475e20a91e02dda32cfde8806f2fcd2e0c7e57e1d0acdab82f15ff44e23e1c1830df86c3e8688f5fff2ec32eb0ee0d19dc90a576cdc40d19f908ac3b7e33f160ce1d627019e80811317146402b683285095afefd3dc16dc7743e7445fb7a2bdb668f89b6013c660b87db89d2f9d42487685fb6715779718fc9dc2ebe1f33468aacf2b21ba6a4afda6a58b5d954e7135b86e9557bafbf1d0cbe2cb77ab13c1662ac5d0a528b3f7706b262d200cf9a20563b378683eff1aafcf5747949ca1ffe74b8d837c7a5788cbdb181f57e5e3dddf6b2f653306dd5f160427ad834c5afde72dd886eb44dfeb3272ef43139fbd9cff882e1d13acc689625ee06e4e20701c9c0e4e64fd2db48cac7ea28bd8420d3805824c49833313c20b26250033af7e0160912f89c73a801f02e4fd2adb0f20be912c4e23053ef190b2f9e5bf9a13a54bb6c54b3ce3d90c42cfcb51363b4acc22c0ac26984700c293d856f387d2580145c03c8532a244b938d4ae7a239bb6e911c56326e67117eb693dc99cda29989b3d9f6bdbb36a2f4575618d5977a5d02cbc56991f1fa7373fa2826d1599ef7d7a5b75b91d740d9ed695faecbf45fe75e88671eb3ffb7e4bf01002c930a8242030000",
+		"c282aee160b282bc0d97b2920cf6618e": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"c2cfcee5e13ac2123860313b44857e2f": "1f8b08000000000000ff6c934993aa3a1886f7fc8abbefea6a1c8f2ebf4c10edd0060318762d6a5a26470cf0eb6fe9399b5bf764957abea75295bc79df9f0b518f07ff601a2ace3806459ff0dd119ce3b8c7180e43039623305c1e46def4803f2fa89c9e0e5351efddc25c735822632e3f45feb59292408e8642deac83a526b1941eb58b38eaa912887a30882846622987ecf69da48fac9a9cb5a28940f23543ad08a2216b384d3b472793fc7b13e4db9ea602652f01ff08b91d2d6ab19e947b8fdd33af2d3fabe0b155f42090fb72a015c9ce6b7b470f69f37719f6ccba9d20d00912d92fc23b41d037b36eff9bf117739e50f8bac53d2c900962045a41192b110a4b7f5f8f537b263a69cf6935efb655f923426d19bc66be43ed40e9646cc27af1b3ad8273360c1e222c2cb32f61495bf4c8aaacfdace2b14e0676eb458d1eceef229c595f6ae2c45212daa6eed60f72bd9efc5d6685a556fbcb53cafbdcc52035ffb3270ec88c4803b4fe3203976ec26e23f86ed3942c9dc107fe3556abf9e8b62a1fd3d8db378bcff1045177490ebb265525b0f5c539ce167d9734fab4ef44b5094c3d16f1f230db45c1d5c3cbe02ebc9b25469338745720fd0f049280f1aa3f69ec9c8594af7c31be79202386acc0c8982b329421991150b07a3eae1f0a048719450ac8f320818b679c3b87187944184ddedcc140b6d7e0ee076d3119f965e6be4d770b1b6934ef7385d7352ad761d860f5df1fe980093810c4e048c13d6e4ead1c4a6dd164463fbe4a7ffa8b6e8e6ddbbd753391d4a3dabfc83663db7c8639393647a46b678a56741cd7c791ba7345a3d3f23a488b39a3706597dd384fb3a2d3ceb335ef3420ffafd2bf0300881eb19267030000",
+		"c4b35789c0024ae714a999ea1410f0ca": "1f8b08000000000000ff648f416f82301886effc0aef0ba1840df5f8b57cb6c08ad2aa81e3e6b46c254ecd5cd3fefa856437dfd39be7f43cf1348abc6c661b55ee618bb31afb09c6912cb9008320a9e4d45fb996cf4b30c819fbff0e05e5c4bdb99242db9abc0ec705c9725365ce5bac22326eba833e2b71c557c6bcfdb9242da2390d440f0a1a06a06970fd61adbb0b0d8e15632f6f157c90743fdfb6e23de2969d57ebe157f3ec6647e1d3a4cbec3d2dc62f58ce89fb242fab9df112174f77aabe6dc86bb54bd8f1144dfe3136c563d4df0080f0f724f1000000",
+		"cb1e083a66f2640ac1e004be503c2de2": "1f8b08000000000000ff6c934d73b23c1486f7fc8a77ef7454aa5697272140d4a041e46b275889013f0a6a20bffe1d6dbb7a9ad599eb5c8bfb9ec9797b3e441ceafd87891f509b6208c813be198c52bc9018c31e17a0288282fab09cc2ac0eaa2a1e455be6d85a67ebaca30a17095d5c52aae58080a22ad7646930281d186e09120c87216b89061f155e88a00870e989cca94ed9fbfcbe8b486b4bd87eeff2c00a3d911d9163e427fb9e98954ecc69eb04107f0b978038b32ed5e49ec64264316ad2cd5866e6a0752dd87d3b2c20a6278ce5e94f51b922f79804c5240c995574cf397a32fd62dd2f3322896ac61b857962859c3b44cdc3ad260143e4550d23b6e0a6ddeca2f4919fc6d724201143fcb5432df38cad69df2949bb241acb5decc94c931503f51270cbec24f26fbb68d4ba1af63fd1995dda65165577eecc3ae3aff85100012af22f51cad59a53848ae2676608290f03f87b94249b0faf9f1bc7212f3ef0a666fbeb2daedf6bd5a9af69acea7e4aceeac20fac9def27b4bb55e14e244a4bf999567456f6e68fcaba9c3dcf98d4337d99de8ae4f37aba0c066aa361f58ceaf22982c3942060188d40599058a13f0880bb7d04548105f5d333dc0d23730b8e05c696e92fdbf361df98361f60f0d73d399e7e9dc45deeaf833aeccf77d08e56139f0b098bdf5ac69a730b0a8f82856c381218a56b212dee55751db771dc4fcba5a81eb436b7b06ccf6e9d3e8a83b9a475e39798ba17c720e1e416aeba43e356b978340b180ae8e9937d6fd22edc581396f56c338a3be3f9f1df8867fd7b0dff0f008142178a2a030000",
+		"cbafbb4789bfd0c2ac352814daf9cf18": "1f8b08000000000000ff6c93cd6eb33a1086f75cc5d94755535a68bb1c83314e315f00f36376011a1302f96b8989affe886475743eafac671e8d349a779ee68730a1e13f0e8e39f5a8031ccff0c960943a99761cd8da12144520690cc137350fafcdfbee2314b0dc5ba348c6a6568e14f4eb5852dd2d3128aa9a0e0706833d819714a3963959c626ac214632cc1048eeecc3b622fd50bdaec64d8e27af83f451abb99b856db543c4a8076f1466af85f931110ec54338724c3e6fa5a66359b46d55a09f32b1baca5c4ebe86e6e130ee1de21fa336c33618fe2a2bbfad43d681621dbc3057dee67f3e337d67b7991977d82193453fca89849b4511c16a95a51a7386f07d3c07b1afc8f47e367979ad07eb2438ce198aee35034d2c4c4d6fa4b8bc89dcea3645d8551a970cd577c1695954bdae0e6c67f5dfc4fbadc9d4074378ad38de32b424c62cc1c4f2864c5a9878fcbb8c4a861841b73349d8db27484c1ce7f13740611f9614109c8fc3d9345f26972762fa1c17851dc50dbfe22a48f97293fadb57eb97e2d324dbaa16bd13adbad07f6f0dd3fa135f86f2b0309be7e6d2ea8bace5e0876a31261ddae55dc5d185c1db3c4ee32a8c9e553467009dbac7360c3f49f163bff28224f65054bb3007460984a2d4078595ab849bc54b0e91ff8c20553037d2707a3488de309591ccfa35cb0e1a6827f360bf68abb3ff369c7780ca7825ece25c1c34d94cbbf5d192ff49a4018abab102150395e4fae2b6c9987eacc3fa78f225a37925ec844deb3fd9e97db23409ecc37bec7f591f0e45bfebef53901a186d175b91d9bbe755b1d91c7b7e6d826b9ffcee8f3cb6e371243ab38df96a9e70e8feff94fe1d0025e62de567030000",
+		"cc1a1438e2989496c6e36886a0eb5d19": "1f8b08000000000000ff6453cb92aa4810ddf315b3373a446c6feb6216590fca520b1a28d07227b417047c742b26f2f513e8c4cce2d6aae264469e47c479eb1fe1427a7f511e6ae94a0a9af7e09ba5a4a49f25a5b08f724049209721785d7eb95de6939f65aae15b9f9819845f8834377279decaaeb439a0c49de62b4b412560147352289a24aae51d8424f71202b9a69557a4a23ea6e345b35bf3d62d217ecd32cd12af480f4458d9d16d8c5377c699b62e83e8b570d6d449decd7a84a9881be3cc6e2accd00d0c4b8280719c94269ad47be1deac4cb4f5eae8dd530d7b17ed8762f0502c469fc9876264e7a2ddbd30f91fa644d5d20e163d9945c068a813ad4285fcc520395e9859b797ed71f6488f75a142832e3c67738e236dd6ef79785a14e9d1bb5899e3dd551820cf9f0b4b8eb7a7b4ff95f18d22f1332768956f1cde6c3745916ec8751b4d4a2b756c5c6bd024cfbe8baaf43f0349489efffb5784a0470122d2a1c9fc6873211d52561bf5b3802f7b947c583a98a7a2a227d72fee9118ff54f5fc311a6ec65533627509b30f1b0ff6c48df387e2d34143c273d5fd5a86f190ee7f9f1d1bad5d077e1fc63c9812f83de50414859dc1799f4768d7843cfdcb251a4282780e2805a5570141ec1254c45230edfd7d71e4648881ab40f58770f93ae0132ab9f4afaadc7f94f76db6b127a7b038f1e9e8c33b0ce89e5a5817dec9dd26ba7d17c3ba9d2a380b4abf45a4de674f35760e8ac75406cc46db548381d746d793f96952d6b59f60c1e8523e1ee81d58328b0e53ee36136780320fc6f7a6f85cf9e75dc2ebd4a67b2d32757156abddc2bf21fce2882b671c07d6b0327f5b7d2bdeb8c7feacca3f0300779d601347030000",
+		"cdd4685b9dbe8773cdf46086659a1862": "1f8b08000000000000ff6c934993aa3a1886f7fc8abbefea6a1c8f2ebf4c10edd0060318762d6a5a26470cf0eb6fe9399b5bf764957abea75295bc79df9f0b518f07ff601a2ace3806459ff0dd119ce3b8c7180e43039623305c1e46def4803f2fa89c9e0e5351efddc25c735822632e3f45feb59292408e8642deac83a526b1941eb58b38eaa912887a30882846622987ecf69da48fac9a9cb5a28940f23543ad08a2216b384d3b472793fc7b13e4db9ea602652f01ff08b91d2d6ab19e947b8fdd33af2d3fabe0b155f42090fb72a015c9ce6b7b470f69f37719f6ccba9d20d00912d92fc23b41d037b36eff9bf117739e50f8bac53d2c900962045a41192b110a4b7f5f8f537b263a69cf6935efb655f923426d19bc66be43ed40e9646cc27af1b3ad8273360c1e222c2cb32f61495bf4c8aaacfdace2b14e0676eb458d1eceef229c595f6ae2c45212daa6eed60f72bd9efc5d6685a556fbcb53cafbdcc52035ffb3270ec88c4803b4fe3203976ec26e23f86ed3942c9dc107fe3556abf9e8b62a1fd3d8db378bcff1045177490ebb265525b0f5c539ce167d9734fab4ef44b5094c3d16f1f230db45c1d5c3cbe02ebc9b25469338745720fd0f049280f1aa3f69ec9c8594af7c31be79202386acc0c8982b329421991150b07a3eae1f0a048719450ac8f320818b679c3b87187944184ddedcc140b6d7e0ee076d3119f965e6be4d770b1b6934ef7385d7352ad761d860f5df1fe980093810c4e048c13d6e4ead1c4a6dd164463fbe4a7ffa8b6e8e6ddbbd753391d4a3dabfc83663db7c8639393647a46b678a56741cd7c791ba7345a3d3f23a488b39a3706597dd384fb3a2d3ceb335ef3420ffafd2bf0300881eb19267030000",
+		"ce2f505c9ae3c68a9bb3965a49fbcc29": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"cfaeb7ee696771ddbf2fd614f067cbe1": "1f8b08000000000000ffbccf3d0ec2300c05e03da77817a0ecdd50c5c042587a00933c50a4fc54ae9110a747e9c405ca68cb7e9fdeb545fa791d1d70ae72cf1c61faa203a69c58cdcf97c86ae991a8fd0898a87d0c621c1124506d3d0619f896b2640ea195435f0e0bcbf6e0f529357dc452ab92e79aec271261631c702375776d21d501a75852dd1d93ae38c06ba4fea15cd348a5baef001e34df7fd4010000",
+		"d2dcdb18b24254b96db003cd8d546c84": "1f8b08000000000000ff648fb16e83301806779e227b846ada1060b4cd1fc70d060c2115d940720d755b825440f8e92ba46efda64f37ddb9db08309eeef282dff0157617a837e83a82b333d68005118cac232bc521c21a18a57f7f8133616869164eb094fa2e68b4920abd36c5fdfb2a95d3c561629fed5bde9e8cbe3c6adff7fa36dcab15a9aec029c5b8b0ef839c59ac2acf7c6671e90fc6a0e3ed491fd43407ce1494eb98b7947ef403e851b50d6d80f766cc6cf748aaaf09312e6b3f0d4ec74c7afb171dd649f41385cee6ef421aff8ffa1d004d8e3f1ff1000000",
+		"d3f67b8801de56f6eaa21e0fa561ccc2": "1f8b08000000000000ffbccf31cac3300c05e0dda7d0057effd0315b091dba345d7200d57e0d025b0e8a0aa5a72fced41364147ad2c7bbb58c69de864074517e140ce4f642201a8b407d9aaf19eaf214580f118db03e26760c9438c17cfb4f1c9b2da78837d7b520a656fffa26aea8fbd5640bab7cd8a5299759c57ffe52daad407407ec1872052c109d73153d46e44e859ecab0a36a36cb3058f80e00703512f3e8010000",
+		"d681096c5e53f26a3ff73727d7f805ee": "1f8b08000000000000ffbccf3d0ec2300c05e03da77817a0ecdd50c5c042587a00933c50a4fc54ae9110a747e9c405ca68cb7e9fdeb545fa791d1d70ae72cf1c61faa203a69c58cdcf97c86ae991a8fd0898a87d0c621c1124506d3d0619f896b2640ea195435f0e0bcbf6e0f529357dc452ab92e79aec271261631c702375776d21d501a75852dd1d93ae38c06ba4fea15cd348a5baef001e34df7fd4010000",
+		"da872c542e7a4bae7d71a79cf9fced13": "1f8b08000000000000ff6c934d73ba3a18c5f77c8abbef748ab4b475f9e40d83060d0668dc29da2088c55209f0e9efe8ffce5ddcdbac32e7f9cd992427e7f1b6100d78f417a6b1e28c6350f4263e3a82739c288ce1f360c0720486cbb85c62a3d3f8388f1630179b37337b3d2a9823632e45552e57521228d128646b1d2c3549a50ca80dd364a44a201ac024a11889b9f458bbcd365d5efb8d56341348de67a81751e2b12ba79bc1d1995f6e3fa27237d28d40f91dc08590bbe7f02cd6fee910b09f3ce84f8b3aea768ae6027ddd19e885d21ebd3abf837060d61d04814190c42e091f04415b66ddf18fc6ffd51c31133d1e2144264a116805a754895858fae76a9cda86e8ac6f36f574d8d5a742c4da32b8cf66d44e94a3b317139fc36257474dee459d882bcbec1d98d31e75799df78b3a7dd1d9c4ee82e4aabde98f88ad0dcc9d210eeda352affddf4156596af56cfeb5e163e962909affb3272073220d500774f5fef6d590ee994dce84adf8d8d9bd3a94110cad7f6ddbe9a57dc65394d6e3badbe34563d75d1ffacca448c86450be73c80a33e4cbc93eacb2c590e7303dd4d93cfa11416bc9ed98b1bb02397b42200998a016c8bda5b00fa5bcc7ea60dc06201386acc0c8986f6428433227a060757bd8592c107cbe53a480dc8c04aeee06c4c823c298385ebce8cf9ffbd663d2c510af1e4afffd5217d772dfb8dfe953b885fe65f91acba2fccf4f341107829803470adfab95370b1f3e9bd07f42af5503cfeed8ea966dfbcb05fb43b09e147994ac6d5019cca15bb2ee0d271fea7bef5ece0efb1187233eed72b6635e5b051f1fb57d785a149d5a4f9d5b5b1e6944fe5fa1bf070017359eef5f030000",
+		"dffc6c0873a87c3dcd0d63c98734548d": "1f8b08000000000000ffbccf31cac3300c05e0dda7d0057effd0315b091dba345d7200d57e0d025b0e8a0aa5a72fced41364147ad2c7bbb58c69de864074517e140ce4f642201a8b407d9aaf19eaf214580f118db03e26760c9438c17cfb4f1c9b2da78837d7b520a656fffa26aea8fbd5640bab7cd8a5299759c57ffe52daad407407ec1872052c109d73153d46e44e859ecab0a36a36cb3058f80e00703512f3e8010000",
+		"e12d8af5f1b3629e0914ce134a080e00": "1f8b08000000000000ff648f414f83301886effc8add1762875b5c8f5f6bfda8ac15ca7470c40ccad870198db2f2eb0d8937dfd39be7f43ce13c2650ea456ae407ecc52211e50cc340498cc10a504c21f337ccd59a8215c8f9df1f45cc908cd528196499e5118debe9f1935ed2d372eb57016fcd912a5df2d29dfcdb44fc4b8d3fe410b1a86f0d680e90dfb2e669ed6a62fb4b736cf2834a5fb705312dd114fae04b25f17d5a418f45e2cfbb7736c8a176e7caa86ed33d5ccbca0987f966f7edaecb7b2796ae35745f0c3698fd43a19fff47fd0e006cee10fef1000000",
+		"e12f9107c5c85699e92124941a94103c": "1f8b08000000000000ffbccf3d0ec2300c05e03da77817a0ecdd50c5c042587a00933c50a4fc54ae9110a747e9c405ca68cb7e9fdeb545fa791d1d70ae72cf1c61faa203a69c58cdcf97c86ae991a8fd0898a87d0c621c1124506d3d0619f896b2640ea195435f0e0bcbf6e0f529357dc452ab92e79aec271261631c702375776d21d501a75852dd1d93ae38c06ba4fea15cd348a5baef001e34df7fd4010000",
+		"e21d069e9bff540f57e5cce1d10497a2": "1f8b08000000000000ff648f417282301846f79cc20b30a60202cb04fe8654039aa4b12c511b40da38542ad4d37798e9aedfea9bb77acf9d4780b27cb1134c63058b0d9433741dce68866bc09c704a7e7a2ab91fe31a6892fcfd113242d1588d8ce0fdbe461c67539cc683bc085334c6416c3abc5e23f97665d1d73d6ccfea7667a82f4ee7a211384f3056db5a835805d6f8e6712b2f46cab5b6c373f0598543e430f55275a958b5db8fa5569ef5dade07b44ca7a7efd01edebbf5b1dc1c9196c8e60f4f75bb89375e149d0267f677214fff47fd0e00b868e0b9f1000000",
+		"e5bf45a9524c0595345d6387ce9482ac": "1f8b08000000000000ff648fcb6e82401846f73c857b43680a4975f9cfc5818eff54468a951d83dc4a1bb1a103f8f40d49777eab2f67758ebb8c7011a9d5414729247c25f97981ae839108a1e6800405996fe288c1166a2e28fdff230f89781af3312210c7f59bf1e43ac8d4fe8b051fdd840e63f93195fdc41ad356c27b1977a743369b4bd97e371a140548a0af9af2ec75adbecffe36b6439215cffab257d684c66103063b49d7e5505c37c5a99fb4adf2da57af1acb9f4fc9de1bbcfea2216967fc9ba579678b4d70ef6767f177b9628f517f0300d6be6a2cf1000000",
+		"e70228a46358aee3fdd3357e73730738": "1f8b08000000000000ff6c934993aa3a1886f7fc8abbefea6a1c8f2ebf4c10edd0060318762d6a5a26470cf0eb6fe9399b5bf764957abea75295bc79df9f0b518f07ff601a2ace3806459ff0dd119ce3b8c7180e43039623305c1e46def4803f2fa89c9e0e5351efddc25c735822632e3f45feb59292408e8642deac83a526b1941eb58b38eaa912887a30882846622987ecf69da48fac9a9cb5a28940f23543ad08a2216b384d3b472793fc7b13e4db9ea602652f01ff08b91d2d6ab19e947b8fdd33af2d3fabe0b155f42090fb72a015c9ce6b7b470f69f37719f6ccba9d20d00912d92fc23b41d037b36eff9bf117739e50f8bac53d2c900962045a41192b110a4b7f5f8f537b263a69cf6935efb655f923426d19bc66be43ed40e9646cc27af1b3ad8273360c1e222c2cb32f61495bf4c8aaacfdace2b14e0676eb458d1eceef229c595f6ae2c45212daa6eed60f72bd9efc5d6685a556fbcb53cafbdcc52035ffb3270ec88c4803b4fe3203976ec26e23f86ed3942c9dc107fe3556abf9e8b62a1fd3d8db378bcff1045177490ebb265525b0f5c539ce167d9734fab4ef44b5094c3d16f1f230db45c1d5c3cbe02ebc9b25469338745720fd0f049280f1aa3f69ec9c8594af7c31be79202386acc0c8982b329421991150b07a3eae1f0a048719450ac8f320818b679c3b87187944184ddedcc140b6d7e0ee076d3119f965e6be4d770b1b6934ef7385d7352ad761d860f5df1fe980093810c4e048c13d6e4ead1c4a6dd164463fbe4a7ffa8b6e8e6ddbbd753391d4a3dabfc83663db7c8639393647a46b678a56741cd7c791ba7345a3d3f23a488b39a3706597dd384fb3a2d3ceb335ef3420ffafd2bf0300881eb19267030000",
+		"e716a9c4f2bfc7769a31ca0da6663941": "1f8b08000000000000ff64934193aa381485f7fc8ad9777535d268e9e22dee0d010386361ac0b003b443477ddad2be28bf7e0a7b666a5ebdec726e52e74bee3dcfc3421ab1f42f425792858c80a483f8ec70c648d113023ad16019826642727751acf3dc4845a34996c41f6df6cb40825a7fb67bf3b6142200831d179d758850412e44446d9c673d951c6904a38c12e489f0c2ae2aca5fcd717c5692161cc5a386379e665e7865b4bc3baa189b6a939abaa71947f638405abe28376d5b6fb02bd763537bee2d32a050a739029774b3ea1a2f6d17c7dc775431b275945d9537fbe2e86f02c92c0f984d25f8dc30cbc3d32690fca171f39f660b49171cf691333862cb499ef31bed61f5eda225d9a76d1d1d8ef56b7cad0a7a0b0d64dfb5460679dad61f1835c7f0ea28efd02b6f7a9b4ba8ff450ca3d9bd8cf27bb961ee80f91b65b8b7d4aa79722a596f5ce28050ec9f4d00a20984068ab93f593f196a2fc10eb676bc4c66af37ff747db926fa646cf5733acb6f6eba6de2fdd19964ef2f07944fef879d959760b97c5aadc27a7249eea5a0a22b27cb335db7d6ec276f780c081e24bc0da4733145789f52078113acc0ce8776aedc03a2b2212896588528b239581a11d24520b2102d271cecd0a92db5145fac200e07b0897d5c3648846527d0c9d29b7845c982a6ea2e5bbddc2dfcb0e946bb76b79d5631de71749eccdf327b3c4fad937c3baf9030cb18cbead7785f6ee24363c4f5f759f008f9dfe7723845847c46ce9afbb3e119e036c0a9200cc9424dc5427dc54f8b4f99fbd3adcaabf1dcb8bad1af3be307bb97d1cc3599f47ec207a0a3ae3a5c6d13ddf7a68fd77170531df3049ef52866cde872a9a266f6d5b821d13f7e38437a9e691afc19a9bf07001c30db8a6f030000",
+		"e7871de4bc0a1852b0d29e2db7d71340": "1f8b08000000000000ff6c93cd9282381485f73cc5ecad2e81965697373f40d0a001146167d0e6a7416d15033cfd943a35335533599efba59273eadc8fe741d461fe1f980611b31986883ec50f8d33861715c670c03928862067220997d3c668b2c1e61d48c3e8533c991158a03cff2d7eaad55a0802151ab8b8290d8b846c8570a8f2b69b81461c51078c0dc5882f8469dff671fac81aeb924434e648bc66a8e3fec6b45b46d35e4b62abdaeffc4a0e34e5287b01b8e0427e7a275e5af5d1b1ef99d3d5cbc67fc888661c9d5f0c743c4a4cda6aff0fc2d1567acf09f49c6cd48ab09e13b4b7953ebc35f6b7a671477578000fe5fe16411241bd8d78c0157d5b63545d48127797b499f7b2a90b1e24ca86d7cca5ca88b4249ee4c1c92b64e35f32d37f703153e47d7949555a49a7aee54974ee0087f7239cdbf5764877ac13cebcd7d281b5e9ae28e40eddd2d0aaa4a9ab388208e5d95f8933f44ffa1c21e56380107be9bcd81e4e534d91d24d6ee3604dcdcd5789d6de94dc77f1f871e94ce6d1c068cacf849f96fe4e36ad55b2efd5fccebc7bd17db5a3c9d1ef3ded7b8d8b334bbccd281ccebaaec20156cfafba6286e07b4611708c26a0c8d377a04720dc3102a680c0f5c9696ec8a947a0cc31b1c3f1292e5b6b40bff387a2d8d6f5aa4bddddc16095212cfb9ab2a33c5be625f857a9b457ab729f0141369414ca45d50d0d736f8b31960112c97deaad630b4fda6be7ca3eedbe16a5d74a276f6698b93f95e6d64ab7a632d7c9d2bb2d26d9848703db17c79157cb11b9d2d95df6f8ae6ced59fc0fea93ff6ec39f030007730dc42a030000",
+		"eb009c5e347efe1e1c27e35a61806d1a": "1f8b08000000000000ff6493c972ab381486f73c45ef53a9e0a94d16bd381a00f92208b2c057de19ec0803f2100f023f7d17f1adf4a2b5527de7af3a9f74a4d761211ab0f82f4c85643ec320e9005f1dce1866046328371a2c43a09980984b788c9aaf58c6d3595399af8a040765b156ecd771cd1eb54bc132bb9134723834018c328a2a8ef39c77f40102e93847a0256ee2aa085a534c16b7cd8a767e0dd9b3564a92c755b14781531affa6c6ed438dbdce27b07c068e128ff3a95a8d6c116437357ebf72515a3f55244f5342edac56cb59bb0bfcab53065d1b99f85e48d8f9d6ed39819e93cc2684f59ca08d6fddc793b11fc6834b871fb0189a3908948436975c704b9f1d18b527a256dd696ddefbc2b41517cafaf05d0ba91d49b59a6a71585485894f4e398eef5c8025fa3b1051bbbd9746b4a5c97b2e5c1bd83fda9df88ff74ffdc1de79eaa335473c40fd3958f2e93b681a60fc676f69082e0394cc170b7ee59e19bb42d35bf848ea8c3591333744d96cd5cdeadea3bb4693c5329dccb7c8d856decfabbf4d529d71d8bb29c5a1a9bce57d72bab9ea703dbfc9102741ed4889220ed361945b62297ab3e930e35013e0c325858223f8f428d4001c5fbe73cca60dc7bf802517eed4bb797d5f97bfddd9415407ea8de6f1fe05efb06dabf8e0af73d94d83b7b6f3381c7f8e8580637035709a6187a5f8235c5e4bd2f2225f30127f2626746f2f167fbd1d45b24fcbf1e5f755a869a4e69966ba207b89e5169b95fa5c43e6399b9b1e5dc9c79c9e22fde235a7ed2441d22c3f70c4ff7186c7fe4a63f2ff1ff0ef007c25d36a1e030000",
+		"eb0b8421f12afd1efbe50beada443568": "1f8b08000000000000ff6453cb72ab3814dcf315b3bf95b20338b1177771f400cbb64830423c7680636130103f88305f3fe5782a333557cb6e9d3aa7bbab9fee0f5197797f61ba15cc611804bd834f06670c470463d8ff52a01902c5fc74ecccaeb80e7961f75113e627f4b61a2a5823a54e655dbdbdfb3e810a5db87fd106f613227ddfa57a25c3910a8ea80bcf21c588af7dd3b96451fa5534b3cf44d08823ff9b4303f742d3e9194d6f4612cdaa2cf6aa7ca42147ecfb032ef9268dcb328fd1250d66556e4e07b78204294f22e082c6db4b617ae5a691b69144cf3a77c33e3117578eec9808a63961da1360f38a69ee743111fc1be3d50fa62342371c6ad7b86f4425c752f2818eb07d6c5102d75e99bbc726b7567d16d1c1a9207c708520d22bf303728bc6e98dc43c8e89391f9604b29f13cdc52d75e52d8d59ff3f293a1220902afe319321e35f673942dac30062a324dd9ab3766fefc74b52ed83e045b65767d664afd73913abac265bf3b0394ea4b05ac33a9c6c3a9d90e1b97f6da38ffa254fd6f95406d3d61b2d51bf0fbcb4e6f362d6d96ec4992b38d877a377445334d13e354033a723b0bb9fbf0c42fad0aace485107f905013f616b9d20e4874bd04c134888dc4e05f8cb0902a60d20707e0c73ba22705018ba497e4ed35a79c3e9763561617f29791be58b33b386ae5eb265d476c54ce0ac1da135be87b79432021faaf4be8a667b2c1a79bb67fcdf8895dafd701c3a17e3931b707b6120e018a60570ea6346e37553beeece091ecafef84eca8555bd5cf1fa1785f3e639599c876c9fe97cb59bcce1006a23de43e38c3ea7cb460d51f53682fc0cca418e4553500be69e0a704090f546d5efdfc6bd394fd4237fd6e9ef010013e78bde6b030000",
+		"ebccbbc4d8ef72f0fdb9cf554e7f855b": "1f8b08000000000000ff6c93cb72ab381086f73cc5eca74e85609c932cbb7543380243b87a67b00103be1f2cccd34fd99edd8c565d5f7faa92d4fa7f3d163221bdbf080b23c92581883de02f434949969410d82e6ad012a196217c071fcb46635171b159a42ab40ffe755d6a52e772715cc9a9351968a9372dfb36147402de63868d2249a246364188b59720d411e9bca610fdbe98b9c33a65236f217ef5ca88265e53ec5018e59e0fb9d54fb9f5398a08b297708c98f8baaf2639acb2a62932bcae7ee66d6199a333c1e6e5a8881fc2ab515a5ef3bdff5f593b4de9a916b46ae15dd1fafea8d3079b9eecfe60c613b658a8e0aa4990d3240804d36e124f2c52c89ed723a81681c5afeb74752bf7f3531eb15461f0ec19382a2fb6f820d9ea9ea7f3769d796d31b14aa1f91440eb34c9bcbe6c391433f7a076f37e2bf89f528cfdf7debb1945842b854ae0fd2c7e94fd05351384fc5b6be6802901f1cfcd6caeddfd18b930f8300f8f9918c2c5072231dc64cb669eb5d9d83e6a3fd1dd7c2f6ecee134b87c9edfe2e5ddcf86ebc93a9ccfb6e36af7f81b75c9b5a3fd8ddf1614cf8602fb71d40dd50cdf74f0982f3fd2d74b3b3f317b8daebe60cd3806258520970b9d2306b1f3f80b06859c26a11941e0bc21480d142eafcd8ab9147635c134fe1cd34ac1cec77c883ef870ac76ebb304deb3be354edbd3b652ab8b907fb77b0a0baceb73d3b5fe320828d49e048a1c760cec91bddfaeb33e61365b6ff5ba3af4e3e7f4bb33b26156759f377b54872a3e875f17d324d28923b5a4efb0cd9a909a6f07277099d74db6df92b7daaa801e4d71af1dd99d4be39908e6d1ffa6e49f010033c50ead42030000",
+		"ecafd99e87aac7c5d03bfa91deee70cd": "1f8b08000000000000ff6c93cd6eb33a1086f75cc5d94755535a68bb1c83314e315f00f36376011a1302f96b8989affe886475743eafac671e8d349a779ee68730a1e13f0e8e39f5a8031ccff0c960943a99761cd8da12144520690cc137350fafcdfbee2314b0dc5ba348c6a6568e14f4eb5852dd2d3128aa9a0e0706833d819714a3963959c626ac214632cc1048eeecc3b622fd50bdaec64d8e27af83f451abb99b856db543c4a8076f1466af85f931110ec54338724c3e6fa5a66359b46d55a09f32b1baca5c4ebe86e6e130ee1de21fa336c33618fe2a2bbfad43d681621dbc3057dee67f3e337d67b7991977d82193453fca89849b4511c16a95a51a7386f07d3c07b1afc8f47e367979ad07eb2438ce198aee35034d2c4c4d6fa4b8bc89dcea3645d8551a970cd577c1695954bdae0e6c67f5dfc4fbadc9d4074378ad38de32b424c62cc1c4f2864c5a9878fcbb8c4a861841b73349d8db27484c1ce7f13740611f9614109c8fc3d9345f26972762fa1c17851dc50dbfe22a48f97293fadb57eb97e2d324dbaa16bd13adbad07f6f0dd3fa135f86f2b0309be7e6d2ea8bace5e0876a31261ddae55dc5d185c1db3c4ee32a8c9e553467009dbac7360c3f49f163bff28224f65054bb3007460984a2d4078595ab849bc54b0e91ff8c20553037d2707a3488de309591ccfa35cb0e1a6827f360bf68abb3ff369c7780ca7825ece25c1c34d94cbbf5d192ff49a4018abab102150395e4fae2b6c9987eacc3fa78f225a37925ec844deb3fd9e97db23409ecc37bec7f591f0e45bfebef53901a186d175b91d9bbe755b1d91c7b7e6d826b9ffcee8f3cb6e371243ab38df96a9e70e8feff94fe1d0025e62de567030000",
+		"ed53994d28134ecdf098f8594707018e": "1f8b08000000000000ffec7b5b8c1cd799deb95455574f756b8a5a5aa635b479aa4449cdb5485675d7a5bb4d333cb44999a0b55c78b5de605f9ad5ddd59ca999e99ee9e919922b9933b2236c0424011f94400f7a7082c5ae8104888138801e16c104508245369b20c002f1c33e18881ff629d8204890a730f84e55f5f4f026267180200809b26ee79cff3ffffffdb7734e936467931242e8f7eab7d9fe3eb9cdf7f7f7e93eeee9fe6d426e533ce125b9adede7dfe83e7d709b962fc86d1d17747da03e1b78c21dbffc4dae11ca74a352314d53d3186194988430dd30cd2a2104af74aa134ab8a9139d305ad1b425bcb66a06d574ba454f9cd03925278caff07d2a0f0e0e89b54fe4671f2d5c2bdf358ccd747332bdcfc852af374c66492f1d0f39b57abdd534d9eaf5939d943333d9d8980c92594ae8d2309d3fb0dace2c99ce7a7be9746d749ff0ea2b94486ad9277fedc44b76d5b44e7f75a972b1fdebfed2efffee3775629f23568bd27d76855d1144ee7fb74e705327922e5b82486a6b2ebd56a7822e5b3621f9ab06b15e46337aad4e041154525bb7ac554af75f2352acbbfc2c115c9eca04b15fc6c0ec8ae00d761aadb9b43341ed97f1e224c6e5d2cc04cb5fd4aed599e05264671579b92f89fd1679e2ba4ce93ec8daaf384cb065cb524c08667f79d9fab5e29b6082dba71c4d68cb569d5cfd677ffb1ffea71ffdcdbfff871fefe3e9f73ffaec5ffddd7ff9c1bffbc37d8b11ebb49abe200da6a64a70379fd41b4f7c9466d660c42578a04a1cba65bd5a4aed270787447e5dfee5c121b1cf435ccffbf4a78c3288cc5e77d9d99cae43202efc15541ed01b7522982411abe1d1be51a7f98703ed16be082affdab63ca0d32e79493d197bf31775f5a2b62797b7e52fd0a226b9436bdc3ad66d593dbdbc27ff4a35797c9097ca41ca1775a939b4c62c4cb7c14c97e3a2bd0d1c486a2fe3c974b8852b113c13b44ba01dbc96348b985963c719a89724cf6ccb43bca849e6500b1ac5702515d557286a24134cd680a24baf5a82491b7021d6dfa094ed17a2034f2e2bfaae0b0a61610c970a7ea3ce049374e672410517f4e68a4b25f94e9da1af60d02a50efb01a2da6c12415d4361c700ec8124123a6092258c488b56ca90e0d62c1960673238046150b048d993433057d6967e7188939863533809f4a73fe4a5075670a26b54c7907d5ccf61c2a98320c41ad8b0ae30a84822d0a891d131215ccbef4aa24d6b728578211f43c0110a99a9ba40ee7572083ccd10497347399c385a6da58500003e36ab20476c288b5525897240df6f30fafb8900c5b39410821d61b845d917ff9e121b18512b8ba175410fbcd652b7f20b66359a4bc7fc3da52602f3bb15bf5279ed5bdb2e53773a6d533b71da58c3a95643eb6e0f6d965604ba91af3bab9625f72d89c9c60560537af126bcaf93e7da034e5adbb14b667b7ecbac304b19b76cde14a2982d9a1a0e7b8e96882d991a32b91c6113b2da8d023764a50a1c5dc1614167a1624232604952293ff0daa237653506967f60ab1fe01a37a414f837b7374e5f11c0317d3a9e0a239262ec4e1a51380a07ffad11549afd78924573f383838b063fed38fae4016045f7f86af99ab47ec671f5d11ba6437ebd4121ab0c685292ac2b003a101aa0da1c94f0e0e15ab9aa41113e8ff171f5e81d6558f88bd25b88a01129e9a662e8fd85f7c58f0f14b28fc1af868b05fe4bd2024226883d92708c120e7b8197321b8d0a4c8046bb0da0942e6642c35ec7ea60615baa4efd4a9a5828aa0f6d788f57921a343a21c225c1d0c992b9bd432a805d2813511489a5da5313f2560cfa7324c8bc986b2326dd1ca1acacab4452bd3d4c78660f2ecdcca1a99edbd5a1aa5ab81791131b466e7f8a9989b253bbaf2fc451830f0a00b43450bc105830418a6099a0d463044ae53e527605254d6d0d7c19d99b914f7baa05749cc604335a921e8d689ba6b100b3083612bd06939cce06fffd60787e4c844059387243b4bacff48a9b620c5427405f775f27f9f0c692e43fa3c195ac7e6cb17e7fb3aa50b4194c15fd5e0062032b0629f7995104b838fd28834ad7f7b8a99fbf4c13ccafe174459491cc4a13f7bf4dead3a6eaccca5f2deb6a3228ee66ad7e06b1c5d7530f7542a20cfc086e5a3478f2a37115fa421e81d97aecb33db7b926e43dbb3757939737485fb758795d109663acbe443369fbe24700492c8afc02cf63086d0e599eb2b33a7228963f22b85e4e4bd6db78a20464455f075b7aadca4a8827bc714d572268e89484fe54945a22a0c51155454e457f7a4b60d1bc16bfaf60a3eac3886a8483a732a82d60942bb42b5a102bd30ebcc020b9281a3ed19a2a5a0eb53a16d83758e48adde6caf3e362b6ef123a60dc16f09a360f9c6b653c92764bb062099f3e918c0ba292a2b988a60b861c270a830eac412e6b57a99783ce4792807b4a8e0ebb7ea0c5ec4b45dc8184901be99824b3e05644cc133172194aa472a58a6a2b76a7bc0d018d91cb35fc394812dbee732c967c07dee8e4196b806229e1203db869094f34664124418824d014c8ee0c48143b384a3b2595c1852206a097c014b73fe5472a234ac58b48429cd6cd92a2103daa644a8b132790fe0b26eacb83c67f3ccb66bec61ac6d7084c60ff93b752e689dce33d767e9c900c97bdb82af2b515714d20c288602030580e8b5ba6695ba84120dc130df1bae011551c49915e0c7316a79f240e5fe0eac8065e07296b995127490a832058c67bac09e99b995eb20272a9056451885988830e503c1ef6e2b17806a81cfdc52a954f0e342ab71ab30302a8c9964c298a375bbb8d95e75d971765e900756f200f60d38e1452e2a40cccc65100d5f0419cf415640b6ac97f89e6b96e05239e227dca1478aae4a2a4c0532731b5d184056f05215a60219734c243b0a64660932965fcc8899d04125629f40ef3c620fb93050d089ca7113a9cce751c13cf8dc44f882893c877be38bb9379ec2bd01ee8dc7b8378e73cf0bee19b8c7e42bc76da6f2a4cd54166ce6c8721e7228066e9234d8c7ca73dcac73e527b9fcc7075ae61ad2debb425c2af7afd70da46c33d7bc5637f2ccfac75c18f2e0e0fd6d57cf5c1ab11f43a4a4c1fe80bbc081811222627fa022c5a7dc355489207fc233577368ee01895bc14573ab9930afc332813a5a2735ddb28e495021c890cb88280469254480eb4324b5ac983afc978b12c1e1c85300ffa25e016a1f8241651c39002ac25884a401f840eb305c3506bc15bca102f77261430df6c785cea93091a8c11022f6c728eee4a333113b041933623f812831e59ff10c9c82f17fc433242b842b635402ce8481a4a08206863c99c1d4499101c903f6363cbc1aef538cabcbbfae84fd3192585ab06f0a9ac906744fe4c1c1018dd8675c85b906abd511388c77eaca15ddc48285a80a3d538d0bcd50b90cdfe91af25e5690a1c258c7d78fb9d033b70248bb66c43e86cadfcf84a9084398952709ab4081e0e332d5634e06923714016321e2ccfd8592710e214818f313e68d1588569842cf9c8ad0723414e01195eb757a0419661533065fa612229eb562b6c7839e037998eb70ef455101ba9f728ca958f844dd6259a1028032c9b7257d3bafc404cbc55506c88aedd6282270a5c16a6e5554c0928e81745185f156a1f4c2d4112ee13725df2b5ca7815253c131732a306750940fb64bf9cc2535779b7c4e9a0a6ebf065d2be8fe1f8ccdb9560b78e4c8805f541a9e33998f490b07a5dc63d1ea217fda54ca689e2bb64ce6e0150c187aee1c10458c9b486410171d5a04d2ff698b401495b78174794f394801ebbbb1e256e50a048cc4dd5912d53cc9cfab228674722966e6d3cdb8347441e44f7926aad2ccd0a02a4f67b98b93ca982bca9fb8f4266cb92a0c20a32aaa475a46166aacbb25f755081a0186cef56c086abf56881781a652ea7921c09812992cf45c51e15189ab52846a51c92d1608a3f086aa06868255b8c1a59287c73216bc5327ff3b86ccac7f8ab2152a3c354ff1619f5860c2c218952755e27632735108bada8a4a3c74555128378209b87a0e433e37212c4240452b8ec6af08cd31d815a1bb5c9ecc5c1445ae5e2ca762f5a6c16c47b7840ec11b4a7b305376adb06355f49d2ed33e57875381910b4594088e90058968f2816a0a8bd5b09a226d791221c616f4fa4a56b4be8668a042c6a9328b5745b1e2ddce073d75547de4dfe0f215968acfd6bf6054db2fdc8cb9e77255ba6858d8388305977961c5a421f81d579b17565a5958212545d56ce715f2694184564ef2680da55c0fd425159acaaa8cd23d106844a5b4ec6dac453b1ad4017766144910000a9d47ec14bc0f53259a5696685a5ea221cc214ccf8b2b2561148d0ccba2338708cde1423ba205d3d6a075e5456a42c3c54421552c2b010a54d5b0cb96d017d900ac0bbf853ed62f746aee034950b8fcd78fdebb5927d216ac281498b46eaca8a586f70bb60cd4b59523e9e0af30245742a1f019a6a8644e555484760bc050f1a27abd5e64aaa28a0c0656ed1a926dd70d8111dd25518107d36ed5b59a81152898b48e41a4362deed6e50707e6adba5ed3e0feb57557156a5ce842438eb23d954c59af29b43c17e5859fa8ce933ca4b382d9af431bb97bcec9bef3ac61584ea9f4c92aaffcb8c82b3f55e697fbef7c981bc53a70c19dcac3d4f2ceb6a81c0d5bc95c56265e11d3904a42037041cf9c4ae9b02b6a61049fb41c11ca353e23e1d58a5503b134f790d5320857731f53058caab9ab2d3c48f5f1206ccc83f051e60f468187a7f15ae60f8a57ed49d9ab79569eecfe985c2c4173a110c16c07f6804953c1544f9723446952252cdb2beb2ec7e8f9a22d1cb2fd06367704b7feb346b57d95bbce7318c44b57931405afa6a0ab122402d1aaeab2802a851a95a75386ceb72587a11732165cf0b906c4d1f280726d048970016315204ab92bdfae17c52be46ec0edd5e0341e97bbb6287790723559e426e249b2f35509b5bf32cfd4f851a656307f3cf16099cbe6f5c163232d3f99cde4cd3f3ede5c0daf062f6a8da2f640ca02e3d60bb12204cd25c24b89f05c22c8b75cc52d2f25c29f2d91a7b17b8c134ce021b796ad27176996e76b34bc5ca361476b349a44a276667be6f2a3f299e438d4042fca67322f9fc963e533bcaef5772a2aa8c31da24c7a3f2b11ebd2ccd1e67e93e718e40506996a25a8ca570aa5a9928e0183da710c52ccef98170047854c98a019f8ca61789408b352e82c173a7b0118f2051802d300222f80a809fe6cda35ba0844ed0888da5c430b404439aea6f4d459941e770e445aa6b3f3e650740900f2381495bbff8c23abc2126d91a25dab17c6fe317775794ec908b5b7c35496adf4a10964a4d80469302dc303368f2037562756314324b6684ceb443e3aa3ca06b8750e7f49b14a53d65f48021e9d51ffdd5cc14a9f9ad721a20f7f078cc97dd4a5f05c408b5e80e198e568a512b55c892a1550e2d54a256acf56e2d334754c709078be649227d64a5548acf3d10eb9dc574ee090abfdce85b9b3796dbf6c2dcf9370ed2949389f6753da51125e1819175a9184e7668ed44649a956d65cac486d9e2562b22864b063fd15a37a91e6c83f7bf41e7263fcc3eeedba60450eff78dee36a99ac65b6a3f6ad50cd96f522850474c194ed3a146c33f97ee61a80400509213c5ebe6298bb00856498724e53d9f4bada10a894a913f23498721911e1877590d18f22a85a373d1e361797b016232c8a05872fecfa089615a18496a57a31ad6d173b6e7606315000fd180f2a09e288845a11d955655304bf922e139afd7ab1bec92dc1adaa12a9fd25629d20f29f7c7048e409f9f907f9b90646ac0d5aee9faae31e822d24cc5c9ecea472e85cbe95c9bababbca626427f2931f621b0751bf8efd26ae4e7c20ede548cd1a08ca52cbb0bfa736d1887d81589339b1f91e8f96eff160fe25313627c672624c7ef6a3c362234811c336574e0c77a0d2c0061f88a99dbf3ff9d1a1a2d73d7e96e5aadae6e557695ccef1b4e080b0625a6d9c2911a0abffdceda83f2ff6b0183660e1cdbf422c6037042404b53bd6e72635cbed3b255979b630712e031c7dc88b2aad58c5eb92b612321c9aedea42d9aafa76f5007fb4989b39afa2dc64526ce362e781dacb2f8d32a8e57ed3742b00174068e2a2b955c42a51bdb9e22e613b8c3a808d895ad2c4f213f2a21394d43561ca5ae630491d433961247bf9669e2e35307f82e1f48ca48eaa140cb104870efb730a82480d10d3850eafa3a32572702cbcf30613c5091de0f76c4ef45ab1947a9c5d1c1381ac74a1976c9367b2cd0bb605362a144d439575babc079e8bda5cdad9794294d0859e67051133b1008ae2752e3ff0dd60e4259c16e3c4127ab1e5abc8abf4ae7ce5aa9dffc0c5811e0c81274f54a04557ad8fbdf4769d61a58d2cf6977f82430d15a1d95f2756d9ad918ff516e20857bb2a116ba88fe540e4f84028e249c44e62bf3e6276c97d390735018d124353cea8e1aa25b5b7deaed31ad61a725de62f15ff14779e208a7752f0ae296581770d251bc239168f1aa26cc0171a941323f9c44a1a8e66498a43418a4f981e8dd8a942d05b68a10e48807cc3d50a19c050b1bc15b106bca92e6768987f0e5c72ab5e885a03bb8e06956873a06a4f00552f804a9f05d4b32f0c540d390756c2de29e0a8306da2069f158b3388180bbdea5c29198affc90ff353643fcc1db1fc0cf2d20451f2e2f91100a13d76ca0de75e1acc54e75f1aac667d4d891890565567119de7877d6af8ac4e2231f244dfe3deeacf1967fbf4013f0a05f2cddc0e04b9faf0df90df4573474736f5a65ac7e2b29aa1cc54ebeb246f74e0eab83cf8c11fb915f9e811df96bf2463d79474267ffea343925d24e41b04bb060f32ac66cb5f928df5bcdd632d34f8104d90ab8ffef9bfbff87dc710ba43d4de3104aeff916bc8ff40be0beb504cc80799ab65eabd308ee81acf1cdf2a964a96bef3f818c6632d81b8a2c17ed1c0cbbaa4c8dd100464394226df14dabadd050ea487135e1ad674f4e2c8165cd6d7e5c1c1e764bec8bded52b5d78abd029523ea708da6faee18f2bc532919d5b695532992d92aea15640cdc59124c2ddc56d59930794871bcef3a167d28c6a2620909d21224a7b6114475dd3195119619a0999b1c9c108e677d03f540839dc2a28220f234ac2777cdaa7e2185b3ab0af3e60bf752533091a25585b9ee54e1658b63515490f3c47397c492e4d7575ed218a18459a2aadc701532a94abae750519534c35dd52a66c68be48faac539d086230217a7718c4945264b9d3ea50eca61da2570691eb29b45b67f852cb12b0b4cd1e732a5de3b4b50c482e014ef90753d1fdda9e2e3e9fcf0ca2987a8285c0501753ea49c9b58127c3e7c8d59c7479fcf953c9512f95fa684ed2bebddf2cc31ce407f4eae97811973a2475000737514a1285470d80fe490bcf1e370412cd3ac480d8a439a703a38ddc815be710615c7d9904de302af8d0a48f1422ceb4fab0c1b8a87c4fab9ce708abbf8c78bab4e083170c811e7ca09211542c820d9d84887e2f6adadd9da64dcedee8eef4e93adc6b9db62321689b8fd1b93717a5bec251bbbe9ce74707163ad3f984cd38b13d5fcc274e7b7884dbe4408f93d4ad4d52484d8053d9c245fc279254248ad78876b31903aea7d719adcededa5830bd39d41b2950cd666f7c5642f9d8e3626773f2536f932c6e139efcb0bbc83c6da7898de1393dd99988c447fb23b1eee74c56c35151be958aced08d1df9da9e7bce1da8e20e497c42602bc509bbcfcab983f3d3effc5463b1b6b83f4e2e6647861ba93b350323b4dc67752319a4c856a83571be9f8ce6c553ca4b6d2d1dfa336710921f7a84d4e114256977219789ee77b4dafe5055ee8455eecb5bd8eeff9bedff45b7ee0877ee4c77edbef34bda6df6c365bcda01936a366dc6c373b2dafe5b79aad562b6885ada815b7daad4ee0057ed00c5a4110844114c4413be8845ee887cdb01506611846611cb6c34ee4457ed48c5a5110855114c5513beac45eecc7cdb81507711847711cb7e34edb6bfbed66bbd50eda613b6ac7ed76bbd3f13a7ea7d96975824ed8893a71a7dde92cca69b4392ba444c88f994d5e2184bcab11d22047cfb73542d4015df9f30f0f89f50b5e60e1fffff97ff5cf7f7f451b279b29fdafaf7c937c47f98a6eb77016ddeef792bbdf4f0797de7d4b5eee76cbdf8cf4d6c6ddee7bef0d36263bbbd3f4073fe87657bd28182641bb9df4bda4334a3b74fe6b13b6f063137eecb7265aadd79beeeecc7aaaa9fe52f1543437cae769fe5cf12fbd2b921d012477bbc9f87eb72bc7f72f77bbb3fb5b696f6d08263abe1f455e3f09c27690c4b1f9c55dfaa341d86e46cd563a4cfacd6154f5f2f1b766d36e17947bc3e964abb736ee6d6d2483b4db5d6da6fe20f6864d6f08e36ff797beb047ab19f707a19fb6e2d04be2c0b7beb04730f2436fd41e3447497f18763ab566de635238cf3c885c7af772e947bbdd552f48bc66d889da7e3beaf883a4fe027d82a4e3c5413a4a5afd380987fd97be71e98dcddd99f89d23a98d3667ddeeef4cd766e9e56ef72eaebdc16a32ed7657d3bedf09423fecf87e3c6c7592e50b05781470146a2e637e3be9740f828b0741bb9384b11fb4c366336ad9dd1721a6e8af8e9a432f0c92f6288dfcb0d3199c78a1be3b10f06ab3ef075edaf493b61f78c32879b9b9331b76bba3d15ab73bc8db7cebb7f0ff683ad9ec29c5af0ed276276c27bedf8986417f18ffdaf72fa94e3bf7777a83c9e62694305b9da6c9b00793d8e8766fa6f78f78996ced74bb004eb7fbede964eb72f9b09a069d4e3c18f9edc8ef0fbc283e19ab610bc10dd351b2bb51d8432f9d4e27d3deea64b2deedae8ed2d06f0e86417fd0eea4717ff82553d9ca64b2f98ad5eb4d871b79a72fd7f387c2864e158f85097de584ead44fefac8d7bbbe3bb6be3e1aba162602b19af0dd6d7c677baddc1643c5b1befa63df5ae907f98768291ef85c1286dc69dc160257ebc9b1a593df7eeaecd564bbefd61128d92240c47f16890369ba7572fbd08c1dfc440bf99dcdf9824c323b1aa06ddeed5c9bd77d2df8654fb937bbdcdb4b7bbd5edae765ab1efb792bedf893a7ed81e7db5ffaba47427050c07b1376a8f3aed70306c0ee2a0ffb5a5a3699f79b9f057b98b5324841c6e6c96ca9ddf7d7b7e975f7bc9c6da9df1663a0689c44b5bed6173e84571df0b3b43277e9121e0653a61324a4671301c05cd66cb73bff5fc8ebbe38db5f17a6f2399de497b83d5dd31d4d51e0e87e13088fbd130483b61f0da170cb236de49a7b3c706e9a7499286f1281ef85173e04567dbcf1fa4402726df8ee27e3a18345beda0e5f547af5f7e7ecfe1daced6646781fd7edc4c92d6a8d5ea8fc24ed27c237c7effd13485674adba33888fc61ab190ec251dc7cb3f3fc6e9be9a652190ca31d619e7ee4b7db49b3336ab48a36c56535190f37d2458b86471a0efa5e7334e8b4fc41abd31a9d0b1e0fbb658ade2b5374b880e1c0f3872daf1fb583c1a813fcfa1786917e98a6c37698f487711c0dfcf8ebd102ba736b57b7bd3ca7ef0d56d301c498b6c27ea7e3b5faf160e08fe2e4ad20efa772e8e2d25389766f231df746c9da066695869d417bd80aa276cb6f2783f3e79e4eaddb5df5633f8a827e73d44ada50f685f3cf604c99e86a9486cd288edafd8e1f2403bf79f137165cfe7877b3db5ddbdcea762fad6d6e6d1406ac3e7d7b6d676b23b9aff2ffdd56f372f17a354d3c6f948441188c3a2dafd9f25e5be8a4c20e4c21e8078341a7df4e3acd663c6cf92f907fa45e3fed778278d88e3d7fe0359bc7bc89726a37c6a30910b4b393dc01f606411a85499404c37ed88a06ed56eb597d106810f621eab835ea079d7014421c5e185c3ed6e9bbf3966be3593a1d271bbdc164bc339bee0e660a80c3681047c351b33dec74e220f1c20bcfe83f5adb0093c3b0838aa59d4481d7f2daede859ed37d6c6689f743aa3569a846938e88f86fd30f69ed17e30d9d8dd1ca3471af6a341d069369bcdb81935db05e4944ae4f4ce2edce3f7fd6e77677572b7b7bbb3f67ba0e3779a233f8807811f0f07c3d0ef5c7c2a30469bb3de6e14c02282c8f7a234f6bd301cf8c37e375ce8707d32dd4c66b3740a410c7b90dd9d690268b747413bea0c9b83a0d90ac2d6e81bdf7a916e0a49bdad693a5abb87fca7d5ee07f06fc3c01bb592d125fc687bb0759ffc76756b3a19ee0ed2e90e333792f19ddde44e4ab5efedeecc486d6b3a19a43b3be9f07cff3ed5116006affb1702ef82777ebc766775b6715f34922448c37890b445d3f33be77def7ccb3ff73f0600f787a040d93e0000",
+		"f47618da1e3640c15468aad34618e1b1": "1f8b08000000000000ff6453c172ab3810bcf3157bdf4ad9609cc48777182121142c3d0b04186e800998406c073bc27cfd16ced6566d3d9d543d53d3add6f4d37c10a14cfce59040319739a0c80c3e199c31474e8e03555f8366086a26416d6eef7be57b43febe72abddbb27593e818feafad27cb4bf77526268d1c0e5a00d47a638969212fd164713511c110a66441cc47d69b9439e64df65bf3ea78a241cc9470d8d5c44967b6324bb1b69b26ef3bd688b89441cb14783d3f06db66f9a628f862c5cb785b51c690b29aa458c802bb20f86d212cdb68f6d234d4c5dd0e8965a9b2b47f61e2ba639665a28b079cb34774f7bacf803e3ed7f982e30d972f8a0c6cc881aeec4311fc904c10f4bad9c0fd114b4eb8bd5db2d4fc8e8b610fdd44a8563d1144744cbdebd19a9d54da9f53aba18c29f869372acd8fe9fb2e0a4293cbcc26474978527da345c771575af4649c76edb8bef42a18c234ed1fd42436e6fa026d471febd6be2c1920112b989d3cd20dfc27e7de97c8c6167641eb56ef1675ad811c3a7cf66186e41ff5e77566359e17d5c24e465b9ba733c1ef2bd4dd3707839ef6ec3746f57eec673cad040e2d8160a7d71b0e71f38604dd0424b32efc4b985c3fc2c2f8cc88f07f517aa898b6489a14e99af5364201979a089c63ac571b05420bd058248c33c6882f36380b409ab65edefac672bc9182ef3e1eb50efaaaded1ae560564d7578cddfd01d99e767ef77a4fbf3ab76668653c6a67639abc121800e246b8077cbbf43b82ec6ee7e114762d4c7cc5f9acd69657ea338f9c657334e0626ca7c71bfc211a479c1be458ac5b9ab3e8feb67572f9e4965f628081661b3f25e8c501495790ad63efcfa65cce9782202ff19997f06004b4667e44f030000",
+		"f6c19160482e0afe9124d7373e1a5019": "1f8b08000000000000ff6c93b992e23a1486733fc5cdbbba069b65e86082a315993e760bbc5c3903430b0c1ee36691f1d3df829eec8e22d5773e05fa4ffdaf8f43b854d13f942f12251485843fe0ab874ad1b8a214b6d2825304acd2b11c41244e7e323acc98bfb538ad7499c09c58dbee0e55fca135838a04a8cfcea3dab04c6bc95d98a53d4f9070097eca29c1b90ec4799517b7b21e9f4cc27324fa39231d466920ae8a1777cfe4e36af56f54ad7b5e20299f02dda15e0fc3dfb81f1fb7525c4ad91ddfebe8b64ef82792c1d3810ef38dec7acf04fcfa7719b6c20deec8e08e2c75315377646425dca0ff66eac9bc074471ee680f21b15146c02470cc125ca0e3dfdf53dc9d98c9bb5351bfddd7f571870be3043c67338f3b3f31f9c82e7e87bb751d9dca20bae162ea66df8f19230529a47f5a1fc8adaccbfb7b9d8d4ceebbb54caf2678bb78280e8e3b339b3785eaab01056dd49f3b035d326d81abdd360ca31f40aa4efe441b06832e2bf67a965276f1ea8909aa52baf8f27518beb4e13a48daaf81eb6f47bcceb26536e48a11dbaa3cac7e2eeee58634b1f3d93889e6d11965e33c660dcb16830fd0b31f0434039bdb3f496f42ad9faba3f42c41a7823824c4da2f62b920ba6450017a8fe0660b24f039e55001e043f6d38d72fa80740e4a14492f8aa9a36a24f6e38f269fec5ec2b6b190a7cb3c3e7a93e378dad567534d6283d0484a5bb9c4d11b01a4302801b9a64aaee221976268585dc6abdb757a5a86c071bebcecbddebe0f12d7155dd3f26931d4b027c3f6029b7adfde0fd99b2f9624bc55cedd9ac9f194bf6cfb2c36b94fbe3e55f359c0af5fdea310af3c62ff6fc97f0300161e4ba542030000",
+		"f893a330c50104305ad9e97df406a418": "1f8b08000000000000ffbccf31cac3300c05e0dda7d0057effd0315b091dba345d7200d57e0d025b0e8a0aa5a72fced41364147ad2c7bbb58c69de864074517e140ce4f642201a8b407d9aaf19eaf214580f118db03e26760c9438c17cfb4f1c9b2da78837d7b520a656fffa26aea8fbd5640bab7cd8a5299759c57ffe52daad407407ec1872052c109d73153d46e44e859ecab0a36a36cb3058f80e00703512f3e8010000",
+		"fa415ea7a0fac597b9b49372184e03e5": "1f8b08000000000000ffbccf3d0ec2300c05e03da77817a0ecdd50c5c042587a00933c50a4fc54ae9110a747e9c405ca68cb7e9fdeb545fa791d1d70ae72cf1c61faa203a69c58cdcf97c86ae991a8fd0898a87d0c621c1124506d3d0619f896b2640ea195435f0e0bcbf6e0f529357dc452ab92e79aec271261631c702375776d21d501a75852dd1d93ae38c06ba4fea15cd348a5baef001e34df7fd4010000",
+		"fb26f0ea66e974f15c085a4875af5e91": "1f8b08000000000000ff64534d93a24814bcf32bf63ed1210addd31cf6f0ead3420ba5f810b809da857c74abad16f8eb371c63776362ea56f95e44e6cbc87c793c44b908fec254c582090c317d802f961402670463d02b0d4620d04241e0fe3cc669e6b787d9ae8c7625739da9a406eb5c2cbe0a716f6c0a469892d0a525a1e5304d28aa254e5339d03b28a48314818e711bd425effad2f1afdb0d1d5803c97356c5240deaf280b855f5ec9acfba7b3e7b1f1881e8b9f015e359eae69ba9297972cd67de45aa2fc321276918123a30bb9c074d1ebd76d69eb34bc5876ed907b732863d33f628098c92246645c42809da3263df9f98f80f93ac1df01d7cebc998c7d0a5b154d2d0f0178ba0e648f2cd702c7a6f2cfbae962a37eca9604ecd34ce37ae569f7e6d957d70ac66c14d2adb70f3af4475ab7ad5557d3a06d16bf7bb4a544824391a4f3c92ae6781a61ce3e7070c9d832d00f9ee40be3bfb9cafd76febc9a55d38f149ed27f3e349d5c584b976a0561afcb19ad5d628ead659613b8bebe11591f7f3e7e2ddcf849aee53e35d8b88a6cb53c49cd3302c1a5d68a38bc4109d9354d96b08e7130b4148406fb444368769b2f3c3702351c831fee610260c198990d667a429436145a001f9f06dae24828f776a410320f1f7230b3b61c256e20508dc78d74bd747e0f0f3c2c98973f881b265907d659f1ffc10567ab7ddd7f74efaa70f4be25f41dad1303c488c31e3de58f0742c32315df6bf6741ebfa7f73635820ad4f56dd36ab75f83823980341140eb06b42d633716360bf91c95e9b4adc866d52b8dddbdeb4389a9ebf7f2c3b996e3d2db495f407137a6d70543f357270d06472f15a45faeecfb7591433afde7c980b99f937f76febd19e171a903f2bf5cf008ef2aa9e6f030000",
+		"fb54a0f8c64720ec5f19abc96d656bd6": "1f8b08000000000000ff648fc14e83401445f77c45f786648284eaf2cdf0780c6660a69d12e90e8a0e5a2836da52fc7a43e2ae77757356e7f8cb3892cc577a234bb0b87ac16a81bea724a5e0101457c4e7336d55f80c0e4988ff3f61ca894df5243918e3748a41b4472819e9aaba9c3ca3d7b79165877edb4911b690d851a9c1b2dffaaddb402e00cc47121e8f8f4f401a9bef194e8d4dca2183d6c82268bcafcf3a9f1a88828b7d8fc6feba2fce571b170fbb396ccbecf5609c223176d5a07fd2ecc6c8ade39dee15f3167f1ff3f83eea6f0015b28291f1000000",
+		"fbb1199a39cd6fb49014033849e1960c": "1f8b08000000000000ff648fcb8e82301846f73c857b4332124661f9b7fe161c8a52ea8d1d84b1c60b3005a6cad34f4866e7b7fa7256e7d8e308b2309e6c45b80789932f3c8dd0b678c80250089c70465e3f2ce5ae0f0a19a5ffdf6040d887c94d48204954f728fa363b2ebe9b59d3ca5f62995efbe25a54c12e2a57fb5cd79fc9100c2fe0905e04c414401ebac4d91648774d9f37a93266e98867a7ef99c3a62b8bc27ced165174cb2a775dd665776bdbf3ecb2f163ef0adef1709f9207eacd5c2e34f76aff7916a642254fd6e86f63bc7c8ffa1b003ab9f8d3f1000000",
+		"fbb99778bb172060828dfc740b3ddd66": "1f8b08000000000000ff648f4b6ec2301445e759051b4001e553183e27e6d935362609110ca18d9d022d4d8df25b7d15a9b3ded1d5199d339f46287235d3192fa1a033414f139c7b9223034b41128964683097e11a2cc524f9fb1d650417ddb9e304f67b2bafb72d2b2bf274cb342822ee1d4c25c3d815e3ee1b04fb58a926974112f9475fd419a804206f1f41f5eee88d0e6cb8bca0165763766dd43663589ebdd7fa6e9a78bb81936f736edce761b1e9c7d4ff8959ff40cb6ab716fa4df717dddf55bc92b87c1e23ccbebcc97f4e55fa3fea77006d8c3507f1000000",
+		"fd70b4862710089a573ce325b200369d": "1f8b08000000000000ff64934d93b2381485f7fc8ad9bfd5a522b6b898c54d082162c0d0e1730768c30b527e2046fcf55374cf4c4dd7649773933a4f72ef799b162294797f60124866330c924ce29bc619c3898531546e058a21a898f0674e77f7964ef5c1d7e569d7dccfeeacb5c0455575addbc6df0b6141837a2e7aa561915a911094a86d14be88e4885058840423ee0addeef3387b94ddea924a127324be6ae8c9bd50b70746b2514be35593275e53bc48c811fb3a806bbecb92ba2e12d4671faba6d0e74fda408a2a2f42c0254982bed4bd7ad7458696c60b55d07048f5cd9d2323b12453dc62ca9360f086296e9f134bf22f8d37ff6a2a9664c7a1a5dae4886a8ea3883fc90b826f974ae2d6ab0b7aea8ae576c863f2b41b08bf6ba5b422af2e7e235a76f6a0a5fae995eae6d39150fc8368d3cd98d168cc12364e983f28ed5611953aee3963af668e351029fb7b6381282d510119724e7ecd5c4a036b4c6673ddf6d7633e3c8ec6339943b1c4b1775990c7b5cd7e39efdae310c8f231d49b6578bff3bb1139b7f9fbe7f8a98a45b0a6abc32d09847e9bb9e3d5479d85d149823f913ac244f069120d01c72807e54ced0ce62784526543ca5c952224420714a118f714446823c531073575ea4014413325b0c60194abbe2e37080bc5ce50b97bfd5d8f336695797f3b54fbe3ceb0cb7e71ac8f0733dfa2112d2eef8e1faaee622acdfd760e10668a311616cb6d9b25db53d9b0e1e72ce818ffe773399c29c657aa7d7063333d03e61570126226f067bc5ec5b05f6d1cc3eb026cb4ceaa372fdecd4832282ead4bdc20237b6751df2a56694edb603c3c8e52f680d7f3ebc92c4665fbc5cc2c494dfc7e289bfbefed4d1743faa736a5e78d78d6ff23f5d700a4c29e126f030000",
 	})
 	if err != nil {
 		panic(err)
@@ -160,144 +160,144 @@ var _ = func() error {
 
 	func() {
 		b := packr.New("box", "config")
-		b.SetResolver("config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "a7c10c393a51b1844037b10845b3c522"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/ca/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "2473198f46be236809b2bc408138ff1c"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/ca/eb0c8deee3bd9717d399d186713cd7b362f82782c2584d9c56554f1e06fe5c7c_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "0e0743037d2b60aa902c9d64d33b7a8f"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "43559e1a234e5aebf47050ad21b389f4"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "35eebcf4cde7e931370f01aa7f6703dd"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "f476e643201fbf02f314ab3bfdbd701e"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "a0da285509578cb8bb6cee5eaf169493"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "68e2c43a6bd704be87766dad25e26996"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/msp/keystore/0926281815a9b5ffbe45e7727dbafa9b878d8bea2631232aea8b7ac57325a116_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "42aafaaf22a37ea1d401ad4bd740803f"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/msp/signcerts/orderer.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "bd09959288ad01a00225c2a79c742814"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "b604b6d273ca3102d16c5e01d33b547f"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "77b747ac15db981b00f5917697bffdcb"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "6ef21145fdaefb158274a63bae6a8757"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "e02a3432c9ae22a1bedd91ee03ea27a8"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "191e7011dc62eb84a93de49b32f5ea78"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "d0069d67da585e5519fa6fc82125d545"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/msp/keystore/c0c0a9ba3dcd0c9012258d81d14a65b2cf3bb54358bd24de1d095a59e51edec2_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "bc0feb967285bdc87ad50170d154de57"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/msp/signcerts/orderer2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "b82026ca74ebd5f523f5c818bb86dc9b"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "0141069186605bc0efaf6df92a8a5da2"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "91c7247b736259991b05597da9c7d131"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "8ab19a2c0a28f2901f1b75e1458066e4"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "c9b1378e2f1f223ac11f5655e832d308"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "e307e2ebd699c0a2667bc1803f495daa"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "9bc52dee72564b7d25797f988be56fdd"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/msp/keystore/8a2a82f835e72e0dc345f835d6b806f6f4f3353182b176d30805035f4eeef1a7_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "6bb2ab1d2c3162ee0d9f730c944a58c0"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/msp/signcerts/orderer3.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "65c550a333766580098aa336b78b041e"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "9159111881ac7d5d179c63ce2f65687b"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "08f8c16cb1184ecc2a086b2887cf60d3"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "9d5035faca939ccede91b17749822e2e"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "f1ab4b8c5578950f33f858e42882f34f"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "53e26f1c6755797b0decf0a14643c4a1"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "9541dc1384883f1ef2a272237589c045"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/msp/keystore/4d8b84be5290aed0aa7a5c57260d9da4044a5646a88c94222cbc8f0338779a1e_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "c01f089a7aa72b89451acef20057ea0c"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/msp/signcerts/orderer4.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "0e71edbfc97a442c85ba83370287a206"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "6e11fe6062a36ecb9964c2c2c76489ee"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "48f16a8cd7db11da7b6227ab07d93149"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "8389377f2fbd70e36e7be2c7f5ca5281"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "56236238c43d93a76fdfb79e14f73cfe"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "fff3f20b73d6c3375509d3724ce1f497"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "b9c8f6b8a8f5479ba10f39f6f2d04c75"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/msp/keystore/076ca9c58c84ec4043c90fc888360da33e0016f9605160d4b80150db041f82c5_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "aac60746a0f1604009acbd63f189cc9a"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/msp/signcerts/orderer5.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "b4cf17a6993658eebeb54a5001d78e7f"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "8f78be627d563771ed2e20df4c64d152"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "2facf2a57f0900858307be73ce414e86"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "58a0bb6b59f040b7d1650b5addbcc67f"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "31cb19a9c3e6f837ed81935061b0838b"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/tlsca/28fdbad966480dc6acadd80f78be0572cd5e85e77c689072075a7a1ce5309a9f_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "97b032d96068a4906f4423874bcda7fd"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/tlsca/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "95495d34a907a5e2e03665addb86dfdb"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "e4f0018d725423d11cc6b823e581242d"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "1a70bfe62a3dfb8acfe032c4192a2143"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/msp/keystore/c10dc7d04433fb9dbc4c6876ab8cb98c7d8e834fe616ec9d505e3eb3316caa74_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "29316eb7aad39120c720b4154a4e0b0c"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/msp/signcerts/Admin@example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "d40ea6ce4137492253ffdfdbc89f40e1"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "fac969692ac257e37c75e9ef5d18d8d2"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "7b84f2b6e859f02997a77f605359f9a9"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/tls/client.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "7c9b2209b819fc912d6461878ff19c31"})
-		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/tls/client.key", packr.Pointer{ForwardBox: gk, ForwardPath: "9cd901e9e9d0854cff4c6acf95fd2b75"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/ca/83d912f19df76cd8543408044ffa3e7caa686e8dda74ad5fc9680c783ba45086_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "7a90f592a53c71d5b9ae1db98f80985f"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/ca/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "5cb60b0be2ef7cbf1a2bd352f6b8518c"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/msp/cacerts/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "5d5cb12d5fb236344d67c6f9d7a2c6fc"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "b91577afee99582ac76023ddec1c3de9"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/msp/tlscacerts/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "c71e7dd6f5874e0a79af0dacece2b19c"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/msp/cacerts/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "085dba7e2c9f213147f352f1de862e99"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "c39273ade175ce73bd1bfa779e713cbd"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/msp/keystore/657e17435e13b0c66ea389aaedcd6339b8c308499408effb2990dbe22f3f294d_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "eff0d9dc52abb51a7ec5a11238ce4231"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/msp/signcerts/peer0.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "a32120157fd05843b3436aaf6d42df6b"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/msp/tlscacerts/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "7f28845decfaced317aa922d664d061f"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "93ca48fb36e6789338fa8384e49d9527"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "ee4a1e5bb3ca7a1b519670be19e835ef"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "74e3b10abc0b5abb7fe40574f967b73a"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/msp/cacerts/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "71d88182ba0750f4f5ac594e37e18fef"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "0b1035fa92a2096684a96cba2b148d4c"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/msp/keystore/7595664efb75c2be684efea29c1b58f7925d78dc3070092b3ea17a1e0a7be823_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "e27ac34014546c822718464a5252429a"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/msp/signcerts/peer1.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "f5442f8d371bec39595e39de0f00865a"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/msp/tlscacerts/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "324f9215967e3c2f96d54f32b8ffe1f3"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "5d24263f77728712a6c29557bb987ef4"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "55a379be0206a813681e1fc6587b32f8"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "ed2ef847f4e4ae637e5cb833cc1e8fcd"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/tlsca/79fb4d75431acdb47371939dc795cd3ee9d27051807dce34c24a706549146e09_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "8638edf15d5033bec9077fa35ad9b9a5"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/tlsca/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "157a03d5cc512a59a5e031f007911242"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp/cacerts/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "9ebfcab8c9863ceae18a50fe41a1d6ad"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "24424a4c2a6565a09b5115235ca0a284"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp/keystore/75cf3c52fc9173b29aa8bb19b7c0a6e9fee48348e3072bed1fce9b9632b6188a_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "10c19ad42cecb1a6935e778c276936fb"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp/signcerts/Admin@org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "dbdb49499f1ead0a180879e3934aebd9"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp/tlscacerts/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "2577c465c77ee6631778681231b52afd"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "348d32f57f1586048bf3382c2d2a1fe9"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "39946a8e8dbdd9b56cbfa37045f66371"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "d3dd55aaf483d751978bf1a0b70a8dc5"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/msp/cacerts/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "7c611eddf5db0f2c0dbc0e7dcbfedf66"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "2749db5b7c7daefa7a47056ac079e48e"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/msp/keystore/ef34e40fc4680ce18cefdc72ad26c240677036a67b2b45de0a718b529984797e_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "d5857b856dbbbe02c6e44e0448d11029"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/msp/signcerts/User1@org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "e4f43a695e7ec5f26e04e3849437f7ab"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/msp/tlscacerts/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "87a5d8157d70ac81ba171ee129e2c863"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "737d2af311f701af0291f45249f72596"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/tls/client.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "7e0dfe55b01ad1e1dc83c91e01497980"})
-		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/tls/client.key", packr.Pointer{ForwardBox: gk, ForwardPath: "216fab6231115b24c6feeda8a1eb805f"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/ca/c54bf9d68aee7306af6fc04085d348f16475ddd488f543916b64879ba39da944_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "35d4650a5b943111b94b92a7b0cf9d3e"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/ca/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "778d34766dba30f8889fbf6165e2d2ba"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/msp/cacerts/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "065df540e4ea07c285ec5aab92855351"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "fd03ae41ac3b3e5abc9f9cab9d491f54"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/msp/tlscacerts/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "5805459bcbf755934035c505de00f04b"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/msp/cacerts/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "87df747867d473bd7662b2dad195d4c2"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "b6aa8a205096e7169733ca3f25e8cf8e"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/msp/keystore/58bc6bf487bbaec8d2a95aeebea2ed92ab834a5a69bc6be64ba3036efb446533_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "a6f7577515d7f3182b6f004ed911b1a1"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/msp/signcerts/peer0.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "3ac9156724c363041ae471794ac21590"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/msp/tlscacerts/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "148e9bea854a1e67513e6329a3a6763f"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "d60243651092f9b1c9f7e06c4ab643c9"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "0cae28d103a9a7af6e5ce107effe46a3"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "ef2a4270d032df898d1836ea3aec179d"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/msp/cacerts/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "d45cbc426a6786484a5240c19df38172"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "b25727d202d27507aa1ec8d432fa245c"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/msp/keystore/ba29a5ad12a3d0d9534f4abde37b94e3ef3765c2292ed86648b4e0d4c2e8dd1c_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "00df918ba569d55a776c7323292f2085"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/msp/signcerts/peer1.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "6fc35031122d492a920b38caadee00b4"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/msp/tlscacerts/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "4d2ae5312bd6eb19177acf35a2c669d4"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "071b0084b56f9e4420324ef130bc92d1"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "743993710a49c934d771a72ee260764d"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "2bb998056a14b0ba66102d9e2da77921"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/tlsca/5653cc567cc02238162e4fa16ea1f89aa88005944963a5ea5e7cc66b188fa398_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "0b80819b3df6c34ba6e8e36725b879c2"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/tlsca/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "786ac599afee4a6211e43033f30c3c2b"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/msp/cacerts/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "caef80555adf321928ce83a094f88b58"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "d20cd817653eb72b0d26c22444e1139a"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/msp/keystore/1bb25631d6c6ba29ca2800d46409eed8bb2f5176b08f5948339b45ed14439bac_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "9ea6533fbb45a7419927d6d7005cbd3a"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/msp/signcerts/Admin@org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "f851b30cd7d8d2fa213ef5333e93587f"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/msp/tlscacerts/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "7a2d9285dc3a8c5955789bb0ed479fd3"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "40477c442328866ae2a5996bbf14682b"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "11b25447579de2d8cd07ed9397c3783b"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "cbaf2edfce5abdc6baaafa9e5c308eda"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/msp/cacerts/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "9aea375be26e78aaae4fff9705ea4456"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "4756ddb5e4159174bcab6d6a513464ae"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/msp/keystore/caf7db9c794657bd31d212cc9d2860af01040e770d7018767d8268509065bc0e_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "93798234d07d65cbf68e551cf94d95fd"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/msp/signcerts/User1@org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "4f5ce8404764dea55a584cffb76e56ed"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/msp/tlscacerts/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "1bb604b61a24d1d39bb7ef39a08abf82"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "58d942e2ac61d6f0af9e958eadfc4eb4"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/tls/client.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "25b4152de41b0df57d18db81b636bc5e"})
-		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/tls/client.key", packr.Pointer{ForwardBox: gk, ForwardPath: "f179ee367d1aca32235e1643a3e7bffa"})
-		b.SetResolver("fabric.toml", packr.Pointer{ForwardBox: gk, ForwardPath: "97b94c71adcf85997c8476db6519876c"})
-		b.SetResolver("fabric.validators", packr.Pointer{ForwardBox: gk, ForwardPath: "9e9679553d3f5533efafa0482fca2803"})
-		b.SetResolver("pier.toml", packr.Pointer{ForwardBox: gk, ForwardPath: "78909379d9b7903a7cb74ee327869a34"})
-		b.SetResolver("validating.wasm", packr.Pointer{ForwardBox: gk, ForwardPath: "1cfa8caf594ef8afece24c016070cb29"})
+		b.SetResolver("config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "23f17e4f7adf9df2557357959253ee13"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/ca/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "09d1e68aaa4e6ad15bd33843db71e552"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/ca/eb0c8deee3bd9717d399d186713cd7b362f82782c2584d9c56554f1e06fe5c7c_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "c4b35789c0024ae714a999ea1410f0ca"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "b83cca584863fce0a64fb8579990eb59"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "3b506c5963bcc83c923e88bba5bae97b"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "5d5b789df1a51823c1f7eb522fbd43b0"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "86351cc6f91e7658f97d2e8d70913904"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "e12f9107c5c85699e92124941a94103c"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/msp/keystore/0926281815a9b5ffbe45e7727dbafa9b878d8bea2631232aea8b7ac57325a116_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "d2dcdb18b24254b96db003cd8d546c84"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/msp/signcerts/orderer.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "06d85c18514a60ea12973088713ba618"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "63de57e5de6f44fbec2aa95476295990"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "ce2f505c9ae3c68a9bb3965a49fbcc29"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "eb0b8421f12afd1efbe50beada443568"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "e21d069e9bff540f57e5cce1d10497a2"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "1c5555fe52283fb771382cb2f83e46fc"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "d681096c5e53f26a3ff73727d7f805ee"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/msp/keystore/c0c0a9ba3dcd0c9012258d81d14a65b2cf3bb54358bd24de1d095a59e51edec2_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "e5bf45a9524c0595345d6387ce9482ac"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/msp/signcerts/orderer2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "bd39c2b5b3aac900d7788f6b78db99a7"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "0eab4e323b1df946f7bedbfeb9c50788"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "4b5a32638c016d89f4e7cbe32d5cfe80"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "fd70b4862710089a573ce325b200369d"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer2.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "7078d94fd03bd3e2d483e8f19ea876bd"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "557efd9e2924d1a8bb309485c532e1d7"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "830b80786c5d974bb7b7c26eeea2474f"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/msp/keystore/8a2a82f835e72e0dc345f835d6b806f6f4f3353182b176d30805035f4eeef1a7_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "4c9571cd2f799ac49bdf576d49688f35"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/msp/signcerts/orderer3.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "eb009c5e347efe1e1c27e35a61806d1a"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "022913578553f0c8e398f5aff424e8cd"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "c282aee160b282bc0d97b2920cf6618e"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "88f4b7fbda82d4ab14eb03cc7815e5cb"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer3.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "13d0c391ff7fbef610f991e81fd3eda3"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "8df79364160e508f741e65f4c7ff6d95"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "cfaeb7ee696771ddbf2fd614f067cbe1"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/msp/keystore/4d8b84be5290aed0aa7a5c57260d9da4044a5646a88c94222cbc8f0338779a1e_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "a90300fbb47af825910cb4c76a575a38"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/msp/signcerts/orderer4.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "815566ad247289ac91709667630a03c0"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "2573df96a5380fd9684d4c6613048543"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "8772f198e8a1bc480cad22c715a3dce7"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "e716a9c4f2bfc7769a31ca0da6663941"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer4.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "56965960caf2c6f1ccb0bd474f917ca1"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "3fa40af2da6e6d89f11916a9aa7a91c5"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "fa415ea7a0fac597b9b49372184e03e5"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/msp/keystore/076ca9c58c84ec4043c90fc888360da33e0016f9605160d4b80150db041f82c5_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "58a5bdd90ca1914b9c4a16fd7bec6e76"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/msp/signcerts/orderer5.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "b658fc077e985984fc2e4ee71a71b74d"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "2dade2a9a3c48c773e99b21905f4160d"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "51c35eb9bec35d85f3e626bfd3649d50"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "fb26f0ea66e974f15c085a4875af5e91"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/orderers/orderer5.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "ae252de36ca60b64db4dc72509a77434"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/tlsca/28fdbad966480dc6acadd80f78be0572cd5e85e77c689072075a7a1ce5309a9f_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "fbb1199a39cd6fb49014033849e1960c"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/tlsca/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "f47618da1e3640c15468aad34618e1b1"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/msp/cacerts/ca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "cc1a1438e2989496c6e36886a0eb5d19"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "a896dfc9ffa02993b5f833919eaa4cc2"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/msp/keystore/c10dc7d04433fb9dbc4c6876ab8cb98c7d8e834fe616ec9d505e3eb3316caa74_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "adc72468d6e09d166f5fd7bf882c5e13"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/msp/signcerts/Admin@example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "74059d200f1ddfe118cfe2c57f021bf7"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/msp/tlscacerts/tlsca.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "88e610cbee09e709b5570cd9314076ed"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "9f23f9fb705a05f254a06edad3890281"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/tls/client.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "52b712364bd982f9589a035ff0848d48"})
+		b.SetResolver("crypto-config/ordererOrganizations/example.com/users/Admin@example.com/tls/client.key", packr.Pointer{ForwardBox: gk, ForwardPath: "4eca2ed1bb681f62169d279fa2c977f9"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/ca/83d912f19df76cd8543408044ffa3e7caa686e8dda74ad5fc9680c783ba45086_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "c15921594bdc0a1f753dbd6250e31bd5"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/ca/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "160f46e22ed790aa2a94b86fa9cbcb46"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/msp/cacerts/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "9da360bee5fb8602a0dfe5f61f715524"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "8f705345cbb04227ec1e7244ddce696a"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/msp/tlscacerts/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "24c2648bf899dddbeb2cf0a5549b0294"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/msp/cacerts/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "57c29c31c27cde95cfeac356519f796c"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "a4e258b5c4031f8464e1d5d5c18749f7"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/msp/keystore/657e17435e13b0c66ea389aaedcd6339b8c308499408effb2990dbe22f3f294d_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "fbb99778bb172060828dfc740b3ddd66"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/msp/signcerts/peer0.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "1c443db0478276cd15bed0e8190433cb"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/msp/tlscacerts/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "e70228a46358aee3fdd3357e73730738"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "0ef4e11e63f019d618a466f71ab9fdf6"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "2170db6a57c3f758b84755546e1e8c0e"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer0.org1.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "b3530a182d508f92772c331e979209ce"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/msp/cacerts/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "4ae86b066ccbd75f4eb60f636a59778a"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "0e37a93aee3521e0dec21dda478d334d"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/msp/keystore/7595664efb75c2be684efea29c1b58f7925d78dc3070092b3ea17a1e0a7be823_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "8ee350f23dadaf5a59318e312d79e6e7"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/msp/signcerts/peer1.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "bb5dd56adf647a75ac3f4322a013ff29"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/msp/tlscacerts/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "52dc72bd3412c4f05a26fb3c1d058982"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "93184f40f0c2f8544b36c903894e4a4a"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "568ed3dc3feb03fc22f893db661522bb"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/peers/peer1.org1.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "1fe472990557375c41a807cbe3dcf656"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/tlsca/79fb4d75431acdb47371939dc795cd3ee9d27051807dce34c24a706549146e09_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "29362eb79da634a1ff216797079831da"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/tlsca/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "2f6d5fe18660de64cbba2fa4ae25cd69"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp/cacerts/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "0f9c2570b6f026715f5c48cbe305e0da"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "aa717a7a1501c3360e2febe75b3a0fda"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp/keystore/75cf3c52fc9173b29aa8bb19b7c0a6e9fee48348e3072bed1fce9b9632b6188a_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "71ce146a2db6f0f7cbe9e3e2c7f9a983"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp/signcerts/Admin@org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "cb1e083a66f2640ac1e004be503c2de2"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/msp/tlscacerts/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "cdd4685b9dbe8773cdf46086659a1862"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "c2cfcee5e13ac2123860313b44857e2f"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "a4b56cf2fc558ba44aa8050847a8f917"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/Admin@org1.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "0c46924bfecf51a5b246cc8319e277c4"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/msp/cacerts/ca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "da872c542e7a4bae7d71a79cf9fced13"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "2bcf1e57961a861b99b5abe2b5b15789"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/msp/keystore/ef34e40fc4680ce18cefdc72ad26c240677036a67b2b45de0a718b529984797e_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "e12d8af5f1b3629e0914ce134a080e00"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/msp/signcerts/User1@org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "78f125254e50c1626c8e59449b9d1dd0"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/msp/tlscacerts/tlsca.org1.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "23f42436d18566ff1b997e4c0f9cd6b7"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "708aa20865bde5c0b2b33776ba82fed5"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/tls/client.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "c18ab86936469d471a46ace12db12e58"})
+		b.SetResolver("crypto-config/peerOrganizations/org1.example.com/users/User1@org1.example.com/tls/client.key", packr.Pointer{ForwardBox: gk, ForwardPath: "a6898454b6650cbe4a19d8ffbf1acf67"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/ca/c54bf9d68aee7306af6fc04085d348f16475ddd488f543916b64879ba39da944_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "93e6f5fc5b2c90b8b0f0a2c662fc4fa0"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/ca/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "ac8f8d4d664d4d76cd03d057ce82aee7"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/msp/cacerts/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "577685d4868918703141b3e6be008c8b"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "dffc6c0873a87c3dcd0d63c98734548d"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/msp/tlscacerts/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "96f6c28ec6d4cb2f26c765b13f9b4839"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/msp/cacerts/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "2b33e20395f2cf2cb26a1b5171992c2c"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "ac552dae4d7e0d15e4662fe73f919024"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/msp/keystore/58bc6bf487bbaec8d2a95aeebea2ed92ab834a5a69bc6be64ba3036efb446533_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "6c4697db1ab3832deb27062333ad19db"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/msp/signcerts/peer0.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "78d76dfa7a3ea9a8d7e911e76ebd8b3f"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/msp/tlscacerts/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "05352be609d2333b35072b0926312e1b"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "4c71af6703646ff1cf807a4c011d9547"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "41b2046ee208572f31c574b0f1727dfe"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer0.org2.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "c13dc7dd001d222d906fcef4fa7beced"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/msp/cacerts/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "7717b1439212f8479d8414fb7bb187bb"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "24b8a83088960c57dabc75bdfe6cf9d9"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/msp/keystore/ba29a5ad12a3d0d9534f4abde37b94e3ef3765c2292ed86648b4e0d4c2e8dd1c_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "bdcc7a3bbbbb34823ac941b7c621d34f"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/msp/signcerts/peer1.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "21f6680c988741b46c1df82abdd65859"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/msp/tlscacerts/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "3824b8f4c15f351a71b0785c0f3b8289"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "5de1af416d2c8ffdaa6efe614ee8a7e6"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "620dc97512a78f7a684fa3683f468813"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/peers/peer1.org2.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "80648fa26023e719f269ccb85fbc30ff"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/tlsca/5653cc567cc02238162e4fa16ea1f89aa88005944963a5ea5e7cc66b188fa398_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "046e791738dcea5aebe00b7ce62dccf2"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/tlsca/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "cbafbb4789bfd0c2ac352814daf9cf18"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/msp/cacerts/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "7201712e76bbae88ed11a2e9d6e0c4fa"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "d3f67b8801de56f6eaa21e0fa561ccc2"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/msp/keystore/1bb25631d6c6ba29ca2800d46409eed8bb2f5176b08f5948339b45ed14439bac_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "481cb6351f62aa369c7700f40c943d30"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/msp/signcerts/Admin@org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "10836b1c338002a2e9ec2a6dad3a1d74"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/msp/tlscacerts/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "63d88d6d905f18d7e487ab3f096c184c"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "7ad240c605d011c812eccffbc42ea66f"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/tls/server.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "f6c19160482e0afe9124d7373e1a5019"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/Admin@org2.example.com/tls/server.key", packr.Pointer{ForwardBox: gk, ForwardPath: "fb54a0f8c64720ec5f19abc96d656bd6"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/msp/cacerts/ca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "a3362bbed02066e2b1c8cfe4a704bf2b"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/msp/config.yaml", packr.Pointer{ForwardBox: gk, ForwardPath: "f893a330c50104305ad9e97df406a418"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/msp/keystore/caf7db9c794657bd31d212cc9d2860af01040e770d7018767d8268509065bc0e_sk", packr.Pointer{ForwardBox: gk, ForwardPath: "46686019a8608b6ce36473c851987565"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/msp/signcerts/User1@org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "e7871de4bc0a1852b0d29e2db7d71340"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/msp/tlscacerts/tlsca.org2.example.com-cert.pem", packr.Pointer{ForwardBox: gk, ForwardPath: "ecafd99e87aac7c5d03bfa91deee70cd"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/tls/ca.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "271dc7047f9fa59ab2e5b03e279c6c07"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/tls/client.crt", packr.Pointer{ForwardBox: gk, ForwardPath: "ebccbbc4d8ef72f0fdb9cf554e7f855b"})
+		b.SetResolver("crypto-config/peerOrganizations/org2.example.com/users/User1@org2.example.com/tls/client.key", packr.Pointer{ForwardBox: gk, ForwardPath: "76375adb098616b98d50eeffe4f56e3d"})
+		b.SetResolver("fabric.toml", packr.Pointer{ForwardBox: gk, ForwardPath: "20e406ca2148962754bf5df1ded55055"})
+		b.SetResolver("fabric.validators", packr.Pointer{ForwardBox: gk, ForwardPath: "c1032e466c703e8b766bfeb891099210"})
+		b.SetResolver("pier.toml", packr.Pointer{ForwardBox: gk, ForwardPath: "baf6b0f8ed0a43ee1269b90ebc08e24f"})
+		b.SetResolver("validating.wasm", packr.Pointer{ForwardBox: gk, ForwardPath: "ed53994d28134ecdf098f8594707018e"})
 	}()
 	return nil
 }()
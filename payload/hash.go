@@ -0,0 +1,74 @@
+package payload
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// Legacy hashes the plain concatenation of the fields, as plugins did
+	// before the encoding was versioned. It is ambiguous and only meant for
+	// relays still checking hashes the old way: the IBTP does not carry the
+	// version, so both ends must be configured alike.
+	Legacy byte = 0
+	// Version of the canonical encoding hashed into pb.Payload.Hash
+	Version byte = 1
+
+	KindInterchain byte = 1
+	KindReceipt    byte = 2
+)
+
+// Encode packs fields as
+//
+//	version | kind | uint32 count | (uint32 len | field)*
+//
+// with big endian integers, so that no two different field lists share an
+// encoding.
+func Encode(kind byte, fields [][]byte) []byte {
+	size := 2 + 4
+	for _, f := range fields {
+		size += 4 + len(f)
+	}
+
+	buf := make([]byte, size)
+	buf[0], buf[1] = Version, kind
+	binary.BigEndian.PutUint32(buf[2:], uint32(len(fields)))
+	off := 6
+	for _, f := range fields {
+		binary.BigEndian.PutUint32(buf[off:], uint32(len(f)))
+		off += 4
+		off += copy(buf[off:], f)
+	}
+
+	return buf
+}
+
+// Pack returns the bytes of fields hashed under version, Encode for Version
+// and their concatenation for Legacy.
+func Pack(version, kind byte, fields [][]byte) []byte {
+	if version != Legacy {
+		return Encode(kind, fields)
+	}
+
+	var packed []byte
+	for _, f := range fields {
+		packed = append(packed, f...)
+	}
+
+	return packed
+}
+
+// InterchainHash is the payload hash of an interchain call of fn with args.
+func InterchainHash(version byte, fn string, args [][]byte) []byte {
+	fields := make([][]byte, 0, len(args)+1)
+	fields = append(fields, []byte(fn))
+	fields = append(fields, args...)
+
+	return crypto.Keccak256(Pack(version, KindInterchain, fields))
+}
+
+// ReceiptHash is the payload hash of a receipt carrying results.
+func ReceiptHash(version byte, results [][]byte) []byte {
+	return crypto.Keccak256(Pack(version, KindReceipt, results))
+}
@@ -0,0 +1,88 @@
+package payload
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+func args(s ...string) [][]byte {
+	ret := make([][]byte, 0, len(s))
+	for _, a := range s {
+		ret = append(ret, []byte(a))
+	}
+	return ret
+}
+
+func TestEncode(t *testing.T) {
+	got := hex.EncodeToString(Encode(KindInterchain, args("ab", "c")))
+	if want := "0101000000020000000261620000000163"; got != want {
+		t.Fatalf("encode: got %s, want %s", got, want)
+	}
+}
+
+// The vectors below pin version 1 of the encoding. Changing any of them
+// breaks hashes already signed on bitxhub and needs a new Version.
+func TestInterchainHashGolden(t *testing.T) {
+	tests := []struct {
+		fn   string
+		args [][]byte
+		hash string
+	}{
+		{"ab", args("c"), "9bd4ff16248abd02293fefc22bfe7deb94e63eaa477dc79372ba8aa538d5ba5a"},
+		{"a", args("bc"), "743e42415a24ce655aceb4eff9ac11135a9dd2bd4ad389d6b0cddf65bedf3a62"},
+		{"interchainCharge", args("Alice", "Bob", "1"), "3d6d27eb1b4b7745ae54965deef44c23c02b6e9b425b65b256f0f97fcf5b6429"},
+		{"", nil, "a37ddccca332cf9a32c211ebb79f1db88fac0dcce552c2bc33175a58061a1333"},
+	}
+
+	for _, tt := range tests {
+		if got := hex.EncodeToString(InterchainHash(Version, tt.fn, tt.args)); got != tt.hash {
+			t.Errorf("InterchainHash(%q, %q): got %s, want %s", tt.fn, tt.args, got, tt.hash)
+		}
+	}
+}
+
+func TestReceiptHashGolden(t *testing.T) {
+	tests := []struct {
+		results [][]byte
+		hash    string
+	}{
+		{nil, "5aef29b97657ec83a8db68e02891f4302fbd3081704a442a5544491380348e6b"},
+		{args("true"), "c969ee14ed7a37e0704c8c8b56f0846d60e8c75daadfdec3fe1f86f811f27255"},
+		{args("a", "b"), "c1db9a7e8dd63f535d95c006ae643b1edb4a7178b32753469b5d73d46bf00cdb"},
+	}
+
+	for _, tt := range tests {
+		if got := hex.EncodeToString(ReceiptHash(Version, tt.results)); got != tt.hash {
+			t.Errorf("ReceiptHash(%q): got %s, want %s", tt.results, got, tt.hash)
+		}
+	}
+}
+
+func TestHashNoCollision(t *testing.T) {
+	if bytes.Equal(InterchainHash(Version, "ab", args("c")), InterchainHash(Version, "a", args("bc"))) {
+		t.Fatal("shifting bytes between func and args collides")
+	}
+	if bytes.Equal(ReceiptHash(Version, args("ab", "c")), ReceiptHash(Version, args("a", "bc"))) {
+		t.Fatal("shifting bytes between results collides")
+	}
+	if bytes.Equal(InterchainHash(Version, "a", args("b")), ReceiptHash(Version, args("a", "b"))) {
+		t.Fatal("interchain and receipt payloads collide")
+	}
+}
+
+// Legacy hashes must keep matching the plain concatenation relays checked
+// before Version.
+func TestLegacyHash(t *testing.T) {
+	if got, want := hex.EncodeToString(InterchainHash(Legacy, "interchainCharge", args("Alice", "Bob", "1"))),
+		"553e3f1e1d424aaba9fa152ab8482f34e3cb34a029e06b4db95392332ae6bb5a"; got != want {
+		t.Errorf("InterchainHash: got %s, want %s", got, want)
+	}
+	if got, want := hex.EncodeToString(ReceiptHash(Legacy, args("true"))),
+		"6273151f959616268004b58dbb21e5c851b7b8d04498b4aabee12291d22fc034"; got != want {
+		t.Errorf("ReceiptHash: got %s, want %s", got, want)
+	}
+	if !bytes.Equal(InterchainHash(Legacy, "ab", args("c")), InterchainHash(Legacy, "a", args("bc"))) {
+		t.Fatal("legacy hashes do not concatenate the fields")
+	}
+}
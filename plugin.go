@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/meshplus/bitxhub-core/agency"
+	"github.com/meshplus/bitxhub-model/pb"
+	"github.com/meshplus/pier/pkg/plugins"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// gRPC metadata keys under which pier may send the timeout height and the
+// JSON encoded group of the IBTPs it submits, one value per IBTP, as the
+// agency.Client methods have no arguments for them.
+const (
+	MetadataTimeoutHeight = "ibtp-timeout-height"
+	MetadataGroup         = "ibtp-group"
+)
+
+// servePlugin serves impl to pier until pier kills the plugin process.
+func servePlugin(impl agency.Client) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: plugins.Handshake,
+		Plugins: map[string]plugin.Plugin{
+			plugins.PluginName: &appchainPlugin{AppchainGRPCPlugin: plugins.AppchainGRPCPlugin{Impl: impl}},
+		},
+		Logger:     logger,
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+}
+
+// appchainPlugin is plugins.AppchainGRPCPlugin with the server side
+// GetUpdateMeta implemented, which pier leaves panicking, and IBTPs submitted
+// with their interchain meta.
+type appchainPlugin struct {
+	plugins.AppchainGRPCPlugin
+}
+
+func (p *appchainPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
+	pb.RegisterAppchainPluginServer(s, &grpcServer{GRPCServer: &plugins.GRPCServer{Impl: p.Impl}})
+	return nil
+}
+
+type grpcServer struct {
+	*plugins.GRPCServer
+}
+
+// GetUpdateMeta streams the updates of the client until pier hangs up.
+func (s *grpcServer) GetUpdateMeta(_ *pb.Empty, conn pb.AppchainPlugin_GetUpdateMetaServer) error {
+	ctx := conn.Context()
+	metaC := s.Impl.GetUpdateMeta()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case meta, ok := <-metaC:
+			if !ok {
+				return nil
+			}
+			if err := conn.Send(meta); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// metaSubmitter submits IBTPs knowing their interchain meta.
+type metaSubmitter interface {
+	submitIBTPWithMeta(meta interchainMeta, from string, index uint64, serviceID string, ibtpType pb.IBTP_Type, content *pb.Content, proof *pb.BxhProof, isEncrypted bool) (*pb.SubmitIBTPResponse, error)
+	submitIBTPBatchWithMeta(meta []interchainMeta, from []string, index []uint64, serviceID []string, ibtpType []pb.IBTP_Type, content []*pb.Content, proof []*pb.BxhProof, isEncrypted []bool) (*pb.SubmitIBTPResponse, error)
+}
+
+// SubmitIBTP submits the IBTP with the interchain meta in the metadata of ctx.
+func (s *grpcServer) SubmitIBTP(ctx context.Context, req *pb.SubmitIBTPRequest) (*pb.SubmitIBTPResponse, error) {
+	submitter, ok := s.Impl.(metaSubmitter)
+	if !ok {
+		return s.GRPCServer.SubmitIBTP(ctx, req)
+	}
+	meta, err := incomingInterchainMeta(ctx, 1)
+	if err != nil {
+		return nil, err
+	}
+	if meta == nil {
+		meta = []interchainMeta{{}}
+	}
+
+	return submitter.submitIBTPWithMeta(meta[0], req.From, req.Index, req.ServiceId, req.Type, req.Content, req.BxhProof, req.IsEncrypted)
+}
+
+// SubmitIBTPBatch submits the IBTPs with the interchain meta in the metadata
+// of ctx.
+func (s *grpcServer) SubmitIBTPBatch(ctx context.Context, req *pb.SubmitIBTPRequestBatch) (*pb.SubmitIBTPResponse, error) {
+	submitter, ok := s.Impl.(metaSubmitter)
+	if !ok {
+		return s.GRPCServer.SubmitIBTPBatch(ctx, req)
+	}
+	meta, err := incomingInterchainMeta(ctx, len(req.From))
+	if err != nil {
+		return nil, err
+	}
+
+	return submitter.submitIBTPBatchWithMeta(meta, req.From, req.Index, req.ServiceId, req.Type, req.Content, req.BxhProof, req.IsEncrypted)
+}
+
+// incomingInterchainMeta returns the interchain meta of the n IBTPs submitted
+// with ctx, nil if pier sent none.
+func incomingInterchainMeta(ctx context.Context, n int) ([]interchainMeta, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	timeoutHeights := md.Get(MetadataTimeoutHeight)
+	groups := md.Get(MetadataGroup)
+	if len(timeoutHeights) == 0 && len(groups) == 0 {
+		return nil, nil
+	}
+	if len(timeoutHeights) != n || (len(groups) != 0 && len(groups) != n) {
+		return nil, fmt.Errorf("got %d timeout heights and %d groups for %d ibtps", len(timeoutHeights), len(groups), n)
+	}
+
+	meta := make([]interchainMeta, n)
+	for i := range meta {
+		height, err := strconv.ParseInt(timeoutHeights[i], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse timeout height of ibtp %d: %w", i, err)
+		}
+		meta[i].TimeoutHeight = height
+		if len(groups) != 0 {
+			if err := json.Unmarshal([]byte(groups[i]), &meta[i].Group); err != nil {
+				return nil, fmt.Errorf("unmarshal group of ibtp %d: %w", i, err)
+			}
+		}
+	}
+
+	return meta, nil
+}
@@ -0,0 +1,361 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/meshplus/bitxhub-core/agency"
+	"github.com/meshplus/bitxhub-model/pb"
+	"github.com/meshplus/pier-client-fabric/codec"
+	"github.com/meshplus/pier-client-fabric/internal/simulator"
+	"github.com/meshplus/pier/pkg/plugins"
+	"google.golang.org/grpc/metadata"
+)
+
+// TestMain turns the test binary into the plugin when pier's handshake
+// cookie is set, so that the conformance test can launch it through
+// go-plugin like pier does.
+func TestMain(m *testing.M) {
+	if os.Getenv(plugins.Handshake.MagicCookieKey) == plugins.Handshake.MagicCookieValue {
+		servePlugin(&simulatedClient{Client: &Client{}})
+		os.Exit(0)
+	}
+
+	os.Exit(m.Run())
+}
+
+// simulatedClient is the plugin talking to a simulated appchain1 on which
+// alice has sent 10 to bob on appchain2 and bob has an account.
+type simulatedClient struct {
+	*Client
+}
+
+func (c *simulatedClient) Initialize(configPath string, extra []byte, mode string) error {
+	chain, err := simulator.New("appchain1")
+	if err != nil {
+		return err
+	}
+	for _, account := range []string{"alice", "bob"} {
+		if _, res := chain.Invoke(simulator.UserMSP, simulator.Transfer, "setBalance", account, "100"); res.Status != shim.OK {
+			return errors.New(res.Message)
+		}
+	}
+	remote := "1356:appchain2:" + simulator.Channel + "&" + simulator.Transfer
+	if _, res := chain.Invoke(simulator.UserMSP, simulator.Transfer, "transfer", remote, "alice", "bob", "10"); res.Status != shim.OK {
+		return errors.New(res.Message)
+	}
+
+	err = c.initialize(configPath, func(ctx context.Context, meta *ContractMeta, msgH MessageHandler) (*Consumer, error) {
+		return newConsumer(ctx, meta, msgH, simulatorClients(chain)), nil
+	})
+	if err != nil {
+		return err
+	}
+	c.ticker = time.NewTicker(50 * time.Millisecond)
+
+	return nil
+}
+
+// TestPluginConformance calls every agency.Client method of the plugin
+// process through the gRPC boundary pier uses and checks that the plugin
+// survives and answers with well-formed responses.
+func TestPluginConformance(t *testing.T) {
+	if testing.Short() {
+		t.Skip("launches the plugin process")
+	}
+
+	pluginClient := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  plugins.Handshake,
+		Plugins:          plugins.PluginMap,
+		Cmd:              exec.Command(os.Args[0]),
+		Logger:           hclog.NewNullLogger(),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+	})
+	t.Cleanup(pluginClient.Kill)
+
+	rpcClient, err := pluginClient.Client()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := rpcClient.Dispense(plugins.PluginName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := raw.(agency.Client)
+
+	pair := genServicePair(testLocal, testRemote)
+	inPair := genServicePair(testRemote, testLocal)
+	amount := make([]byte, 8)
+	amount[7] = 10
+	content := &pb.Content{
+		Func: "interchainCharge",
+		Args: codec.Encode(0, codec.String("carol"), codec.String("bob"), codec.Bytes(amount)),
+	}
+	begin := &pb.BxhProof{TxStatus: pb.TransactionStatus_BEGIN}
+
+	checks := []struct {
+		method string
+		check  func(t *testing.T)
+	}{
+		{"Initialize", func(t *testing.T) {
+			if err := c.Initialize(writeTestConfig(t), nil, "relay"); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"Name", func(t *testing.T) {
+			if name := c.Name(); name != "fabric" {
+				t.Fatalf("got name %q, want fabric", name)
+			}
+		}},
+		{"Type", func(t *testing.T) {
+			if typ := c.Type(); typ != FabricType {
+				t.Fatalf("got type %q, want %s", typ, FabricType)
+			}
+		}},
+		{"GetUpdateMeta", func(t *testing.T) {
+			if c.GetUpdateMeta() == nil {
+				t.Fatal("no update meta channel")
+			}
+		}},
+		{"GetOffChainDataReq", func(t *testing.T) {
+			if c.GetOffChainDataReq() == nil {
+				t.Fatal("no off-chain data request channel")
+			}
+		}},
+		{"Start", func(t *testing.T) {
+			if err := c.Start(); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		{"GetIBTPCh", func(t *testing.T) {
+			select {
+			case ibtp := <-c.GetIBTPCh():
+				if ibtp == nil || ibtp.ID() != testLocal+"-"+testRemote+"-1" || len(ibtp.Proof) == 0 {
+					t.Fatalf("unexpected ibtp %+v", ibtp)
+				}
+			case <-time.After(10 * time.Second):
+				t.Fatal("no ibtp polled")
+			}
+		}},
+		{"GetChainID", func(t *testing.T) {
+			bxhID, appchainID, err := c.GetChainID()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if bxhID != simulator.BitXHubID || appchainID != "appchain1" {
+				t.Fatalf("got chain id %s:%s", bxhID, appchainID)
+			}
+		}},
+		{"GetServices", func(t *testing.T) {
+			services, err := c.GetServices()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !containsString(services, testLocal) {
+				t.Fatalf("transfer missing in services %v", services)
+			}
+		}},
+		{"GetOutMeta", func(t *testing.T) {
+			meta, err := c.GetOutMeta()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if meta[pair] != 1 {
+				t.Fatalf("unexpected out meta %v", meta)
+			}
+		}},
+		{"GetOutMessage", func(t *testing.T) {
+			ibtp, err := c.GetOutMessage(pair, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ibtp.From != testLocal || ibtp.To != testRemote || ibtp.Index != 1 || ibtp.Type != pb.IBTP_INTERCHAIN {
+				t.Fatalf("unexpected out message %+v", ibtp)
+			}
+		}},
+		{"SubmitIBTP", func(t *testing.T) {
+			ret, err := c.SubmitIBTP(testRemote, 1, testTransferCID, pb.IBTP_INTERCHAIN, content, begin, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ret.Status || ret.Result == nil || ret.Result.Type != pb.IBTP_RECEIPT_SUCCESS {
+				t.Fatalf("unexpected response %+v", ret)
+			}
+		}},
+		{"SubmitIBTPBatch", func(t *testing.T) {
+			ret, err := c.SubmitIBTPBatch([]string{testRemote}, []uint64{2}, []string{testTransferCID},
+				[]pb.IBTP_Type{pb.IBTP_INTERCHAIN}, []*pb.Content{content}, []*pb.BxhProof{begin}, []bool{false})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ret.Status {
+				t.Fatalf("unexpected response %+v", ret)
+			}
+		}},
+		{"GetInMeta", func(t *testing.T) {
+			meta, err := c.GetInMeta()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if meta[inPair] != 2 {
+				t.Fatalf("unexpected in meta %v", meta)
+			}
+		}},
+		{"GetReceiptMessage", func(t *testing.T) {
+			ibtp, err := c.GetReceiptMessage(inPair, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ibtp.From != testRemote || ibtp.To != testLocal || ibtp.Type != pb.IBTP_RECEIPT_SUCCESS {
+				t.Fatalf("unexpected receipt %+v", ibtp)
+			}
+		}},
+		{"SubmitReceipt", func(t *testing.T) {
+			ret, err := c.SubmitReceipt(testRemote, 1, testTransferCID, pb.IBTP_RECEIPT_SUCCESS,
+				&pb.Result{MultiStatus: []bool{true}}, &pb.BxhProof{TxStatus: pb.TransactionStatus_SUCCESS})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !ret.Status {
+				t.Fatalf("unexpected response %+v", ret)
+			}
+		}},
+		{"SubmitReceiptBatch", func(t *testing.T) {
+			ret, err := c.SubmitReceiptBatch([]string{testRemote}, []uint64{2, 3}, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ret.Status || ret.Message == "" {
+				t.Fatalf("malformed batch accepted: %+v", ret)
+			}
+		}},
+		{"GetCallbackMeta", func(t *testing.T) {
+			meta, err := c.GetCallbackMeta()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if meta[pair] != 1 {
+				t.Fatalf("unexpected callback meta %v", meta)
+			}
+		}},
+		{"GetDstRollbackMeta", func(t *testing.T) {
+			if _, err := c.GetDstRollbackMeta(); err != nil {
+				t.Fatal(err)
+			}
+		}},
+		// the broker knows neither of these in relay mode, the plugin has to
+		// answer with an error rather than crash
+		{"GetDirectTransactionMeta", func(t *testing.T) {
+			_, _, _, _ = c.GetDirectTransactionMeta(pair + "-1")
+		}},
+		{"GetAppchainInfo", func(t *testing.T) {
+			_, _, _, _ = c.GetAppchainInfo("appchain2")
+		}},
+		{"GetOffChainData", func(t *testing.T) {
+			if _, err := c.GetOffChainData(&pb.GetDataRequest{}); err == nil {
+				t.Fatal("off-chain data served")
+			}
+		}},
+		{"SubmitOffChainData", func(t *testing.T) {
+			if err := c.SubmitOffChainData(&pb.GetDataResponse{}); err == nil {
+				t.Fatal("off-chain data accepted")
+			}
+		}},
+		{"Stop", func(t *testing.T) {
+			if err := c.Stop(); err != nil {
+				t.Fatal(err)
+			}
+		}},
+	}
+
+	for _, check := range checks {
+		if !t.Run(check.method, check.check) {
+			// later methods depend on the state left by earlier ones
+			t.FailNow()
+		}
+		if pluginClient.Exited() {
+			t.Fatalf("plugin exited calling %s", check.method)
+		}
+	}
+}
+
+// TestSubmitIBTPMetadata submits IBTPs the way pier's gRPC client does, with
+// their interchain meta in the metadata, and checks the receipts carry it.
+func TestSubmitIBTPMetadata(t *testing.T) {
+	f := newFakeFabric(t)
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "bob", "0")
+	c := newTestClient(t, f)
+	s := &grpcServer{GRPCServer: &plugins.GRPCServer{Impl: c}}
+	begin := &pb.BxhProof{TxStatus: pb.TransactionStatus_BEGIN}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		MetadataTimeoutHeight, "77", MetadataGroup, `{"appchain3":4}`))
+	ret, err := s.SubmitIBTP(ctx, &pb.SubmitIBTPRequest{From: testRemote, Index: 1, ServiceId: testTransferCID,
+		Type: pb.IBTP_INTERCHAIN, Content: transferContent("carol", "bob", 1), BxhProof: begin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := ret.Result; !ret.Status || r == nil || r.TimeoutHeight != 77 || r.Group == nil || r.Group.Keys[0] != "appchain3" || r.Group.Vals[0] != 4 {
+		t.Fatalf("unexpected response %+v", ret)
+	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		MetadataTimeoutHeight, "78", MetadataTimeoutHeight, "79"))
+	ret, err = s.SubmitIBTPBatch(ctx, &pb.SubmitIBTPRequestBatch{From: []string{testRemote, testRemote}, Index: []uint64{2, 3},
+		ServiceId: []string{testTransferCID, testTransferCID}, Type: []pb.IBTP_Type{pb.IBTP_INTERCHAIN, pb.IBTP_INTERCHAIN},
+		Content:     []*pb.Content{transferContent("carol", "bob", 1), transferContent("carol", "bob", 1)},
+		BxhProof:    []*pb.BxhProof{begin, begin},
+		IsEncrypted: []bool{false, false}})
+	if err != nil || !ret.Status {
+		t.Fatalf("submit batch: %+v, %v", ret, err)
+	}
+	for idx, want := range map[uint64]int64{2: 78, 3: 79} {
+		receipt, err := c.GetReceiptMessage(genServicePair(testRemote, testLocal), idx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if receipt.TimeoutHeight != want {
+			t.Fatalf("receipt %d times out at %d, want %d", idx, receipt.TimeoutHeight, want)
+		}
+	}
+
+	// without metadata the timeout of the interchain is unknown
+	ret, err = s.SubmitIBTP(context.Background(), &pb.SubmitIBTPRequest{From: testRemote, Index: 4, ServiceId: testTransferCID,
+		Type: pb.IBTP_INTERCHAIN, Content: transferContent("carol", "bob", 1), BxhProof: begin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := ret.Result; !ret.Status || r == nil || r.TimeoutHeight != 0 || r.Group != nil {
+		t.Fatalf("unexpected response %+v", ret)
+	}
+
+	for _, md := range []metadata.MD{
+		metadata.Pairs(MetadataTimeoutHeight, "high"),
+		metadata.Pairs(MetadataTimeoutHeight, "1", MetadataTimeoutHeight, "2"),
+		metadata.Pairs(MetadataTimeoutHeight, "1", MetadataGroup, "{"),
+		metadata.Pairs(MetadataGroup, "{}"),
+	} {
+		_, err := s.SubmitIBTP(metadata.NewIncomingContext(context.Background(), md), &pb.SubmitIBTPRequest{From: testRemote, Index: 5,
+			ServiceId: testTransferCID, Type: pb.IBTP_INTERCHAIN, Content: transferContent("carol", "bob", 1), BxhProof: begin})
+		if err == nil {
+			t.Fatalf("submitted with malformed metadata %v", md)
+		}
+	}
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,235 @@
+package proof
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric/protoutil"
+)
+
+const (
+	// Version of the envelope proof encoding
+	Version = 2
+)
+
+// Proof ties an interchain message to the fabric transaction that carried it.
+// The endorsements can be checked against the endorsement policy of the
+// channel, and the envelope against the data hash of the block header, with
+// the other envelopes of the block. ValidationCode is what the ledger queried
+// by the prover reported, fabric keeps it in block metadata no header covers.
+type Proof struct {
+	Version                 uint32        `json:"version"`
+	TxID                    string        `json:"tx_id"`
+	Envelope                []byte        `json:"envelope"`
+	ProposalResponsePayload []byte        `json:"proposal_response_payload"`
+	Endorsements            []Endorsement `json:"endorsements"`
+	ValidationCode          int32         `json:"validation_code"`
+	Header                  BlockHeader   `json:"header"`
+	TxIndex                 uint32        `json:"tx_index"`
+	// Preceding and Following are the envelopes of the block before and
+	// after Envelope
+	Preceding [][]byte `json:"preceding"`
+	Following [][]byte `json:"following"`
+}
+
+// BlockHeader is the header of the block of the proven transaction.
+type BlockHeader struct {
+	Number       uint64 `json:"number"`
+	PreviousHash []byte `json:"previous_hash"`
+	DataHash     []byte `json:"data_hash"`
+}
+
+// Hash is the fabric block hash of h, which the next block links to.
+func (h BlockHeader) Hash() []byte {
+	return protoutil.BlockHeaderHash(&common.BlockHeader{
+		Number:       h.Number,
+		PreviousHash: h.PreviousHash,
+		DataHash:     h.DataHash,
+	})
+}
+
+type Endorsement struct {
+	Endorser  []byte `json:"endorser"`
+	Signature []byte `json:"signature"`
+}
+
+// New builds the proof of txID from the processed transaction and the block
+// that contains it, whose transactions filter must agree with the validation
+// code of the transaction.
+func New(txID string, tx *peer.ProcessedTransaction, block *common.Block) (*Proof, error) {
+	if tx == nil || tx.TransactionEnvelope == nil {
+		return nil, fmt.Errorf("transaction %s has no envelope", txID)
+	}
+	if block == nil || block.Header == nil || block.Data == nil {
+		return nil, fmt.Errorf("block of transaction %s is empty", txID)
+	}
+
+	var txEnv *common.Envelope
+	txIndex := -1
+	for i, data := range block.Data.Data {
+		env, err := protoutil.GetEnvelopeFromBlock(data)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal envelope %d of block %d: %w", i, block.Header.Number, err)
+		}
+		chdr, err := protoutil.ChannelHeader(env)
+		if err != nil {
+			return nil, fmt.Errorf("channel header of envelope %d of block %d: %w", i, block.Header.Number, err)
+		}
+		if chdr.TxId == txID {
+			txEnv = env
+			txIndex = i
+			break
+		}
+	}
+	if txIndex == -1 {
+		return nil, fmt.Errorf("transaction %s not found in block %d", txID, block.Header.Number)
+	}
+	if code, ok := filterCode(block, txIndex); !ok || code != tx.ValidationCode {
+		return nil, fmt.Errorf("validation code %s of transaction %s does not match block %d",
+			peer.TxValidationCode(tx.ValidationCode), txID, block.Header.Number)
+	}
+
+	cap, err := ChaincodeActionPayload(txEnv)
+	if err != nil {
+		return nil, err
+	}
+
+	endorsements := make([]Endorsement, 0, len(cap.Action.Endorsements))
+	for _, e := range cap.Action.Endorsements {
+		endorsements = append(endorsements, Endorsement{
+			Endorser:  e.Endorser,
+			Signature: e.Signature,
+		})
+	}
+
+	p := &Proof{
+		Version:                 Version,
+		TxID:                    txID,
+		Envelope:                block.Data.Data[txIndex],
+		ProposalResponsePayload: cap.Action.ProposalResponsePayload,
+		Endorsements:            endorsements,
+		ValidationCode:          tx.ValidationCode,
+		Header: BlockHeader{
+			Number:       block.Header.Number,
+			PreviousHash: block.Header.PreviousHash,
+			DataHash:     block.Header.DataHash,
+		},
+		TxIndex:   uint32(txIndex),
+		Preceding: append([][]byte{}, block.Data.Data[:txIndex]...),
+		Following: append([][]byte{}, block.Data.Data[txIndex+1:]...),
+	}
+	if err := p.VerifyInclusion(); err != nil {
+		return nil, fmt.Errorf("block %d of transaction %s: %w", block.Header.Number, txID, err)
+	}
+
+	return p, nil
+}
+
+// VerifyInclusion checks that Envelope is transaction TxIndex of the block
+// whose data hash Header carries.
+func (p *Proof) VerifyInclusion() error {
+	if len(p.Preceding) != int(p.TxIndex) {
+		return fmt.Errorf("%d envelopes precede transaction %d", len(p.Preceding), p.TxIndex)
+	}
+
+	data := make([][]byte, 0, len(p.Preceding)+1+len(p.Following))
+	data = append(append(append(data, p.Preceding...), p.Envelope), p.Following...)
+	if !bytes.Equal(protoutil.BlockDataHash(&common.BlockData{Data: data}), p.Header.DataHash) {
+		return fmt.Errorf("envelopes do not match the block data hash")
+	}
+
+	return nil
+}
+
+// filterCode returns the validation code the committer recorded for
+// transaction idx of block.
+func filterCode(block *common.Block, idx int) (int32, bool) {
+	if block.Metadata == nil || len(block.Metadata.Metadata) <= int(common.BlockMetadataIndex_TRANSACTIONS_FILTER) {
+		return 0, false
+	}
+	filter := block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER]
+	if idx >= len(filter) {
+		return 0, false
+	}
+
+	return int32(filter[idx]), true
+}
+
+func (p *Proof) Marshal() ([]byte, error) {
+	return json.Marshal(p)
+}
+
+// Unmarshal decodes an envelope proof. Legacy proofs, which are a raw
+// ChaincodeActionPayload, are rejected.
+func Unmarshal(data []byte) (*Proof, error) {
+	if !IsEnvelopeProof(data) {
+		return nil, fmt.Errorf("not an envelope proof")
+	}
+
+	p := &Proof{}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("unmarshal envelope proof: %w", err)
+	}
+	if p.Version != Version {
+		return nil, fmt.Errorf("unsupported proof version %d", p.Version)
+	}
+
+	return p, nil
+}
+
+// IsEnvelopeProof tells envelope proofs apart from legacy proofs.
+func IsEnvelopeProof(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		return false
+	}
+
+	var v struct {
+		Version uint32 `json:"version"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return false
+	}
+
+	return v.Version != 0
+}
+
+// ActionPayload returns the raw payload of the first action of a transaction
+// envelope, which is what legacy proofs consist of.
+func ActionPayload(env *common.Envelope) ([]byte, error) {
+	payload, err := protoutil.UnmarshalPayload(env.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal payload: %w", err)
+	}
+
+	tx, err := protoutil.UnmarshalTransaction(payload.Data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal transaction: %w", err)
+	}
+	if len(tx.Actions) == 0 {
+		return nil, fmt.Errorf("transaction has no actions")
+	}
+
+	return tx.Actions[0].Payload, nil
+}
+
+// ChaincodeActionPayload decodes the payload returned by ActionPayload.
+func ChaincodeActionPayload(env *common.Envelope) (*peer.ChaincodeActionPayload, error) {
+	data, err := ActionPayload(env)
+	if err != nil {
+		return nil, err
+	}
+
+	cap, err := protoutil.UnmarshalChaincodeActionPayload(data)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal chaincode action payload: %w", err)
+	}
+	if cap.Action == nil {
+		return nil, fmt.Errorf("chaincode action payload has no endorsed action")
+	}
+
+	return cap, nil
+}
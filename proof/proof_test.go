@@ -0,0 +1,134 @@
+package proof_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hyperledger/fabric-protos-go/common"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/meshplus/pier-client-fabric/internal/simulator"
+	"github.com/meshplus/pier-client-fabric/proof"
+)
+
+func TestNew(t *testing.T) {
+	c, err := simulator.New("appchain1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := c.Execute(channel.Request{ChaincodeID: "broker", Fcn: "getChainId"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := c.QueryTransaction(res.TransactionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := c.QueryBlockByTxID(res.TransactionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := proof.New(string(res.TransactionID), tx, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := p.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proof.IsEnvelopeProof(data) {
+		t.Fatal("envelope proof not recognized")
+	}
+	if _, err := proof.Unmarshal(data); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Endorsements) == 0 || p.Header.Number != block.Header.Number || !bytes.Equal(p.Header.Hash(), protoutil.BlockHeaderHash(block.Header)) {
+		t.Fatalf("unexpected proof %+v", p)
+	}
+
+	if _, err := proof.New("unknown", tx, block); err == nil {
+		t.Fatal("proof of a transaction missing from the block")
+	}
+
+	// the validation code must agree with the transactions filter
+	tx.ValidationCode = int32(peer.TxValidationCode_MVCC_READ_CONFLICT)
+	if _, err := proof.New(string(res.TransactionID), tx, block); err == nil {
+		t.Fatal("proof of a transaction the block records as valid reported invalid")
+	}
+	if err := c.Invalidate(res.TransactionID); err != nil {
+		t.Fatal(err)
+	}
+	if block, err = c.QueryBlockByTxID(res.TransactionID); err != nil {
+		t.Fatal(err)
+	}
+	if p, err = proof.New(string(res.TransactionID), tx, block); err != nil {
+		t.Fatal(err)
+	}
+	if p.ValidationCode != int32(peer.TxValidationCode_MVCC_READ_CONFLICT) {
+		t.Fatalf("got validation code %d", p.ValidationCode)
+	}
+}
+
+func TestNewInBlockOfSeveral(t *testing.T) {
+	c, err := simulator.New("appchain1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var envelopes [][]byte
+	var txIDs []string
+	for i := 0; i < 3; i++ {
+		res, err := c.Execute(channel.Request{ChaincodeID: "broker", Fcn: "getChainId"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx, err := c.QueryTransaction(res.TransactionID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		envelopes = append(envelopes, protoutil.MarshalOrPanic(tx.TransactionEnvelope))
+		txIDs = append(txIDs, string(res.TransactionID))
+	}
+	block := protoutil.NewBlock(7, []byte("previous"))
+	block.Data.Data = envelopes
+	block.Header.DataHash = protoutil.BlockDataHash(block.Data)
+	block.Metadata.Metadata[common.BlockMetadataIndex_TRANSACTIONS_FILTER] = make([]byte, len(envelopes))
+	tx, err := c.QueryTransaction(fab.TransactionID(txIDs[1]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := proof.New(txIDs[1], tx, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.TxIndex != 1 || len(p.Preceding) != 1 || len(p.Following) != 1 {
+		t.Fatalf("got transaction %d with %d preceding and %d following envelopes", p.TxIndex, len(p.Preceding), len(p.Following))
+	}
+	if err := p.VerifyInclusion(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		tamper func(p *proof.Proof)
+	}{
+		{"other index", func(p *proof.Proof) { p.TxIndex = 0 }},
+		{"swapped envelopes", func(p *proof.Proof) { p.Envelope, p.Preceding[0] = p.Preceding[0], p.Envelope }},
+		{"dropped envelope", func(p *proof.Proof) { p.Following = nil }},
+		{"other block", func(p *proof.Proof) { p.Header.DataHash = []byte("data hash") }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := proof.New(txIDs[1], tx, block)
+			if err != nil {
+				t.Fatal(err)
+			}
+			tc.tamper(p)
+			if err := p.VerifyInclusion(); err == nil {
+				t.Fatal("tampered proof verified")
+			}
+		})
+	}
+}
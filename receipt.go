@@ -1,8 +1,8 @@
 package main
 
 import (
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/meshplus/bitxhub-model/pb"
+	"github.com/meshplus/pier-client-fabric/payload"
 )
 
 func (c *Client) generateReceipt(from, to string, idx uint64, args [][]byte, proof []byte, status, encrypt bool, typ uint64) (*pb.IBTP, error) {
@@ -17,23 +17,26 @@ func (c *Client) generateReceipt(from, to string, idx uint64, args [][]byte, pro
 		multiStatus = append(multiStatus, false)
 	}
 	results := &pb.Result{Data: result, MultiStatus: multiStatus}
+	if encrypt {
+		srcAppchainID, err := parseAppchainID(from)
+		if err != nil {
+			return nil, err
+		}
+		results, err = c.cryptor.EncryptResult(results, srcAppchainID)
+		if err != nil {
+			return nil, err
+		}
+	}
 	content, err := results.Marshal()
 	if err != nil {
 		return nil, err
 	}
 
-	var packed []byte
-	for _, ele := range args {
-		packed = append(packed, ele...)
-	}
-
-	payload := pb.Payload{
+	pd, err := (&pb.Payload{
 		Encrypted: encrypt,
 		Content:   content,
-		Hash:      crypto.Keccak256(packed),
-	}
-
-	pd, err := payload.Marshal()
+		Hash:      payload.ReceiptHash(c.currentConfig().Fabric.payloadVersion(), args),
+	}).Marshal()
 	if err != nil {
 		return nil, err
 	}
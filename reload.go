@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/hashicorp/go-hclog"
+)
+
+const (
+	// AdminConfigPath serves the active config on the admin port
+	AdminConfigPath = "/config"
+
+	// reloadDelay lets an editor finish writing fabric.toml before it is
+	// read again
+	reloadDelay = 200 * time.Millisecond
+)
+
+// liveConfig is active with the settings of next that can change without
+// restarting the plugin, along with the keys of the other settings next
+// changes, which are refused.
+func liveConfig(active, next *Config) (*Config, []string) {
+	applied := *active
+	applied.Fabric.TimeoutHeight = next.Fabric.TimeoutHeight
+	applied.Fabric.TimeoutPeriod = next.Fabric.TimeoutPeriod
+	applied.Services = next.Services
+	applied.Log.Level = next.Log.Level
+	applied.Retry = next.Retry
+	applied.Health.StalePeriod = next.Health.StalePeriod
+	applied.Polling = next.Polling
+
+	return &applied, configDiff(&applied, next)
+}
+
+// configDiff lists the keys of the settings differing between a and b, lists
+// counting as one setting.
+func configDiff(a, b *Config) []string {
+	fa, fb := flattenConfig(a), flattenConfig(b)
+	var keys []string
+	for key, value := range fb {
+		if !reflect.DeepEqual(fa[key], value) {
+			keys = append(keys, key)
+		}
+	}
+	for key := range fa {
+		if _, ok := fb[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// flattenConfig maps the dotted keys of the settings of config, as named in
+// fabric.toml, to their values.
+func flattenConfig(config *Config) map[string]interface{} {
+	var tree map[string]interface{}
+	data, err := json.Marshal(config)
+	if err == nil {
+		err = json.Unmarshal(data, &tree)
+	}
+	if err != nil {
+		return nil
+	}
+
+	flat := make(map[string]interface{})
+	var flatten func(prefix string, value interface{})
+	flatten = func(prefix string, value interface{}) {
+		if section, ok := value.(map[string]interface{}); ok {
+			for key, value := range section {
+				flatten(prefix+key+".", value)
+			}
+			return
+		}
+		flat[strings.TrimSuffix(prefix, ".")] = value
+	}
+	flatten("", tree)
+
+	return flat
+}
+
+// currentConfig is the active config, which is replaced as a whole on reload
+// and must not be modified.
+func (c *Client) currentConfig() *Config {
+	c.configMu.RLock()
+	defer c.configMu.RUnlock()
+
+	return c.config
+}
+
+// reloadConfig reads fabric.toml again and applies the settings that can
+// change live, keeping the active value of the others.
+func (c *Client) reloadConfig() error {
+	next, err := UnmarshalConfig(c.configPath)
+	if err != nil {
+		return fmt.Errorf("reload config: %w", err)
+	}
+
+	c.configMu.Lock()
+	active := c.config
+	applied, refused := liveConfig(active, next)
+	c.config = applied
+	c.configMu.Unlock()
+
+	c.logger.SetLevel(hclog.LevelFromString(applied.Log.Level))
+	c.health.setStalePeriod(time.Duration(applied.Health.StalePeriod) * time.Second)
+	if applied.Polling.Interval != active.Polling.Interval {
+		c.ticker.Reset(time.Duration(applied.Polling.Interval) * time.Second)
+	}
+	for _, key := range refused {
+		c.logger.Warn("Refused config change, restart the plugin to apply it", "key", key)
+	}
+	if changed := configDiff(active, applied); len(changed) != 0 {
+		c.logger.Info("Reloaded config", "changed", strings.Join(changed, ","))
+	}
+
+	return nil
+}
+
+// watchConfig reloads the config whenever watcher reports fabric.toml
+// changed, until ctx is cancelled.
+func (c *Client) watchConfig(ctx context.Context, watcher *fsnotify.Watcher) {
+	defer watcher.Close()
+
+	// editors write and rename files in several steps, so the config is
+	// reloaded once its directory settles
+	timer := time.NewTimer(reloadDelay)
+	timer.Stop()
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Base(e.Name) == ConfigName {
+				timer.Reset(reloadDelay)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			c.logger.Warn("Watch config", "error", err.Error())
+		case <-timer.C:
+			if err := c.reloadConfig(); err != nil {
+				c.logger.Error("Keep the active config", "error", err.Error())
+			}
+		}
+	}
+}
+
+// configHandler serves the active config as json.
+func (c *Client) configHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(c.currentConfig())
+	})
+}
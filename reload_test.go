@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLiveConfig(t *testing.T) {
+	active := DefaultConfig()
+	next := DefaultConfig()
+	next.Fabric.TimeoutHeight = 50
+	next.Fabric.ChannelId = "otherchannel"
+	next.Fabric.CCID = "otherbroker"
+	next.Log.Level = "debug"
+	next.Services = []Service{{ID: "mychannel&transfer"}}
+
+	applied, refused := liveConfig(active, next)
+	if applied.Fabric.TimeoutHeight != 50 || applied.Log.Level != "debug" || len(applied.Services) != 1 {
+		t.Fatalf("safe changes not applied: %+v", applied)
+	}
+	if applied.Fabric.ChannelId != active.Fabric.ChannelId || applied.Fabric.CCID != active.Fabric.CCID {
+		t.Fatalf("unsafe changes applied: %+v", applied.Fabric)
+	}
+	if want := []string{"fabric.ccid", "fabric.channel_id"}; !reflect.DeepEqual(refused, want) {
+		t.Fatalf("got refused %v, want %v", refused, want)
+	}
+	if active.Fabric.TimeoutHeight != DefaultConfig().Fabric.TimeoutHeight {
+		t.Fatal("active config modified")
+	}
+}
+
+func TestConfigReload(t *testing.T) {
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+	port := freePort(t)
+	c.config.Admin.Port = port
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+	activeConfig(t, port)
+
+	config := "[fabric]\nname = \"fabric\"\nccid = \"broker\"\nchannel_id = \"otherchannel\"\ntimeout_height = 50\n[log]\nlevel = \"debug\"\n"
+	if err := ioutil.WriteFile(filepath.Join(c.configPath, ConfigName), []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		active := activeConfig(t, port)
+		if active.Fabric.TimeoutHeight == 50 {
+			if active.Log.Level != "debug" {
+				t.Fatalf("log level %q not reloaded", active.Log.Level)
+			}
+			if active.Fabric.ChannelId != DefaultConfig().Fabric.ChannelId {
+				t.Fatalf("channel changed to %q", active.Fabric.ChannelId)
+			}
+			if active.Admin.Port == 0 {
+				t.Fatal("admin port changed")
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("config not reloaded: %+v", active.Fabric)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if !c.logger.IsDebug() {
+		t.Fatal("log level not applied")
+	}
+}
+
+func activeConfig(t *testing.T, port int) *Config {
+	t.Helper()
+	resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d%s", port, AdminConfigPath))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	config := &Config{}
+	if err := json.NewDecoder(resp.Body).Decode(config); err != nil {
+		t.Fatal(err)
+	}
+
+	return config
+}
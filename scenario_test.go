@@ -0,0 +1,266 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/meshplus/bitxhub-model/pb"
+	"github.com/meshplus/pier-client-fabric/internal/simulator"
+)
+
+// scenario runs the plugins of simulated appchains and stands in for pier and
+// bitxhub between them: the IBTPs a plugin polls are submitted to the plugin
+// of the chain they are headed for.
+type scenario struct {
+	t         *testing.T
+	chains    map[string]*scenarioChain
+	ibtps     chan *pb.IBTP
+	stop      chan struct{}
+	delivered map[string]bool
+}
+
+type scenarioChain struct {
+	*fakeFabric
+	client *Client
+}
+
+// step is an action on the chains followed by the cross-chain traffic it
+// causes until done holds.
+type step struct {
+	name string
+	do   func(s *scenario)
+	done func(s *scenario) bool
+}
+
+func newScenario(t *testing.T, appchainIDs ...string) *scenario {
+	s := &scenario{
+		t:         t,
+		chains:    make(map[string]*scenarioChain),
+		ibtps:     make(chan *pb.IBTP),
+		stop:      make(chan struct{}),
+		delivered: make(map[string]bool),
+	}
+	for _, id := range appchainIDs {
+		chain := newFakeChain(t, id)
+		client := newTestClient(t, chain)
+		client.ticker = time.NewTicker(10 * time.Millisecond)
+		if err := client.Start(); err != nil {
+			t.Fatal(err)
+		}
+		go func() {
+			for ibtp := range client.GetIBTPCh() {
+				select {
+				case s.ibtps <- ibtp:
+				case <-s.stop:
+					return
+				}
+			}
+		}()
+		s.chains[id] = &scenarioChain{fakeFabric: chain, client: client}
+	}
+	// runs before the clients are stopped
+	t.Cleanup(func() {
+		close(s.stop)
+	})
+
+	return s
+}
+
+func (s *scenario) chain(appchainID string) *scenarioChain {
+	chain, ok := s.chains[appchainID]
+	if !ok {
+		s.t.Fatalf("unknown appchain %s", appchainID)
+	}
+
+	return chain
+}
+
+// run performs steps in order, relaying IBTPs until each step is done.
+func (s *scenario) run(steps ...step) {
+	for _, st := range steps {
+		st.do(s)
+		deadline := time.After(10 * time.Second)
+		for !st.done(s) {
+			select {
+			case ibtp := <-s.ibtps:
+				s.deliver(ibtp)
+			case <-time.After(10 * time.Millisecond):
+			case <-deadline:
+				s.t.Fatalf("step %q is not done", st.name)
+			}
+		}
+	}
+}
+
+// deliver submits ibtp to its destination once. Interchains go to the chain
+// of To, receipts back to the chain of From.
+func (s *scenario) deliver(ibtp *pb.IBTP) {
+	key := fmt.Sprintf("%s-%d", ibtp.ID(), ibtp.Category())
+	if s.delivered[key] {
+		return
+	}
+	s.delivered[key] = true
+
+	pd := &pb.Payload{}
+	if err := pd.Unmarshal(ibtp.Payload); err != nil {
+		s.t.Fatalf("unmarshal payload of %s: %v", ibtp.ID(), err)
+	}
+
+	var ret *pb.SubmitIBTPResponse
+	var err error
+	switch ibtp.Type {
+	case pb.IBTP_INTERCHAIN:
+		dst := s.chain(appchainOf(s.t, ibtp.To))
+		content := &pb.Content{}
+		if err := content.Unmarshal(pd.Content); err != nil {
+			s.t.Fatalf("unmarshal content of %s: %v", ibtp.ID(), err)
+		}
+		// the relay hands the interchain meta on, so the receipt times out
+		// with the interchain
+		meta := interchainMeta{TimeoutHeight: ibtp.TimeoutHeight}
+		ret, err = dst.client.submitIBTPWithMeta(meta, ibtp.From, ibtp.Index, serviceOf(s.t, ibtp.To), ibtp.Type,
+			content, &pb.BxhProof{TxStatus: pb.TransactionStatus_BEGIN}, pd.Encrypted)
+		if err == nil && ret.Result != nil {
+			if ret.Result.TimeoutHeight != ibtp.TimeoutHeight {
+				s.t.Fatalf("receipt of %s times out at %d, want %d", ibtp.ID(), ret.Result.TimeoutHeight, ibtp.TimeoutHeight)
+			}
+			defer s.deliver(ret.Result)
+		}
+	case pb.IBTP_RECEIPT_SUCCESS, pb.IBTP_RECEIPT_FAILURE:
+		src := s.chain(appchainOf(s.t, ibtp.From))
+		result := &pb.Result{}
+		if err := result.Unmarshal(pd.Content); err != nil {
+			s.t.Fatalf("unmarshal result of %s: %v", ibtp.ID(), err)
+		}
+		txStatus := pb.TransactionStatus_SUCCESS
+		if ibtp.Type == pb.IBTP_RECEIPT_FAILURE {
+			txStatus = pb.TransactionStatus_FAILURE
+		}
+		ret, err = src.client.SubmitReceipt(ibtp.To, ibtp.Index, serviceOf(s.t, ibtp.From), ibtp.Type,
+			result, &pb.BxhProof{TxStatus: txStatus})
+	default:
+		s.t.Fatalf("unexpected ibtp type %s", ibtp.Type)
+	}
+	if err != nil {
+		s.t.Fatalf("submit %s: %v", ibtp.ID(), err)
+	}
+	if !ret.Status {
+		s.t.Fatalf("submit %s: %s", ibtp.ID(), ret.Message)
+	}
+}
+
+func appchainOf(t *testing.T, fullID string) string {
+	_, appchainID, _, err := parseChainServiceID(fullID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return appchainID
+}
+
+func serviceOf(t *testing.T, fullID string) string {
+	_, _, serviceID, err := parseChainServiceID(fullID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return serviceID
+}
+
+func setBalance(appchainID, account, balance string) step {
+	return step{
+		name: fmt.Sprintf("set balance of %s on %s", account, appchainID),
+		do: func(s *scenario) {
+			s.chain(appchainID).mustInvoke(simulator.UserMSP, simulator.Transfer, "setBalance", account, balance)
+		},
+		done: func(*scenario) bool { return true },
+	}
+}
+
+func transfer(from, sender, to, receiver, amount string) step {
+	return step{
+		name: fmt.Sprintf("transfer %s from %s on %s to %s on %s", amount, sender, from, receiver, to),
+		do: func(s *scenario) {
+			dst := s.chain(to).ServiceID(simulator.Transfer)
+			s.chain(from).mustInvoke(simulator.UserMSP, simulator.Transfer, "transfer", dst, sender, receiver, amount)
+		},
+		done: func(*scenario) bool { return true },
+	}
+}
+
+// callback waits until the receipt of interchain index from service name on
+// src to the same service on dst has been executed on src.
+func callback(src, dst, name string, index uint64) step {
+	return step{
+		name: fmt.Sprintf("callback %d of %s from %s to %s", index, name, src, dst),
+		do:   func(*scenario) {},
+		done: func(s *scenario) bool {
+			meta, err := s.chain(src).client.GetCallbackMeta()
+			if err != nil {
+				return false
+			}
+			return meta[genServicePair(s.chain(src).ServiceID(name), s.chain(dst).ServiceID(name))] >= index
+		},
+	}
+}
+
+func expectState(appchainID, name, key, want string) step {
+	return step{
+		name: fmt.Sprintf("%s of %s on %s is %q", key, name, appchainID, want),
+		do:   func(*scenario) {},
+		done: func(s *scenario) bool {
+			return s.chain(appchainID).state(name, key) == want
+		},
+	}
+}
+
+func TestScenarioTransfer(t *testing.T) {
+	newScenario(t, "appchain1", "appchain2").run(
+		setBalance("appchain1", "alice", "100"),
+		setBalance("appchain2", "bob", "0"),
+		transfer("appchain1", "alice", "appchain2", "bob", "10"),
+		expectState("appchain2", simulator.Transfer, "bob", "10"),
+		callback("appchain1", "appchain2", simulator.Transfer, 1),
+		expectState("appchain1", simulator.Transfer, "alice", "90"),
+
+		// and back again
+		transfer("appchain2", "bob", "appchain1", "alice", "4"),
+		expectState("appchain1", simulator.Transfer, "alice", "94"),
+		callback("appchain2", "appchain1", simulator.Transfer, 1),
+		expectState("appchain2", simulator.Transfer, "bob", "6"),
+	)
+}
+
+func TestScenarioTransferRollback(t *testing.T) {
+	// the receiver has no account on appchain2, so the transfer is rolled back
+	newScenario(t, "appchain1", "appchain2").run(
+		setBalance("appchain1", "alice", "100"),
+		transfer("appchain1", "alice", "appchain2", "nobody", "10"),
+		callback("appchain1", "appchain2", simulator.Transfer, 1),
+		expectState("appchain1", simulator.Transfer, "alice", "100"),
+		expectState("appchain2", simulator.Transfer, "nobody", ""),
+	)
+}
+
+func TestScenarioDataSwapper(t *testing.T) {
+	newScenario(t, "appchain1", "appchain2").run(
+		step{
+			name: "set key on appchain2",
+			do: func(s *scenario) {
+				s.chain("appchain2").mustInvoke(simulator.UserMSP, simulator.DataSwapper, "set", "key", "value")
+			},
+			done: func(*scenario) bool { return true },
+		},
+		step{
+			name: "get key of appchain2 on appchain1",
+			do: func(s *scenario) {
+				remote := s.chain("appchain2").ServiceID(simulator.DataSwapper)
+				s.chain("appchain1").mustInvoke(simulator.UserMSP, simulator.DataSwapper, "get", remote, "key")
+			},
+			done: func(*scenario) bool { return true },
+		},
+		callback("appchain1", "appchain2", simulator.DataSwapper, 1),
+		expectState("appchain1", simulator.DataSwapper, "key", "value"),
+	)
+}
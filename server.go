@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// servers are the HTTP servers of the plugin, handlers configured on the same
+// port sharing one server.
+type servers struct {
+	logger  hclog.Logger
+	muxes   map[int]*http.ServeMux
+	running []*http.Server
+}
+
+// handle registers handler for path on port, a zero port disabling it.
+func (s *servers) handle(port int, path string, handler http.Handler) {
+	if port == 0 {
+		return
+	}
+	if s.muxes == nil {
+		s.muxes = make(map[int]*http.ServeMux)
+	}
+	mux, ok := s.muxes[port]
+	if !ok {
+		mux = http.NewServeMux()
+		s.muxes[port] = mux
+	}
+	mux.Handle(path, handler)
+}
+
+// serve starts serving the registered handlers until shutdown.
+func (s *servers) serve() error {
+	for port, mux := range s.muxes {
+		l, err := net.Listen("tcp", net.JoinHostPort("", strconv.Itoa(port)))
+		if err != nil {
+			return fmt.Errorf("listen on port %d: %w", port, err)
+		}
+
+		srv := &http.Server{Handler: mux}
+		s.running = append(s.running, srv)
+		go func() {
+			if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				s.logger.Error("Serve http", "addr", l.Addr().String(), "error", err.Error())
+			}
+		}()
+		s.logger.Info("Serving http", "addr", l.Addr().String())
+	}
+
+	return nil
+}
+
+func (s *servers) shutdown(ctx context.Context) error {
+	var err error
+	for _, srv := range s.running {
+		if serr := srv.Shutdown(ctx); serr != nil && err == nil {
+			err = serr
+		}
+	}
+	s.running = nil
+
+	return err
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/meshplus/bitxhub-model/pb"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/meshplus/pier-client-fabric"
+
+	// maxEmittedTraces bounds the trace contexts kept for IBTPs waiting for
+	// their receipt, the oldest being dropped first
+	maxEmittedTraces = 10000
+)
+
+// Tracing traces the IBTPs of a client. The trace context of the interchains
+// the plugin emits is kept by their ID until their receipt is submitted, so
+// that the receipt continues the trace of its interchain. It is not carried
+// in the IBTPs, the Extra of which is part of their hash.
+type Tracing struct {
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+
+	mu      sync.Mutex
+	emitted map[string]trace.SpanContext
+	order   []string
+}
+
+// NewTracing exports the spans of the client to the OTLP collector of config,
+// tracing nothing if it has no endpoint.
+func NewTracing(config TracingConfig) (*Tracing, error) {
+	t := &Tracing{
+		tracer:  trace.NewNoopTracerProvider().Tracer(tracerName),
+		emitted: make(map[string]trace.SpanContext),
+	}
+	if config.Endpoint == "" {
+		return t, nil
+	}
+	if config.SampleRatio < 0 || config.SampleRatio > 1 {
+		return nil, fmt.Errorf("sample ratio %v is not within [0, 1]", config.SampleRatio)
+	}
+
+	exporter, err := newOTLPExporter(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	t.provider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", config.ServiceName))),
+	)
+	t.tracer = t.provider.Tracer(tracerName)
+
+	return t, nil
+}
+
+func (t *Tracing) start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// emit keeps the trace context sc of the interchain ibtp handed to pier until
+// its receipt is submitted.
+func (t *Tracing) emit(sc trace.SpanContext, ibtp *pb.IBTP) {
+	if ibtp.Type != pb.IBTP_INTERCHAIN || !sc.IsValid() {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	id := ibtp.ID()
+	if _, ok := t.emitted[id]; !ok {
+		t.order = append(t.order, id)
+	}
+	t.emitted[id] = sc
+	for len(t.order) > maxEmittedTraces {
+		delete(t.emitted, t.order[0])
+		t.order = t.order[1:]
+	}
+}
+
+// receiptContext returns ctx continuing the trace of the interchain with id
+// if it was emitted by this client.
+func (t *Tracing) receiptContext(ctx context.Context, id string) context.Context {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	sc, ok := t.emitted[id]
+	if !ok {
+		return ctx
+	}
+	delete(t.emitted, id)
+
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Shutdown exports the spans not exported yet.
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	if t.provider == nil {
+		return nil
+	}
+
+	return t.provider.Shutdown(ctx)
+}
+
+func ibtpAttributes(servicePair string, index uint64, typ string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("ibtp.service_pair", servicePair),
+		attribute.Int64("ibtp.index", int64(index)),
+		attribute.String("ibtp.type", typ),
+	}
+}
+
+// endSpan ends span, marking it failed if err is not nil.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// responseError is err, or the message of ret if pier is told the submission
+// failed.
+func responseError(ret *pb.SubmitIBTPResponse, err error) error {
+	if err == nil && ret != nil && !ret.Status {
+		return errors.New(ret.Message)
+	}
+
+	return err
+}
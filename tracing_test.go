@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/meshplus/bitxhub-model/pb"
+)
+
+// TestTracingReceipt checks that the receipt of an interchain continues the
+// trace its IBTP was polled in, without the trace changing the IBTP.
+func TestTracingReceipt(t *testing.T) {
+	var mu sync.Mutex
+	var spans []OTLPSpan
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != OTLPTracesPath {
+			http.NotFound(w, r)
+			return
+		}
+		req := &OTLPTraces{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+	}))
+	defer collector.Close()
+
+	f := newFakeFabric(t)
+	c := newTestClient(t, f)
+	tracing, err := NewTracing(TracingConfig{Endpoint: collector.URL, ServiceName: "test", SampleRatio: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.tracing = tracing
+	c.ticker = time.NewTicker(10 * time.Millisecond)
+
+	f.mustInvoke(fakeUserMSP, "transfer", "setBalance", "alice", "100")
+	f.mustInvoke(fakeUserMSP, "transfer", "transfer", testRemote, "alice", "bob", "10")
+	if err := c.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	var ibtp *pb.IBTP
+	select {
+	case ibtp = <-c.GetIBTPCh():
+	case <-time.After(5 * time.Second):
+		t.Fatal("no ibtp polled")
+	}
+	if strings.Contains(string(ibtp.Extra), "traceparent") {
+		t.Fatalf("ibtp carries its trace context in its hashed extra: %q", ibtp.Extra)
+	}
+	// polled again by a client tracing nothing, the ibtp hashes the same
+	again := newTestClient(t, f).pollMessage(context.Background(), DeadLetterInterchain, genServicePair(ibtp.From, ibtp.To), ibtp.Index, c.getOutMessage)
+	if again.err != nil {
+		t.Fatal(again.err)
+	}
+	if again.ibtp.Hash().String() != ibtp.Hash().String() {
+		t.Fatalf("ibtp hashes %s polled again, want %s", again.ibtp.Hash(), ibtp.Hash())
+	}
+
+	ret, err := c.SubmitReceipt(ibtp.To, ibtp.Index, testTransferCID, pb.IBTP_RECEIPT_SUCCESS,
+		&pb.Result{MultiStatus: []bool{true}}, &pb.BxhProof{TxStatus: pb.TransactionStatus_SUCCESS})
+	if err != nil || !ret.Status {
+		t.Fatalf("submit receipt: %v %+v", err, ret)
+	}
+	if err := c.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	byName := make(map[string]OTLPSpan)
+	for _, span := range spans {
+		byName[span.Name] = span
+	}
+	poll, ok := byName["PollMessage"]
+	if !ok {
+		t.Fatalf("no polling span exported: %+v", spans)
+	}
+	submit, ok := byName["SubmitReceipt"]
+	if !ok {
+		t.Fatalf("no receipt span exported: %+v", spans)
+	}
+	if submit.TraceID != poll.TraceID {
+		t.Fatalf("receipt traced in %s, want the trace %s of its interchain", submit.TraceID, poll.TraceID)
+	}
+	var executed bool
+	for _, span := range spans {
+		if span.Name == "Execute" && span.ParentSpanID == submit.SpanID {
+			executed = true
+		}
+	}
+	if !executed {
+		t.Fatalf("no execute span under the receipt span: %+v", spans)
+	}
+}
@@ -0,0 +1,309 @@
+package verifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset"
+	"github.com/hyperledger/fabric-protos-go/ledger/rwset/kvrwset"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric/protoutil"
+	"github.com/meshplus/bitxhub-core/validator/validatorlib"
+	"github.com/meshplus/bitxhub-model/pb"
+	"github.com/meshplus/pier-client-fabric/proof"
+)
+
+// Validator is the trust root of a fabric appchain as registered on bitxhub:
+// the broker chaincode id, the endorsement policy and the channel MSP configs.
+type Validator struct {
+	Cid      string   `json:"cid"`
+	ChainId  string   `json:"chain_id"`
+	Policy   string   `json:"policy"`
+	ConfByte []string `json:"conf_byte"`
+}
+
+type Verifier struct {
+	validator *Validator
+	evaluator *validatorlib.PolicyEvaluator
+}
+
+func New(validator *Validator) (*Verifier, error) {
+	pe, err := validatorlib.NewPolicyEvaluator(validator.ConfByte)
+	if err != nil {
+		return nil, fmt.Errorf("load msp configs: %w", err)
+	}
+
+	return &Verifier{
+		validator: validator,
+		evaluator: pe,
+	}, nil
+}
+
+// UnmarshalValidator decodes validators as produced by the validator command.
+func UnmarshalValidator(data []byte) (*Validator, error) {
+	v := &Validator{}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, fmt.Errorf("unmarshal validator: %w", err)
+	}
+
+	return v, nil
+}
+
+// Verify checks ibtp against validators the way the validating.wasm rule on
+// bitxhub does. Envelope proofs are additionally checked with VerifyProof.
+func Verify(ibtp *pb.IBTP, validators []byte) error {
+	validator, err := UnmarshalValidator(validators)
+	if err != nil {
+		return err
+	}
+
+	v, err := New(validator)
+	if err != nil {
+		return err
+	}
+
+	return v.Verify(ibtp)
+}
+
+// Verify checks that the proof of ibtp comes from the broker chaincode, that
+// it carries the same index and call as ibtp and that its endorsements
+// satisfy the endorsement policy. Out messages are proven by the transaction
+// of the business chaincode that had the broker store them, in which case
+// the broker write of the message must match ibtp instead of the response.
+func (v *Verifier) Verify(ibtp *pb.IBTP) error {
+	legacy := ibtp.Proof
+	if proof.IsEnvelopeProof(ibtp.Proof) {
+		p, err := proof.Unmarshal(ibtp.Proof)
+		if err != nil {
+			return err
+		}
+		if err := v.VerifyProof(p); err != nil {
+			return err
+		}
+
+		env, err := protoutil.UnmarshalEnvelope(p.Envelope)
+		if err != nil {
+			return fmt.Errorf("unmarshal envelope: %w", err)
+		}
+		legacy, err = proof.ActionPayload(env)
+		if err != nil {
+			return err
+		}
+	}
+
+	// validatorlib dereferences the endorsed action without checking it
+	cap, err := protoutil.UnmarshalChaincodeActionPayload(legacy)
+	if err != nil {
+		return fmt.Errorf("unmarshal proof: %w", err)
+	}
+	if cap.Action == nil {
+		return fmt.Errorf("proof has no endorsed action")
+	}
+
+	action, err := chaincodeAction(cap.Action.ProposalResponsePayload)
+	if err != nil {
+		return err
+	}
+	if action.ChaincodeId.GetName() != v.validator.Cid {
+		if err := v.checkOutMessage(action, ibtp); err != nil {
+			return err
+		}
+		return v.evaluate(cap.Action.ProposalResponsePayload, cap.Action.Endorsements)
+	}
+
+	payload, err := ibtp.Marshal()
+	if err != nil {
+		return fmt.Errorf("marshal ibtp: %w", err)
+	}
+
+	artifact, err := validatorlib.PreCheck(legacy, payload, v.validator.Cid)
+	if err != nil {
+		return fmt.Errorf("check proof against ibtp: %w", err)
+	}
+
+	if err := v.evaluator.Evaluate([]byte(v.validator.Policy), validatorlib.GetSignatureSet(artifact)); err != nil {
+		return fmt.Errorf("evaluate endorsement policy: %w", err)
+	}
+
+	return nil
+}
+
+// VerifyProof checks an envelope proof: the transaction must be reported
+// valid, the envelope must be in the block of the proof header, the
+// transaction must invoke the broker chaincode or have it write its state
+// and its endorsements, matching the envelope, must satisfy the validator
+// policy. The validation code is taken from the proof, and p.Header.Hash() is
+// left to be matched against the headers the caller trusts.
+func (v *Verifier) VerifyProof(p *proof.Proof) error {
+	if p.ValidationCode != int32(peer.TxValidationCode_VALID) {
+		return fmt.Errorf("transaction %s is invalid: %s", p.TxID, peer.TxValidationCode(p.ValidationCode))
+	}
+	if err := p.VerifyInclusion(); err != nil {
+		return fmt.Errorf("transaction %s is not in block %d: %w", p.TxID, p.Header.Number, err)
+	}
+
+	env, err := protoutil.UnmarshalEnvelope(p.Envelope)
+	if err != nil {
+		return fmt.Errorf("unmarshal envelope: %w", err)
+	}
+	chdr, err := protoutil.ChannelHeader(env)
+	if err != nil {
+		return fmt.Errorf("channel header: %w", err)
+	}
+	if chdr.TxId != p.TxID {
+		return fmt.Errorf("envelope carries transaction %s, expect %s", chdr.TxId, p.TxID)
+	}
+
+	cap, err := proof.ChaincodeActionPayload(env)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(cap.Action.ProposalResponsePayload, p.ProposalResponsePayload) {
+		return fmt.Errorf("proposal response payload does not match envelope")
+	}
+	if len(cap.Action.Endorsements) != len(p.Endorsements) {
+		return fmt.Errorf("endorsements do not match envelope")
+	}
+	for i, e := range cap.Action.Endorsements {
+		if !bytes.Equal(e.Endorser, p.Endorsements[i].Endorser) || !bytes.Equal(e.Signature, p.Endorsements[i].Signature) {
+			return fmt.Errorf("endorsement %d does not match envelope", i)
+		}
+	}
+
+	action, err := chaincodeAction(p.ProposalResponsePayload)
+	if err != nil {
+		return err
+	}
+	if action.ChaincodeId.GetName() != v.validator.Cid {
+		writes, err := namespaceWrites(action, v.validator.Cid)
+		if err != nil {
+			return err
+		}
+		if len(writes) == 0 {
+			return fmt.Errorf("transaction %s neither invokes nor writes chaincode %s", p.TxID, v.validator.Cid)
+		}
+	}
+
+	endorsements := make([]*peer.Endorsement, 0, len(p.Endorsements))
+	for _, e := range p.Endorsements {
+		endorsements = append(endorsements, &peer.Endorsement{Endorser: e.Endorser, Signature: e.Signature})
+	}
+
+	return v.evaluate(p.ProposalResponsePayload, endorsements)
+}
+
+// evaluate checks that endorsements of prp satisfy the validator policy.
+func (v *Verifier) evaluate(prp []byte, endorsements []*peer.Endorsement) error {
+	signatureSet := make([]*protoutil.SignedData, 0, len(endorsements))
+	for _, e := range endorsements {
+		signatureSet = append(signatureSet, &protoutil.SignedData{
+			Data:      append(append([]byte{}, prp...), e.Endorser...),
+			Identity:  e.Endorser,
+			Signature: e.Signature,
+		})
+	}
+	if err := v.evaluator.Evaluate([]byte(v.validator.Policy), signatureSet); err != nil {
+		return fmt.Errorf("evaluate endorsement policy: %w", err)
+	}
+
+	return nil
+}
+
+// outMessage is an out message as the broker stores it.
+type outMessage struct {
+	Index     uint64 `json:"index"`
+	DstFullID string `json:"dst_full_id"`
+	SrcFullID string `json:"src_full_id"`
+	CallFunc  struct {
+		Func string   `json:"func"`
+		Args [][]byte `json:"args"`
+	} `json:"call_func"`
+}
+
+// checkOutMessage checks that action has the broker write the out message of
+// ibtp with the same index and call as ibtp.
+func (v *Verifier) checkOutMessage(action *peer.ChaincodeAction, ibtp *pb.IBTP) error {
+	writes, err := namespaceWrites(action, v.validator.Cid)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("out-msg-%s-%s-%d", ibtp.From, ibtp.To, ibtp.Index)
+	var value []byte
+	for _, w := range writes {
+		if w.Key == key && !w.IsDelete {
+			value = w.Value
+		}
+	}
+	if value == nil {
+		return fmt.Errorf("chaincode %s does not write out message %s", v.validator.Cid, key)
+	}
+
+	msg := &outMessage{}
+	if err := json.Unmarshal(value, msg); err != nil {
+		return fmt.Errorf("unmarshal out message %s: %w", key, err)
+	}
+	if msg.Index != ibtp.Index || msg.SrcFullID != ibtp.From || msg.DstFullID != ibtp.To {
+		return fmt.Errorf("out message %s is stored as %d from %s to %s", key, msg.Index, msg.SrcFullID, msg.DstFullID)
+	}
+
+	payload := &pb.Payload{}
+	if err := payload.Unmarshal(ibtp.Payload); err != nil {
+		return fmt.Errorf("unmarshal ibtp payload: %w", err)
+	}
+	if payload.Encrypted {
+		return nil
+	}
+	content := &pb.Content{}
+	if err := content.Unmarshal(payload.Content); err != nil {
+		return fmt.Errorf("unmarshal ibtp payload content: %w", err)
+	}
+	if content.Func != msg.CallFunc.Func || len(content.Args) != len(msg.CallFunc.Args) {
+		return fmt.Errorf("out message %s calls %s, ibtp %s", key, msg.CallFunc.Func, content.Func)
+	}
+	for i, arg := range content.Args {
+		if !bytes.Equal(arg, msg.CallFunc.Args[i]) {
+			return fmt.Errorf("out message %s has other args than ibtp", key)
+		}
+	}
+
+	return nil
+}
+
+func chaincodeAction(prp []byte) (*peer.ChaincodeAction, error) {
+	payload, err := protoutil.UnmarshalProposalResponsePayload(prp)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal proposal response payload: %w", err)
+	}
+	action, err := protoutil.UnmarshalChaincodeAction(payload.Extension)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal chaincode action: %w", err)
+	}
+
+	return action, nil
+}
+
+// namespaceWrites returns the writes of action to the state of chaincode
+// name.
+func namespaceWrites(action *peer.ChaincodeAction, name string) ([]*kvrwset.KVWrite, error) {
+	if len(action.Results) == 0 {
+		return nil, nil
+	}
+	txRWSet := &rwset.TxReadWriteSet{}
+	if err := proto.Unmarshal(action.Results, txRWSet); err != nil {
+		return nil, fmt.Errorf("unmarshal read-write set: %w", err)
+	}
+	for _, ns := range txRWSet.NsRwset {
+		if ns.Namespace != name {
+			continue
+		}
+		kvRWSet := &kvrwset.KVRWSet{}
+		if err := proto.Unmarshal(ns.Rwset, kvRWSet); err != nil {
+			return nil, fmt.Errorf("unmarshal read-write set of %s: %w", name, err)
+		}
+		return kvRWSet.Writes, nil
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,130 @@
+package verifier_test
+
+import (
+	"testing"
+
+	"github.com/hyperledger/fabric-chaincode-go/shim"
+	"github.com/hyperledger/fabric-protos-go/peer"
+	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
+	"github.com/hyperledger/fabric-sdk-go/pkg/common/providers/fab"
+	"github.com/meshplus/pier-client-fabric/internal/simulator"
+	"github.com/meshplus/pier-client-fabric/proof"
+	"github.com/meshplus/pier-client-fabric/verifier"
+)
+
+// brokerProof returns the envelope proof of a broker transaction on c.
+func brokerProof(t *testing.T, c *simulator.Chain) *proof.Proof {
+	t.Helper()
+	res, err := c.Execute(channel.Request{ChaincodeID: "broker", Fcn: "getChainId"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return txProof(t, c, res.TransactionID)
+}
+
+// txProof returns the envelope proof of transaction txID on c.
+func txProof(t *testing.T, c *simulator.Chain, txID fab.TransactionID) *proof.Proof {
+	t.Helper()
+	tx, err := c.QueryTransaction(txID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := c.QueryBlockByTxID(txID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := proof.New(string(txID), tx, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return p
+}
+
+func TestVerifyProof(t *testing.T) {
+	c, err := simulator.New("appchain1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	validator, err := c.Validator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := verifier.New(validator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := brokerProof(t, c)
+
+	if err := v.VerifyProof(brokerProof(t, c)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		tamper func(p *proof.Proof)
+	}{
+		{"invalid transaction", func(p *proof.Proof) {
+			p.ValidationCode = int32(peer.TxValidationCode_MVCC_READ_CONFLICT)
+		}},
+		{"tampered envelope", func(p *proof.Proof) {
+			p.Envelope[len(p.Envelope)/2] ^= 0xff
+		}},
+		{"envelope of another transaction", func(p *proof.Proof) {
+			p.Envelope = other.Envelope
+		}},
+		{"other transaction id", func(p *proof.Proof) {
+			p.TxID = other.TxID
+		}},
+		{"response of another transaction", func(p *proof.Proof) {
+			p.ProposalResponsePayload = other.ProposalResponsePayload
+		}},
+		{"endorsement dropped", func(p *proof.Proof) {
+			p.Endorsements = p.Endorsements[:1]
+		}},
+		{"tampered signature", func(p *proof.Proof) {
+			p.Endorsements[0].Signature = other.Endorsements[0].Signature
+		}},
+		{"header of another block", func(p *proof.Proof) {
+			p.Header = other.Header
+		}},
+		{"other transaction index", func(p *proof.Proof) {
+			p.TxIndex = 1
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := brokerProof(t, c)
+			tc.tamper(p)
+			if err := v.VerifyProof(p); err == nil {
+				t.Fatal("tampered proof verified")
+			}
+		})
+	}
+
+	// a business transaction proves out messages only if the broker stored
+	// them in it
+	txID, res := c.Invoke(simulator.UserMSP, simulator.Transfer, "setBalance", "alice", "100")
+	if res.Status != shim.OK {
+		t.Fatal(res.Message)
+	}
+	if err := v.VerifyProof(txProof(t, c, txID)); err == nil {
+		t.Fatal("proof of a transaction not touching the broker verified")
+	}
+	txID, res = c.Invoke(simulator.UserMSP, simulator.Transfer, "transfer", c.ServiceID("remote"), "alice", "bob", "1")
+	if res.Status != shim.OK {
+		t.Fatal(res.Message)
+	}
+	if err := v.VerifyProof(txProof(t, c, txID)); err != nil {
+		t.Fatalf("proof of an out message: %v", err)
+	}
+
+	// a broker on another channel does not satisfy the policy
+	foreign, err := simulator.New("appchain2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := v.VerifyProof(brokerProof(t, foreign)); err == nil {
+		t.Fatal("proof endorsed by other orgs verified")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"github.com/hashicorp/go-hclog"
 	"github.com/meshplus/bitxhub-kit/crypto/asym/ecdsa"
 	"github.com/meshplus/bitxhub-kit/types"
 )
@@ -22,18 +23,20 @@ type response struct {
 type ValidatorServer struct {
 	router *gin.Engine
 	port   string
+	logger hclog.Logger
 
 	ctx    context.Context
 	cancel context.CancelFunc
 }
 
-func NewValidatorServer(port string) (*ValidatorServer, error) {
+func NewValidatorServer(port string, logger hclog.Logger) (*ValidatorServer, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.New()
 	return &ValidatorServer{
 		router: router,
 		port:   port,
+		logger: logger,
 		ctx:    ctx,
 		cancel: cancel,
 	}, nil
@@ -50,11 +53,13 @@ func (g *ValidatorServer) Start() error {
 		go func() {
 			err := g.router.Run(fmt.Sprintf(":%s", g.port))
 			if err != nil {
+				g.logger.Error("Run validator server", "port", g.port, "error", err.Error())
 				panic(err)
 			}
 		}()
 		<-g.ctx.Done()
 	}()
+	g.logger.Info("Validator server started", "port", g.port)
 	return nil
 }
 
@@ -69,6 +74,7 @@ func (g *ValidatorServer) verifyMultiSign(c *gin.Context) {
 	if err := json.Unmarshal([]byte(signatures), &multiSignatures); err != nil {
 		res.IsPass = false
 		res.Data = []byte("multi signatures json unmarshal error")
+		g.logger.Warn("Reject multi sign verification", "hash", hash, "reason", string(res.Data))
 		c.JSON(http.StatusOK, res)
 		return
 	}
@@ -76,6 +82,7 @@ func (g *ValidatorServer) verifyMultiSign(c *gin.Context) {
 	if err := json.Unmarshal([]byte(validators), &vList); err != nil {
 		res.IsPass = false
 		res.Data = []byte("validators json unmarshal error")
+		g.logger.Warn("Reject multi sign verification", "hash", hash, "reason", string(res.Data))
 		c.JSON(http.StatusOK, res)
 		return
 	}
@@ -83,6 +90,7 @@ func (g *ValidatorServer) verifyMultiSign(c *gin.Context) {
 	if err != nil {
 		res.IsPass = false
 		res.Data = []byte("threshold parse error")
+		g.logger.Warn("Reject multi sign verification", "hash", hash, "reason", string(res.Data))
 		c.JSON(http.StatusOK, res)
 		return
 	}
@@ -99,6 +107,7 @@ func (g *ValidatorServer) verifyMultiSign(c *gin.Context) {
 		if err != nil {
 			res.IsPass = false
 			res.Data = []byte("recover plain error")
+			g.logger.Warn("Reject multi sign verification", "hash", hash, "reason", string(res.Data))
 			c.JSON(http.StatusOK, res)
 			return
 		}
@@ -117,6 +126,8 @@ func (g *ValidatorServer) verifyMultiSign(c *gin.Context) {
 	}
 
 	res.IsPass = false
+	g.logger.Warn("Reject multi sign verification", "hash", hash, "reason", "too few validator signatures",
+		"signers", len(bxhSigners), "threshold", t)
 	c.JSON(http.StatusOK, res)
 }
 